@@ -40,12 +40,17 @@ import (
 	aliyun_oss "mosn.io/layotto/components/oss/aliyun"
 
 	ceph_oss "mosn.io/layotto/components/oss/ceph"
+	oss_encryption "mosn.io/layotto/components/oss/encryption"
+	local_oss "mosn.io/layotto/components/oss/local"
 
 	"mosn.io/mosn/pkg/istio"
 
 	aliyun_cryption "mosn.io/layotto/components/cryption/aliyun"
 	aws_cryption "mosn.io/layotto/components/cryption/aws"
 	aliyun_file "mosn.io/layotto/components/file/aliyun"
+	"mosn.io/layotto/components/file/dedup"
+	file_encryption "mosn.io/layotto/components/file/encryption"
+	"mosn.io/layotto/components/file/replication"
 
 	aliyun_email "mosn.io/layotto/components/email/aliyun"
 	tencentcloud_sms "mosn.io/layotto/components/sms/tencentcloud"
@@ -112,6 +117,10 @@ import (
 	// RPC
 	"mosn.io/layotto/components/rpc"
 	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	_ "mosn.io/layotto/components/rpc/resolver/consul"
+	_ "mosn.io/layotto/components/rpc/resolver/nacos"
+	_ "mosn.io/layotto/components/rpc/resolver/static"
+	_ "mosn.io/layotto/components/rpc/resolver/zookeeper"
 
 	// State Stores
 	"github.com/dapr/components-contrib/state"
@@ -161,7 +170,10 @@ import (
 	"mosn.io/layotto/pkg/actuator/health"
 	actuatorInfo "mosn.io/layotto/pkg/actuator/info"
 	actuatorLogger "mosn.io/layotto/pkg/actuator/logger"
+	_ "mosn.io/layotto/pkg/actuator/migration"
+	_ "mosn.io/layotto/pkg/actuator/rpc"
 	_ "mosn.io/layotto/pkg/filter/stream/actuator/http"
+	_ "mosn.io/layotto/pkg/filter/stream/gateway/http"
 	"mosn.io/layotto/pkg/integrate/actuator"
 
 	"github.com/urfave/cli"
@@ -314,12 +326,18 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			file.NewFileFactory("tencent.oss", tencentcloud.NewTencentCloudOSS),
 			file.NewFileFactory("local", local.NewLocalStore),
 			file.NewFileFactory("qiniu.oss", qiniu.NewQiniuOSS),
+			file.NewFileFactory("dedup", dedup.NewDedupFile),
+			file.NewFileFactory("encryption", file_encryption.NewEncryptionFile),
+			file.NewFileFactory("replication", replication.NewReplicationFile),
 		),
 		runtime.WithOssFactory(
 			oss.NewFactory("aws.oss", aws_oss.NewAwsOss),
 			oss.NewFactory("aliyun.oss", aliyun_oss.NewAliyunOss),
 			oss.NewFactory("ceph", ceph_oss.NewCephOss),
 			oss.NewFactory("huaweicloud.oss", huaweicloud_oss.NewHuaweicloudOSS),
+			oss.NewFactory("local.oss", local_oss.NewLocalOss),
+			oss.NewFactory("in-memory.oss", local_oss.NewInMemoryOss),
+			oss.NewFactory("encryption.oss", oss_encryption.NewEncryptionOss),
 		),
 		// Cryption
 		runtime.WithCryptionServiceFactory(
@@ -44,9 +44,14 @@ import (
 	aliyun_oss "mosn.io/layotto/components/oss/aliyun"
 
 	ceph_oss "mosn.io/layotto/components/oss/ceph"
+	oss_encryption "mosn.io/layotto/components/oss/encryption"
+	local_oss "mosn.io/layotto/components/oss/local"
 
 	aliyun_file "mosn.io/layotto/components/file/aliyun"
+	"mosn.io/layotto/components/file/dedup"
+	file_encryption "mosn.io/layotto/components/file/encryption"
 	"mosn.io/layotto/components/file/local"
+	"mosn.io/layotto/components/file/replication"
 
 	"mosn.io/mosn/pkg/istio"
 
@@ -119,6 +124,10 @@ import (
 	// RPC
 	"mosn.io/layotto/components/rpc"
 	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	_ "mosn.io/layotto/components/rpc/resolver/consul"
+	_ "mosn.io/layotto/components/rpc/resolver/nacos"
+	_ "mosn.io/layotto/components/rpc/resolver/static"
+	_ "mosn.io/layotto/components/rpc/resolver/zookeeper"
 
 	// State Stores
 	"github.com/dapr/components-contrib/state"
@@ -167,7 +176,10 @@ import (
 	_ "mosn.io/layotto/pkg/actuator"
 	"mosn.io/layotto/pkg/actuator/health"
 	actuatorInfo "mosn.io/layotto/pkg/actuator/info"
+	_ "mosn.io/layotto/pkg/actuator/migration"
+	_ "mosn.io/layotto/pkg/actuator/rpc"
 	_ "mosn.io/layotto/pkg/filter/stream/actuator/http"
+	_ "mosn.io/layotto/pkg/filter/stream/gateway/http"
 	"mosn.io/layotto/pkg/integrate/actuator"
 
 	"github.com/urfave/cli"
@@ -320,6 +332,9 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			file.NewFileFactory("tencent.oss", tencentcloud.NewTencentCloudOSS),
 			file.NewFileFactory("local", local.NewLocalStore),
 			file.NewFileFactory("qiniu.oss", qiniu.NewQiniuOSS),
+			file.NewFileFactory("dedup", dedup.NewDedupFile),
+			file.NewFileFactory("encryption", file_encryption.NewEncryptionFile),
+			file.NewFileFactory("replication", replication.NewReplicationFile),
 		),
 
 		//OSS
@@ -328,6 +343,9 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			oss.NewFactory("aliyun.oss", aliyun_oss.NewAliyunOss),
 			oss.NewFactory("ceph", ceph_oss.NewCephOss),
 			oss.NewFactory("huaweicloud.oss", huaweicloud_oss.NewHuaweicloudOSS),
+			oss.NewFactory("local.oss", local_oss.NewLocalOss),
+			oss.NewFactory("in-memory.oss", local_oss.NewInMemoryOss),
+			oss.NewFactory("encryption.oss", oss_encryption.NewEncryptionOss),
 		),
 
 		// PubSub
@@ -44,11 +44,16 @@ import (
 	huaweicloud_oss "mosn.io/layotto/components/oss/huaweicloud"
 
 	ceph_oss "mosn.io/layotto/components/oss/ceph"
+	oss_encryption "mosn.io/layotto/components/oss/encryption"
+	local_oss "mosn.io/layotto/components/oss/local"
 
 	"mosn.io/layotto/components/file/aliyun"
 	aws_file "mosn.io/layotto/components/file/aws"
+	"mosn.io/layotto/components/file/dedup"
+	file_encryption "mosn.io/layotto/components/file/encryption"
 	"mosn.io/layotto/components/file/minio"
 	"mosn.io/layotto/components/file/qiniu"
+	"mosn.io/layotto/components/file/replication"
 	"mosn.io/layotto/components/file/tencentcloud"
 
 	"github.com/dapr/components-contrib/secretstores"
@@ -109,6 +114,10 @@ import (
 	// RPC
 	"mosn.io/layotto/components/rpc"
 	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	_ "mosn.io/layotto/components/rpc/resolver/consul"
+	_ "mosn.io/layotto/components/rpc/resolver/nacos"
+	_ "mosn.io/layotto/components/rpc/resolver/static"
+	_ "mosn.io/layotto/components/rpc/resolver/zookeeper"
 
 	// State Stores
 	"github.com/dapr/components-contrib/state"
@@ -156,7 +165,10 @@ import (
 	_ "mosn.io/layotto/pkg/actuator"
 	"mosn.io/layotto/pkg/actuator/health"
 	actuatorInfo "mosn.io/layotto/pkg/actuator/info"
+	_ "mosn.io/layotto/pkg/actuator/migration"
+	_ "mosn.io/layotto/pkg/actuator/rpc"
 	_ "mosn.io/layotto/pkg/filter/stream/actuator/http"
+	_ "mosn.io/layotto/pkg/filter/stream/gateway/http"
 	"mosn.io/layotto/pkg/integrate/actuator"
 
 	"github.com/urfave/cli"
@@ -293,6 +305,9 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			file.NewFileFactory("tencent.oss", tencentcloud.NewTencentCloudOSS),
 			file.NewFileFactory("local", local.NewLocalStore),
 			file.NewFileFactory("qiniu.oss", qiniu.NewQiniuOSS),
+			file.NewFileFactory("dedup", dedup.NewDedupFile),
+			file.NewFileFactory("encryption", file_encryption.NewEncryptionFile),
+			file.NewFileFactory("replication", replication.NewReplicationFile),
 		),
 
 		// PubSub
@@ -433,6 +448,9 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			oss.NewFactory("aliyun.oss", aliyun_oss.NewAliyunOss),
 			oss.NewFactory("ceph", ceph_oss.NewCephOss),
 			oss.NewFactory("huaweicloud.oss", huaweicloud_oss.NewHuaweicloudOSS),
+			oss.NewFactory("local.oss", local_oss.NewLocalOss),
+			oss.NewFactory("in-memory.oss", local_oss.NewInMemoryOss),
+			oss.NewFactory("encryption.oss", oss_encryption.NewEncryptionOss),
 		),
 		// Cryption
 		runtime.WithCryptionServiceFactory(
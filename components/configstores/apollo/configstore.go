@@ -52,6 +52,10 @@ func init() {
 }
 
 type ConfigStore struct {
+	// mu protects the repositories and the open api configuration, which can be replaced by ApplyConfig
+	mu             sync.RWMutex
+	config         *configstores.StoreConfig
+	newRepository  func() Repository
 	tagsNamespace  string
 	delimiter      string
 	openAPIToken   string
@@ -92,6 +96,7 @@ func NewStore() configstores.Store {
 		tagsNamespace: defaultTagsNamespace,
 		delimiter:     defaultDelimiter,
 		env:           defaultEnv,
+		newRepository: newAgolloRepository,
 		kvRepo:        newAgolloRepository(),
 		tagsRepo:      newAgolloRepository(),
 		openAPIClient: newHttpClient(),
@@ -209,6 +214,59 @@ func (c *ConfigStore) doInit(config *configstores.StoreConfig) error {
 	listener := newChangeListener(c, c.log)
 	c.listener = listener
 	c.kvRepo.AddChangeListener(listener)
+	c.config = config
+	return nil
+}
+
+// ApplyConfig connects to apollo with the new metadata and replaces the current repositories,
+// e.g. to rotate the secret or the open api token.
+// The subscriptions are kept, and the current repositories are kept if the new ones fail to connect.
+// The replaced repositories are closed.
+func (c *ConfigStore) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	c.mu.RLock()
+	current := c.config
+	c.mu.RUnlock()
+	if current == nil {
+		return ErrNoConfig
+	}
+	// 1. connect with the new config
+	config := *current
+	config.Metadata = metadata
+	next := &ConfigStore{
+		tagsNamespace: c.tagsNamespace,
+		delimiter:     c.delimiter,
+		env:           c.env,
+		kvRepo:        c.newRepository(),
+		tagsRepo:      c.newRepository(),
+		openAPIClient: c.openAPIClient,
+		log:           c.log,
+	}
+	if err := next.doInit(&config); err != nil {
+		next.kvRepo.Close()
+		next.tagsRepo.Close()
+		return err
+	}
+	// 2. move the subscriptions to the new repository
+	next.kvRepo.RemoveChangeListener(next.listener)
+	next.kvRepo.AddChangeListener(c.listener)
+	// 3. swap
+	c.mu.Lock()
+	oldKvRepo, oldTagsRepo := c.kvRepo, c.tagsRepo
+	c.kvRepo = next.kvRepo
+	c.tagsRepo = next.tagsRepo
+	c.kvConfig = next.kvConfig
+	c.tagsConfig = next.tagsConfig
+	c.openAPIToken = next.openAPIToken
+	c.openAPIAddress = next.openAPIAddress
+	c.openAPIUser = next.openAPIUser
+	c.config = next.config
+	c.mu.Unlock()
+	// 4. stop the old repositories
+	oldKvRepo.RemoveChangeListener(c.listener)
+	oldKvRepo.Close()
+	oldTagsRepo.Close()
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
 	return nil
 }
 
@@ -228,6 +286,8 @@ func (c *ConfigStore) GetStoreName() string {
 
 // Get gets configuration from configuration store.
 func (c *ConfigStore) Get(ctx context.Context, req *configstores.GetRequest) ([]*configstores.ConfigurationItem, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	// TODO forced pagination
 	// 0. check if illegal
 	if len(req.Keys) > 0 && req.Group == "" {
@@ -262,6 +322,8 @@ func (c *ConfigStore) getAllTags(group string, keyWithLabel string) (tags map[st
 
 // Set saves configuration into configuration store.
 func (c *ConfigStore) Set(ctx context.Context, req *configstores.SetRequest) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	// 1. check params
 	if req.AppId == "" {
 		return errParamsMissingField("AppId")
@@ -312,6 +374,8 @@ func (c *ConfigStore) Set(ctx context.Context, req *configstores.SetRequest) err
 
 // Delete deletes configuration from configuration store.
 func (c *ConfigStore) Delete(ctx context.Context, req *configstores.DeleteRequest) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	// 1. check params
 	if req.AppId == "" {
 		return errParamsMissingField("AppId")
@@ -349,6 +413,8 @@ func (c *ConfigStore) Delete(ctx context.Context, req *configstores.DeleteReques
 
 // Subscribe gets configuration from configuration store and subscribe the updates.
 func (c *ConfigStore) Subscribe(req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	// 0. check if illegal
 	if len(req.Keys) > 0 && req.Group == "" {
 		req.Group = defaultNamespace
@@ -97,6 +97,14 @@ func (a *MockRepository) AddChangeListener(listener *changeListener) {
 	a.invoked = append(a.invoked, "AddChangeListener")
 }
 
+func (a *MockRepository) Close() {
+	a.invoked = append(a.invoked, "Close")
+}
+
+func (a *MockRepository) RemoveChangeListener(listener *changeListener) {
+	a.invoked = append(a.invoked, "RemoveChangeListener")
+}
+
 func (a *MockRepository) Set(namespace string, key string, value string) error {
 	if _, ok := a.cache[namespace]; !ok {
 		a.cache[namespace] = make(map[string]string)
@@ -440,3 +448,42 @@ func TestConfigStore_Init_fail(t *testing.T) {
 		assert.True(t, err.Error() != "")
 	}
 }
+
+func TestConfigStore_ApplyConfig(t *testing.T) {
+	store, cfg := setup(t)
+	err := store.Init(cfg)
+	assert.Nil(t, err)
+	oldRepo := store.kvRepo.(*MockRepository)
+	oldTagsRepo := store.tagsRepo.(*MockRepository)
+	var newRepos []*MockRepository
+	store.newRepository = func() Repository {
+		repo := newMockRepository()
+		newRepos = append(newRepos, repo)
+		return repo
+	}
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		metadata := map[string]string{"app_id": appId}
+		err := store.ApplyConfig(context.Background(), metadata)
+		assert.Error(t, err)
+		assert.Equal(t, oldRepo, store.kvRepo)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		newRepos = nil
+		metadata := make(map[string]string)
+		for k, v := range cfg.Metadata {
+			metadata[k] = v
+		}
+		metadata["secret"] = "new_secret"
+		err := store.ApplyConfig(context.Background(), metadata)
+		assert.Nil(t, err)
+		assert.Equal(t, newRepos[0], store.kvRepo)
+		assert.Equal(t, newRepos[1], store.tagsRepo)
+		assert.Equal(t, "new_secret", store.kvConfig.secret)
+		assert.Equal(t, []string{"AddChangeListener", "RemoveChangeListener", "AddChangeListener"}, newRepos[0].invoked)
+		assert.Contains(t, oldRepo.invoked, "RemoveChangeListener")
+		assert.Equal(t, "Close", oldRepo.invoked[len(oldRepo.invoked)-1])
+		assert.Contains(t, oldTagsRepo.invoked, "Close")
+	})
+}
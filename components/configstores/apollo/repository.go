@@ -21,6 +21,7 @@ import (
 
 	"github.com/apolloconfig/agollo/v4"
 	agolloConfig "github.com/apolloconfig/agollo/v4/env/config"
+	"github.com/apolloconfig/agollo/v4/storage"
 
 	"mosn.io/layotto/kit/logger"
 )
@@ -31,10 +32,13 @@ type Repository interface {
 	Connect() error
 	// subscribe
 	AddChangeListener(listener *changeListener)
+	RemoveChangeListener(listener *changeListener)
 	// query
 	Get(namespace string, key string) (interface{}, error)
 	//	process every items under the namespace
 	Range(namespace string, f func(key, value interface{}) bool) error
+	// Close stops the repository replaced by ApplyConfig
+	Close()
 }
 
 type repoConfig struct {
@@ -108,3 +112,23 @@ func (a *AgolloRepository) Range(namespace string, f func(key interface{}, value
 func (a *AgolloRepository) AddChangeListener(listener *changeListener) {
 	a.client.AddChangeListener(listener)
 }
+
+func (a *AgolloRepository) RemoveChangeListener(listener *changeListener) {
+	a.client.RemoveChangeListener(listener)
+}
+
+// Close removes the change listeners, so the replaced client stops dispatching the changes.
+// Notice that agollo v4 provides no way to stop the long polling of a client.
+func (a *AgolloRepository) Close() {
+	if a.client == nil {
+		return
+	}
+	listeners := a.client.GetChangeListeners()
+	for e := listeners.Front(); e != nil; {
+		next := e.Next()
+		if listener, ok := e.Value.(storage.ChangeListener); ok {
+			a.client.RemoveChangeListener(listener)
+		}
+		e = next
+	}
+}
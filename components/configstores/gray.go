@@ -0,0 +1,288 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configstores
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// instance attribute names which can be used in GrayRule.Match
+const (
+	AttrAppId      = "app_id"
+	AttrAppVersion = "app_version"
+	AttrIP         = "ip"
+	AttrHostname   = "hostname"
+	// AttrLabelPrefix is the prefix of pod labels, e.g. "label.env"
+	AttrLabelPrefix = "label."
+)
+
+// GrayRule resolves a label for the sidecar instances it matches.
+// All the conditions of a rule must be satisfied. Rules are evaluated in order and the first matched one wins.
+type GrayRule struct {
+	// Label is the label used when this rule matches
+	Label string `json:"label"`
+	// Match is a set of instance attributes which must be equal to the given values.
+	// A value ending with "*" is treated as a prefix.
+	Match map[string]string `json:"match,omitempty"`
+	// CIDRs restrict the rule to instances whose ip is in one of the ranges
+	CIDRs []string `json:"cidrs,omitempty"`
+	// Percentage restricts the rule to a stable subset of the matched instances. 0 means all of them.
+	Percentage int `json:"percentage,omitempty"`
+}
+
+// InstanceInfo holds the attributes of the current sidecar instance used to evaluate gray rules
+type InstanceInfo struct {
+	Attributes map[string]string
+}
+
+// NewInstanceInfo builds the attributes of the current instance.
+// labels are the pod labels, which can be loaded by ReadPodLabels.
+func NewInstanceInfo(appId, appVersion string, labels map[string]string) *InstanceInfo {
+	attrs := map[string]string{
+		AttrAppId:      appId,
+		AttrAppVersion: appVersion,
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs[AttrHostname] = hostname
+	}
+	if ip := localIP(); ip != "" {
+		attrs[AttrIP] = ip
+	}
+	for k, v := range labels {
+		attrs[AttrLabelPrefix+k] = v
+	}
+	return &InstanceInfo{Attributes: attrs}
+}
+
+// ReadPodLabels parses a labels file generated by the kubernetes downward API,
+// in which every line is formatted as key="value"
+func ReadPodLabels(file string) (map[string]string, error) {
+	f, err := os.Open(path.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	labels := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		v, err := strconv.Unquote(kv[1])
+		if err != nil {
+			v = kv[1]
+		}
+		labels[kv[0]] = v
+	}
+	return labels, scanner.Err()
+}
+
+// ResolveGrayLabel returns the label of the first rule matching the instance.
+// The second result is false if no rule matches.
+func ResolveGrayLabel(rules []*GrayRule, instance *InstanceInfo) (string, bool) {
+	if instance == nil {
+		return "", false
+	}
+	for _, rule := range rules {
+		if rule != nil && rule.matches(instance) {
+			return rule.Label, true
+		}
+	}
+	return "", false
+}
+
+// ValidateGrayRules checks the rules before they are used
+func ValidateGrayRules(rules []*GrayRule) error {
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("gray rule %d is nil", i)
+		}
+		if strings.TrimSpace(rule.Label) == "" {
+			return fmt.Errorf("gray rule %d has no label", i)
+		}
+		if rule.Percentage < 0 || rule.Percentage > 100 {
+			return fmt.Errorf("gray rule %d has invalid percentage %d", i, rule.Percentage)
+		}
+		for _, cidr := range rule.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("gray rule %d has invalid cidr %s: %v", i, cidr, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *GrayRule) matches(instance *InstanceInfo) bool {
+	for k, expected := range r.Match {
+		actual, ok := instance.Attributes[k]
+		if !ok {
+			return false
+		}
+		if strings.HasSuffix(expected, "*") {
+			if !strings.HasPrefix(actual, strings.TrimSuffix(expected, "*")) {
+				return false
+			}
+		} else if actual != expected {
+			return false
+		}
+	}
+	if len(r.CIDRs) > 0 && !inCIDRs(instance.Attributes[AttrIP], r.CIDRs) {
+		return false
+	}
+	if r.Percentage > 0 && r.Percentage < 100 {
+		return bucket(instance) < r.Percentage
+	}
+	return true
+}
+
+func inCIDRs(ip string, cidrs []string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err == nil && ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// bucket maps the instance into [0,100) stably, so an instance always falls into the same side of a percentage rule
+func bucket(instance *InstanceInfo) int {
+	h := fnv.New32a()
+	h.Write([]byte(instance.Attributes[AttrAppId]))
+	h.Write([]byte(instance.Attributes[AttrHostname]))
+	h.Write([]byte(instance.Attributes[AttrIP]))
+	return int(h.Sum32() % 100)
+}
+
+func localIP() string {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return ""
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return ipNet.IP.String()
+		}
+	}
+	return ""
+}
+
+// GrayStore wraps a Store and serves the resolved gray label as its default label.
+// Keys which have no value under the gray label fall back to the label of the wrapped store.
+// The resolved gray label is only used for reading: the writes without a label go to the label of the wrapped store,
+// see DefaultWriteLabel, while the writes with an explicit label, the gray one included, are passed through unchanged.
+// The subscriptions with the gray label watch both labels.
+type GrayStore struct {
+	Store
+	label string
+}
+
+// NewGrayStore returns a Store using label as its default label
+func NewGrayStore(store Store, label string) *GrayStore {
+	return &GrayStore{
+		Store: store,
+		label: label,
+	}
+}
+
+// GetDefaultLabel returns the gray label
+func (g *GrayStore) GetDefaultLabel() string {
+	return g.label
+}
+
+// Unwrap returns the wrapped store
+func (g *GrayStore) Unwrap() Store {
+	return g.Store
+}
+
+// DefaultWriteLabel returns the label filled in for the writes without a label.
+// It is the default label of the store, except that of a GrayStore is the label of the wrapped store,
+// since the gray label resolved from the rules is only used for reading
+func DefaultWriteLabel(store Store) string {
+	if g, ok := store.(*GrayStore); ok {
+		return g.Store.GetDefaultLabel()
+	}
+	return store.GetDefaultLabel()
+}
+
+// Get gets configuration under the gray label, falling back to the default label of the wrapped store for the missing keys.
+func (g *GrayStore) Get(ctx context.Context, req *GetRequest) ([]*ConfigurationItem, error) {
+	items, err := g.Store.Get(ctx, req)
+	baseLabel := g.Store.GetDefaultLabel()
+	if err != nil || req.Label != g.label || baseLabel == g.label {
+		return items, err
+	}
+	found := make(map[string]bool, len(items))
+	for _, item := range items {
+		found[item.Key] = true
+	}
+	missing := make([]string, 0, len(req.Keys))
+	for _, k := range req.Keys {
+		if !found[k] {
+			missing = append(missing, k)
+		}
+	}
+	// all the keys are found under the gray label
+	if len(req.Keys) > 0 && len(missing) == 0 {
+		return items, nil
+	}
+	baseReq := *req
+	baseReq.Label = baseLabel
+	baseReq.Keys = missing
+	baseItems, err := g.Store.Get(ctx, &baseReq)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range baseItems {
+		if !found[item.Key] {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// Subscribe watches the default label of the wrapped store besides the gray label,
+// because the keys missing under the gray label are served from it
+func (g *GrayStore) Subscribe(req *SubscribeReq, ch chan *SubscribeResp) error {
+	baseLabel := g.Store.GetDefaultLabel()
+	if req.Label != g.label || baseLabel == g.label {
+		return g.Store.Subscribe(req, ch)
+	}
+	if err := g.Store.Subscribe(req, ch); err != nil {
+		return err
+	}
+	baseReq := *req
+	baseReq.Label = baseLabel
+	return g.Store.Subscribe(&baseReq, ch)
+}
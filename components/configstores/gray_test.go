@@ -0,0 +1,170 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configstores
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type labeledStore struct {
+	Store
+	data       map[string]string
+	subscribed []string
+}
+
+func (s *labeledStore) Get(ctx context.Context, req *GetRequest) ([]*ConfigurationItem, error) {
+	res := make([]*ConfigurationItem, 0)
+	for _, k := range req.Keys {
+		if v, ok := s.data[k+"@"+req.Label]; ok {
+			res = append(res, &ConfigurationItem{Key: k, Label: req.Label, Content: v})
+		}
+	}
+	return res, nil
+}
+
+func (s *labeledStore) Set(ctx context.Context, req *SetRequest) error {
+	for _, item := range req.Items {
+		s.data[item.Key+"@"+item.Label] = item.Content
+	}
+	return nil
+}
+
+func (s *labeledStore) Delete(ctx context.Context, req *DeleteRequest) error {
+	for _, k := range req.Keys {
+		delete(s.data, k+"@"+req.Label)
+	}
+	return nil
+}
+
+func (s *labeledStore) Subscribe(req *SubscribeReq, ch chan *SubscribeResp) error {
+	s.subscribed = append(s.subscribed, req.Label)
+	return nil
+}
+
+func (s *labeledStore) GetDefaultLabel() string {
+	return "default"
+}
+
+func TestResolveGrayLabel(t *testing.T) {
+	instance := &InstanceInfo{Attributes: map[string]string{
+		AttrAppId:               "app",
+		AttrAppVersion:          "1.2.0",
+		AttrIP:                  "10.1.2.3",
+		AttrLabelPrefix + "env": "canary",
+	}}
+	t.Run("match attributes", func(t *testing.T) {
+		rules := []*GrayRule{
+			{Label: "v2", Match: map[string]string{AttrAppVersion: "2.*"}},
+			{Label: "canary", Match: map[string]string{"label.env": "canary", AttrAppVersion: "1.*"}},
+		}
+		label, ok := ResolveGrayLabel(rules, instance)
+		assert.True(t, ok)
+		assert.Equal(t, "canary", label)
+	})
+	t.Run("match cidr", func(t *testing.T) {
+		label, ok := ResolveGrayLabel([]*GrayRule{{Label: "gray", CIDRs: []string{"10.1.0.0/16"}}}, instance)
+		assert.True(t, ok)
+		assert.Equal(t, "gray", label)
+		_, ok = ResolveGrayLabel([]*GrayRule{{Label: "gray", CIDRs: []string{"192.168.0.0/16"}}}, instance)
+		assert.False(t, ok)
+	})
+	t.Run("percentage is stable", func(t *testing.T) {
+		rules := []*GrayRule{{Label: "gray", Percentage: 50}}
+		_, first := ResolveGrayLabel(rules, instance)
+		for i := 0; i < 10; i++ {
+			_, ok := ResolveGrayLabel(rules, instance)
+			assert.Equal(t, first, ok)
+		}
+		_, ok := ResolveGrayLabel([]*GrayRule{{Label: "gray", Percentage: 100}}, instance)
+		assert.True(t, ok)
+	})
+	t.Run("no rule matches", func(t *testing.T) {
+		_, ok := ResolveGrayLabel([]*GrayRule{{Label: "gray", Match: map[string]string{"label.zone": "a"}}}, instance)
+		assert.False(t, ok)
+	})
+}
+
+func TestValidateGrayRules(t *testing.T) {
+	assert.Nil(t, ValidateGrayRules([]*GrayRule{{Label: "gray", Percentage: 10, CIDRs: []string{"10.0.0.0/8"}}}))
+	assert.Error(t, ValidateGrayRules([]*GrayRule{{Percentage: 10}}))
+	assert.Error(t, ValidateGrayRules([]*GrayRule{{Label: "gray", Percentage: 101}}))
+	assert.Error(t, ValidateGrayRules([]*GrayRule{{Label: "gray", CIDRs: []string{"10.0.0.0"}}}))
+}
+
+func TestReadPodLabels(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "labels")
+	err := os.WriteFile(file, []byte("app=\"demo\"\nenv=\"canary\"\n\n"), 0644)
+	assert.Nil(t, err)
+	labels, err := ReadPodLabels(file)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app": "demo", "env": "canary"}, labels)
+
+	_, err = ReadPodLabels(filepath.Join(t.TempDir(), "not_exist"))
+	assert.Error(t, err)
+}
+
+func TestGrayStore_Get(t *testing.T) {
+	inner := &labeledStore{data: map[string]string{
+		"a@default": "a0",
+		"b@default": "b0",
+		"a@canary":  "a1",
+	}}
+	store := NewGrayStore(inner, "canary")
+	assert.Equal(t, "canary", store.GetDefaultLabel())
+	assert.Equal(t, inner, store.Unwrap())
+
+	items, err := store.Get(context.Background(), &GetRequest{Label: "canary", Keys: []string{"a", "b"}})
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "a1", items[0].Content)
+	assert.Equal(t, "b0", items[1].Content)
+
+	// explicit label is not affected
+	items, err = store.Get(context.Background(), &GetRequest{Label: "default", Keys: []string{"a"}})
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "a0", items[0].Content)
+}
+
+func TestGrayStore_Write(t *testing.T) {
+	inner := &labeledStore{data: map[string]string{"a@canary": "a1"}}
+	store := NewGrayStore(inner, "canary")
+
+	// the writes without a label go to the base label, the explicit labels are passed through
+	assert.Equal(t, "default", DefaultWriteLabel(store))
+	assert.Equal(t, "default", DefaultWriteLabel(inner))
+	err := store.Set(context.Background(), &SetRequest{Items: []*ConfigurationItem{
+		{Key: "a", Label: "canary", Content: "a2"},
+		{Key: "b", Label: DefaultWriteLabel(store), Content: "b0"},
+		{Key: "c", Label: "other", Content: "c0"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a@canary": "a2", "b@default": "b0", "c@other": "c0"}, inner.data)
+	err = store.Delete(context.Background(), &DeleteRequest{Label: "canary", Keys: []string{"a", "b"}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"b@default": "b0", "c@other": "c0"}, inner.data)
+
+	// the subscriptions with the gray label watch both labels
+	assert.Nil(t, store.Subscribe(&SubscribeReq{Label: "canary", Keys: []string{"a"}}, nil))
+	assert.Nil(t, store.Subscribe(&SubscribeReq{Label: "other", Keys: []string{"a"}}, nil))
+	assert.Equal(t, []string{"canary", "default", "other"}, inner.subscribed)
+}
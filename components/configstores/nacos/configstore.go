@@ -49,12 +49,17 @@ func init() {
 }
 
 type ConfigStore struct {
+	// mu protects client, which can be replaced by ApplyConfig
+	mu          sync.RWMutex
 	client      config_client.IConfigClient
+	config      configstores.StoreConfig
 	storeName   string
 	appId       string
 	namespaceId string
-	listener    sync.Map
-	log         log.Logger
+	// listener holds the channel of every subscribed key
+	listener  sync.Map
+	newClient func(config *configstores.StoreConfig, metadata *Metadata) (config_client.IConfigClient, error)
+	log       log.Logger
 }
 
 func NewStore() configstores.Store {
@@ -65,6 +70,7 @@ func NewStore() configstores.Store {
 	cs := &ConfigStore{
 		log: log.NewLayottoLogger("configstore/nacos"),
 	}
+	cs.newClient = cs.createClient
 	log.RegisterComponentLoggerListener("configstore/nacos", cs)
 	return cs
 }
@@ -102,35 +108,129 @@ func (n *ConfigStore) Init(config *configstores.StoreConfig) (err error) {
 	}
 
 	n.namespaceId = metadata.NameSpaceId
+	n.config = *config
+	client, err := n.newClient(config, metadata)
+	if err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	n.client = client
+	// replace nacos sdk log
+	return n.setupLogger(metadata)
+}
+
+func (n *ConfigStore) createClient(config *configstores.StoreConfig, metadata *Metadata) (config_client.IConfigClient, error) {
 	// the timeout of connect to nacos, not required
 	timeout := defaultTimeout
 	if config.TimeOut != "" {
+		var err error
 		timeout, err = strconv.Atoi(config.TimeOut)
 		if err != nil {
 			n.log.Errorf("wrong configuration for time out configuration: %+v, set default value(10s)", config.TimeOut)
-			return err
+			return nil, err
 		}
 	}
 	timeoutMs := uint64(timeout) * uint64(time.Second/time.Millisecond)
 
 	// choose different mode to connect to the nacos server.
-	var client config_client.IConfigClient
 	if metadata.OpenKMS {
-		client, err = n.initWithACM(timeoutMs, metadata)
-	} else {
-		client, err = n.init(config.Address, timeoutMs, metadata)
+		return n.initWithACM(timeoutMs, metadata)
 	}
+	return n.init(config.Address, timeoutMs, metadata)
+}
 
+// ApplyConfig replaces the nacos client with one built from the new metadata, e.g. to rotate the credentials.
+// All the subscribed keys are listened on the new client before it is used,
+// and the current client is kept if anything goes wrong.
+func (n *ConfigStore) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	// 1. parse and validate config
+	m, err := ParseNacosMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	if len(n.config.Address) == 0 && !m.OpenKMS {
+		return errConfigMissingField("address")
+	}
+	if !validLogLevel(m.LogLevel) {
+		return errors.New("unknown log level")
+	}
+	config := n.config
+	config.Metadata = metadata
+
+	// 2. create the new client and move the subscriptions to it
+	client, err := n.newClient(&config, m)
 	if err != nil {
-		readinessIndicator.ReportError(err.Error())
-		livenessIndicator.ReportError(err.Error())
 		return err
 	}
+	listened := make([]subscriberKey, 0)
+	n.listener.Range(func(key, value any) bool {
+		subscribe := key.(subscriberKey)
+		err = client.ListenConfig(vo.ConfigParam{
+			DataId:   subscribe.key,
+			Group:    subscribe.group,
+			AppName:  n.appId,
+			OnChange: n.subscribeOnChange(value.(chan *configstores.SubscribeResp)),
+		})
+		if err != nil {
+			return false
+		}
+		listened = append(listened, subscribe)
+		return true
+	})
+	if err != nil {
+		n.cancelListen(client, listened)
+		client.CloseClient()
+		return err
+	}
+
+	// 3. swap and close the old client
+	n.mu.Lock()
+	old := n.client
+	n.client = client
+	n.config = config
+	n.namespaceId = m.NameSpaceId
+	n.mu.Unlock()
+	if old != nil {
+		listened = listened[:0]
+		n.listener.Range(func(key, value any) bool {
+			listened = append(listened, key.(subscriberKey))
+			return true
+		})
+		n.cancelListen(old, listened)
+		old.CloseClient()
+	}
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
-	n.client = client
-	// replace nacos sdk log
-	return n.setupLogger(metadata)
+	return n.setupLogger(m)
+}
+
+func (n *ConfigStore) cancelListen(client config_client.IConfigClient, keys []subscriberKey) {
+	for _, subscribe := range keys {
+		if err := client.CancelListenConfig(vo.ConfigParam{
+			DataId:  subscribe.key,
+			Group:   subscribe.group,
+			AppName: n.appId,
+		}); err != nil {
+			n.log.Errorf("nacos cancel listening key %s-%s-%s failed: %v", n.appId, subscribe.group, subscribe.key, err)
+		}
+	}
+}
+
+func (n *ConfigStore) getClient() config_client.IConfigClient {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.client
+}
+
+func validLogLevel(level string) bool {
+	switch level {
+	case DEBUG, INFO, WARN, ERROR:
+		return true
+	}
+	return false
 }
 
 // Connect to self built nacos services
@@ -281,7 +381,7 @@ func (n *ConfigStore) getPagination(metadata map[string]string) *Pagination {
 }
 
 func (n *ConfigStore) getAllWithAppId(ctx context.Context, pagination *Pagination) ([]*configstores.ConfigurationItem, error) {
-	values, err := n.client.SearchConfig(vo.SearchConfigParam{
+	values, err := n.getClient().SearchConfig(vo.SearchConfigParam{
 		Search:   "accurate",
 		AppName:  n.appId,
 		PageNo:   pagination.PageNo,
@@ -306,7 +406,7 @@ func (n *ConfigStore) getAllWithAppId(ctx context.Context, pagination *Paginatio
 }
 
 func (n *ConfigStore) getAllWithGroup(ctx context.Context, group string, pagination *Pagination) ([]*configstores.ConfigurationItem, error) {
-	values, err := n.client.SearchConfig(vo.SearchConfigParam{
+	values, err := n.getClient().SearchConfig(vo.SearchConfigParam{
 		Search:   "accurate",
 		AppName:  n.appId,
 		Group:    group,
@@ -335,7 +435,7 @@ func (n *ConfigStore) getAllWithKeys(ctx context.Context, group string, keys []s
 	res := make([]*configstores.ConfigurationItem, 0, len(keys))
 	// todo: make more goroutine to search the configurations.
 	for _, key := range keys {
-		value, err := n.client.GetConfig(vo.ConfigParam{
+		value, err := n.getClient().GetConfig(vo.ConfigParam{
 			DataId:  key,
 			Group:   group,
 			AppName: n.appId,
@@ -376,7 +476,7 @@ func (n *ConfigStore) Set(ctx context.Context, request *configstores.SetRequest)
 		if configItem.Group == "" {
 			return errParamsMissingField("Group")
 		}
-		ok, err := n.client.PublishConfig(vo.ConfigParam{
+		ok, err := n.getClient().PublishConfig(vo.ConfigParam{
 			DataId:  configItem.Key,
 			Group:   configItem.Group,
 			AppName: request.AppId,
@@ -410,7 +510,7 @@ func (n *ConfigStore) Delete(ctx context.Context, request *configstores.DeleteRe
 	}
 
 	for _, key := range request.Keys {
-		ok, err := n.client.DeleteConfig(vo.ConfigParam{
+		ok, err := n.getClient().DeleteConfig(vo.ConfigParam{
 			DataId:  key,
 			Group:   request.Group,
 			AppName: request.AppId,
@@ -468,7 +568,7 @@ type subscriberKey struct {
 }
 
 func (n *ConfigStore) subscribeKey(item *configstores.ConfigurationItem, ch chan *configstores.SubscribeResp) error {
-	err := n.client.ListenConfig(vo.ConfigParam{
+	err := n.getClient().ListenConfig(vo.ConfigParam{
 		DataId:   item.Key,
 		Group:    item.Group,
 		AppName:  n.appId,
@@ -479,7 +579,7 @@ func (n *ConfigStore) subscribeKey(item *configstores.ConfigurationItem, ch chan
 		return err
 	}
 
-	n.listener.Store(subscriberKey{key: item.Key, group: item.Group}, struct{}{})
+	n.listener.Store(subscriberKey{key: item.Key, group: item.Group}, ch)
 	return nil
 }
 
@@ -508,7 +608,7 @@ func (n *ConfigStore) StopSubscribe() {
 	// stop listening all subscribed configs
 	n.listener.Range(func(key, value any) bool {
 		subscribe := key.(subscriberKey)
-		if err := n.client.CancelListenConfig(vo.ConfigParam{
+		if err := n.getClient().CancelListenConfig(vo.ConfigParam{
 			DataId:  subscribe.key,
 			Group:   subscribe.group,
 			AppName: n.appId,
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/config_client"
 	"github.com/nacos-group/nacos-sdk-go/v2/model"
 	"github.com/nacos-group/nacos-sdk-go/v2/vo"
 	"github.com/stretchr/testify/assert"
@@ -606,6 +607,56 @@ func TestNacosConfigStore_StopSubscribe(t *testing.T) {
 	assert.EqualValues(t, 0, length)
 }
 
+func TestNacosConfigStore_ApplyConfig(t *testing.T) {
+	req := &configstores.SubscribeReq{
+		AppId: appName,
+		Group: "test-apply-config-group",
+		Keys:  []string{"1", "2"},
+	}
+	oldClient := getMockNacosClient(t)
+	oldClient.EXPECT().ListenConfig(gomock.Any()).Return(nil).Times(len(req.Keys))
+	oldClient.EXPECT().GetConfig(gomock.Any()).Return("content", nil).Times(len(req.Keys))
+	store := setup(t, oldClient)
+	ch := make(chan *configstores.SubscribeResp, 10)
+	err := store.Subscribe(req, ch)
+	assert.Nil(t, err)
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		err := store.ApplyConfig(context.Background(), map[string]string{logLevelKey: "unknown"})
+		assert.Error(t, err)
+		assert.Equal(t, oldClient, store.getClient())
+	})
+
+	t.Run("rollback when listening fails", func(t *testing.T) {
+		newClient := getMockNacosClient(t)
+		newClient.EXPECT().ListenConfig(gomock.Any()).Return(nil)
+		newClient.EXPECT().ListenConfig(gomock.Any()).Return(errors.New("listen failed"))
+		newClient.EXPECT().CancelListenConfig(gomock.Any()).Return(nil)
+		newClient.EXPECT().CloseClient()
+		store.newClient = func(config *configstores.StoreConfig, metadata *Metadata) (config_client.IConfigClient, error) {
+			return newClient, nil
+		}
+		err := store.ApplyConfig(context.Background(), map[string]string{userNameKey: "user"})
+		assert.Error(t, err)
+		assert.Equal(t, oldClient, store.getClient())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		newClient := getMockNacosClient(t)
+		newClient.EXPECT().ListenConfig(gomock.Any()).Return(nil).Times(len(req.Keys))
+		oldClient.EXPECT().CancelListenConfig(gomock.Any()).Return(nil).Times(len(req.Keys))
+		oldClient.EXPECT().CloseClient()
+		store.newClient = func(config *configstores.StoreConfig, metadata *Metadata) (config_client.IConfigClient, error) {
+			assert.Equal(t, "user", config.Metadata[userNameKey])
+			assert.Equal(t, "user", metadata.Username)
+			return newClient, nil
+		}
+		err := store.ApplyConfig(context.Background(), map[string]string{userNameKey: "user"})
+		assert.Nil(t, err)
+		assert.Equal(t, newClient, store.getClient())
+	})
+}
+
 // 由于vo.ConfigParam中存在函数指针的影响，所以自定义方法去进行 matcher 比较
 func EqConfigParam(param vo.ConfigParam) gomock.Matcher {
 	return &configParamMatcher{expected: param}
@@ -16,6 +16,10 @@
 
 package configstores
 
+import (
+	"mosn.io/layotto/components/ref"
+)
+
 // StoreConfig wraps configuration for a store implementation
 type StoreConfig struct {
 	Type      string            `json:"type"`
@@ -24,6 +28,10 @@ type StoreConfig struct {
 	Address   []string          `json:"address"`
 	TimeOut   string            `json:"timeout"`
 	Metadata  map[string]string `json:"metadata"`
+	// GrayRules resolve the default label from the attributes of the sidecar instance
+	GrayRules []*GrayRule `json:"gray_rules,omitempty"`
+	// SecretRef injects secrets into Metadata
+	SecretRef []*ref.SecretRefConfig `json:"secret_ref,omitempty"`
 }
 
 // GetRequest is the object describing a get configuration request
@@ -21,8 +21,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"mosn.io/layotto/components/pkg/actuators"
 
@@ -91,10 +93,6 @@ func (s *AliyunFile) Init(ctx context.Context, metadata *file.FileConfig) error
 }
 
 func (s *AliyunFile) Put(ctx context.Context, st *file.PutFileStu) error {
-	storageType := st.Metadata[storageTypeKey]
-	if storageType == "" {
-		storageType = "Standard"
-	}
 	bucket, err := s.getBucket(st.FileName, st.Metadata)
 	if err != nil {
 		return fmt.Errorf("put file[%s] fail,err: %s", st.FileName, err.Error())
@@ -103,7 +101,7 @@ func (s *AliyunFile) Put(ctx context.Context, st *file.PutFileStu) error {
 	if err != nil {
 		return fmt.Errorf("put file[%s] fail,err: %s", st.FileName, err.Error())
 	}
-	err = bucket.PutObject(fileNameWithoutBucket, st.DataStream, oss.ObjectStorageClass(oss.StorageClassType(storageType)), oss.ObjectACL(oss.ACLPublicRead))
+	err = bucket.PutObject(fileNameWithoutBucket, st.DataStream, putOptions(st.Metadata)...)
 	if err != nil {
 		return fmt.Errorf("put file[%s] fail,err: %s", st.FileName, err.Error())
 	}
@@ -111,6 +109,28 @@ func (s *AliyunFile) Put(ctx context.Context, st *file.PutFileStu) error {
 	return nil
 }
 
+// PutMultipart uploads the parts of the file in parallel by the multipart api
+func (s *AliyunFile) PutMultipart(ctx context.Context, st *file.PutFileStu, config *file.MultipartConfig) error {
+	bucket, err := s.getBucket(st.FileName, st.Metadata)
+	if err != nil {
+		return fmt.Errorf("put file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	fileNameWithoutBucket, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return fmt.Errorf("put file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	p := &partUploader{bucket: bucket, options: putOptions(st.Metadata)}
+	return util.ParallelUpload(ctx, p, fileNameWithoutBucket, st.DataStream, config.PartSize, config.Concurrency)
+}
+
+func putOptions(metadata map[string]string) []oss.Option {
+	storageType := metadata[storageTypeKey]
+	if storageType == "" {
+		storageType = "Standard"
+	}
+	return []oss.Option{oss.ObjectStorageClass(oss.StorageClassType(storageType)), oss.ObjectACL(oss.ACLPublicRead)}
+}
+
 func (s *AliyunFile) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
 	bucket, err := s.getBucket(st.FileName, st.Metadata)
 	if err != nil {
@@ -167,6 +187,33 @@ func (s *AliyunFile) Del(ctx context.Context, request *file.DelRequest) error {
 	return nil
 }
 
+// Copy copies the object by the server side copy of oss
+func (s *AliyunFile) Copy(ctx context.Context, request *file.CopyRequest) error {
+	srcBucket, err := util.GetBucketName(request.Source)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.Source, err.Error())
+	}
+	srcKey, err := util.GetFileName(request.Source)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.Source, err.Error())
+	}
+	bucket, err := s.getBucket(request.Target, request.Metadata)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.Target, err.Error())
+	}
+	key, err := util.GetFileName(request.Target)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.Target, err.Error())
+	}
+	_, err = bucket.CopyObjectFrom(srcBucket, srcKey, key)
+	return err
+}
+
+// Rename copies the object and then deletes the source, it is not atomic
+func (s *AliyunFile) Rename(ctx context.Context, request *file.RenameRequest) error {
+	return util.CopyAndDelete(ctx, s, request)
+}
+
 func (s *AliyunFile) Stat(ctx context.Context, request *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	resp := &file.FileMetaResp{}
 	resp.Metadata = make(map[string][]string)
@@ -207,6 +254,26 @@ func (s *AliyunFile) Stat(ctx context.Context, request *file.FileMetaRequest) (*
 	return resp, nil
 }
 
+// SignURL presigns the url getting or putting the object
+func (s *AliyunFile) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	bucket, err := s.getBucket(st.FileName, st.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("sign file[%s] fail, err: %s", st.FileName, err.Error())
+	}
+	fileNameWithoutBucket, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return "", fmt.Errorf("sign file[%s] fail, err: %s", st.FileName, err.Error())
+	}
+	if st.Method != http.MethodGet && st.Method != http.MethodPut {
+		return "", fmt.Errorf("sign file[%s] fail, err: unsupported method %s", st.FileName, st.Method)
+	}
+	url, err := bucket.SignURL(fileNameWithoutBucket, oss.HTTPMethod(st.Method), int64(st.Expires/time.Second))
+	if err != nil {
+		return "", fmt.Errorf("sign file[%s] fail, err: %s", st.FileName, err.Error())
+	}
+	return url, nil
+}
+
 func (s *AliyunFile) checkMetadata(m *utils.OssMetadata) bool {
 	if m.AccessKeySecret == "" || m.Endpoint == "" || m.AccessKeyID == "" {
 		return false
@@ -20,6 +20,7 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"mosn.io/layotto/components/pkg/utils"
 
@@ -205,3 +206,39 @@ func TestDel(t *testing.T) {
 	err = oss.Del(context.Background(), req)
 	assert.Error(t, err)
 }
+
+func TestSignURL(t *testing.T) {
+	fc := file.FileConfig{Metadata: []byte(data)}
+	oss := NewAliyunFile()
+	assert.Nil(t, oss.Init(context.TODO(), &fc))
+	signer := oss.(file.Signer)
+
+	url, err := signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bbbb/a.txt", Method: "GET", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "a.txt")
+	assert.Contains(t, url, "Signature=")
+	url, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bbbb/a.txt", Method: "PUT", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "Signature=")
+
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bbbb/a.txt", Method: "DELETE", Expires: time.Minute})
+	assert.Error(t, err)
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: fileName, Method: "GET", Expires: time.Minute})
+	assert.Error(t, err)
+}
+
+func TestCopy(t *testing.T) {
+	fc := file.FileConfig{}
+	oss := NewAliyunFile()
+	fc.Metadata = []byte(data)
+	err := oss.Init(context.TODO(), &fc)
+	assert.Nil(t, err)
+	copier := oss.(file.Copier)
+
+	err = copier.Copy(context.Background(), &file.CopyRequest{Source: "a.txt", Target: "bucket/b.txt"})
+	assert.Error(t, err)
+	err = copier.Copy(context.Background(), &file.CopyRequest{Source: "bucket/a.txt", Target: "b.txt"})
+	assert.Error(t, err)
+	err = oss.(file.Renamer).Rename(context.Background(), &file.RenameRequest{Source: "a.txt", Target: "bucket/b.txt"})
+	assert.Error(t, err)
+}
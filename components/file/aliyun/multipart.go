@@ -0,0 +1,66 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aliyun
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+
+	"mosn.io/layotto/components/file/util"
+)
+
+// partUploader implements util.PartUploader with the multipart api of oss
+type partUploader struct {
+	bucket *oss.Bucket
+	// options of the uploaded object
+	options []oss.Option
+}
+
+func (p *partUploader) imur(key, uploadID string) oss.InitiateMultipartUploadResult {
+	return oss.InitiateMultipartUploadResult{Bucket: p.bucket.BucketName, Key: key, UploadID: uploadID}
+}
+
+func (p *partUploader) CreateUpload(ctx context.Context, key string) (string, error) {
+	imur, err := p.bucket.InitiateMultipartUpload(key, p.options...)
+	if err != nil {
+		return "", err
+	}
+	return imur.UploadID, nil
+}
+
+func (p *partUploader) UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (util.Part, error) {
+	part, err := p.bucket.UploadPart(p.imur(key, uploadID), bytes.NewReader(data), int64(len(data)), number)
+	if err != nil {
+		return util.Part{}, err
+	}
+	return util.Part{Number: number, Size: int64(len(data)), ETag: part.ETag}, nil
+}
+
+func (p *partUploader) CompleteUpload(ctx context.Context, key, uploadID string, parts []util.Part) error {
+	completed := make([]oss.UploadPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, oss.UploadPart{PartNumber: part.Number, ETag: part.ETag})
+	}
+	_, err := p.bucket.CompleteMultipartUpload(p.imur(key, uploadID), completed)
+	return err
+}
+
+func (p *partUploader) AbortUpload(ctx context.Context, key, uploadID string) error {
+	return p.bucket.AbortMultipartUpload(p.imur(key, uploadID))
+}
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 
@@ -175,6 +177,113 @@ func (a *AwsOss) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, e
 	return ob.Body, nil
 }
 
+// GetRange reads a byte range of the object from aws oss.
+func (a *AwsOss) GetRange(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	if st.Offset < 0 || st.Length < 0 {
+		return nil, file.ErrInvalidRange
+	}
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, err
+	}
+	input := &s3.GetObjectInput{Bucket: &bucket, Key: &key}
+	if st.Offset > 0 || st.Length > 0 {
+		input.Range = aws.String(util.HttpRange(st.Offset, st.Length))
+	}
+	ob, err := client.GetObject(ctx, input)
+	if err != nil {
+		if strings.Contains(err.Error(), "InvalidRange") {
+			return nil, file.ErrInvalidRange
+		}
+		return nil, err
+	}
+	return ob.Body, nil
+}
+
+// SignURL presigns the url getting or putting the object.
+func (a *AwsOss) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return "", fmt.Errorf("aws.s3 sign file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return "", fmt.Errorf("aws.s3 sign file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return "", err
+	}
+	presignClient := s3.NewPresignClient(client)
+	switch st.Method {
+	case http.MethodGet:
+		req, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{Bucket: &bucket, Key: &key}, s3.WithPresignExpires(st.Expires))
+		if err != nil {
+			return "", err
+		}
+		return req.URL, nil
+	case http.MethodPut:
+		req, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{Bucket: &bucket, Key: &key}, s3.WithPresignExpires(st.Expires))
+		if err != nil {
+			return "", err
+		}
+		return req.URL, nil
+	default:
+		return "", fmt.Errorf("aws.s3 sign file[%s] fail,err: unsupported method %s", st.FileName, st.Method)
+	}
+}
+
+// UploadOffset returns the size of the parts persisted by the unfinished multipart upload of the file.
+func (a *AwsOss) UploadOffset(ctx context.Context, st *file.FileMetaRequest) (int64, error) {
+	m, key, err := a.multipart(st.FileName)
+	if err != nil {
+		return 0, err
+	}
+	return util.UploadedSize(ctx, m, key)
+}
+
+// ResumePut resumes the multipart upload of the file from the offset.
+func (a *AwsOss) ResumePut(ctx context.Context, st *file.PutFileStu) error {
+	m, key, err := a.multipart(st.FileName)
+	if err != nil {
+		return err
+	}
+	return util.ResumeUpload(ctx, m, key, st.DataStream, st.Offset, util.MinPartSize)
+}
+
+// PutMultipart uploads the parts of the file in parallel by the multipart api.
+func (a *AwsOss) PutMultipart(ctx context.Context, st *file.PutFileStu, config *file.MultipartConfig) error {
+	m, key, err := a.multipart(st.FileName)
+	if err != nil {
+		return err
+	}
+	return util.ParallelUpload(ctx, m, key, st.DataStream, config.PartSize, config.Concurrency)
+}
+
+func (a *AwsOss) multipart(fileName string) (*multipart, string, error) {
+	bucket, err := util.GetBucketName(fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("aws.s3 upload file[%s] fail,err: %s", fileName, err.Error())
+	}
+	key, err := util.GetFileName(fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("aws.s3 upload file[%s] fail,err: %s", fileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, "", err
+	}
+	return &multipart{client: client, bucket: bucket}, key, nil
+}
+
 // List objects from aws oss.
 func (a *AwsOss) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	bucket, err := util.GetBucketName(st.DirectoryName)
@@ -230,6 +339,43 @@ func (a *AwsOss) Del(ctx context.Context, st *file.DelRequest) error {
 	}
 	return nil
 }
+
+// Copy copies the object by the server side copy of s3, which is limited to the objects up to 5GB.
+func (a *AwsOss) Copy(ctx context.Context, st *file.CopyRequest) error {
+	srcBucket, err := util.GetBucketName(st.Source)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", st.Source, err.Error())
+	}
+	srcKey, err := util.GetFileName(st.Source)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", st.Source, err.Error())
+	}
+	bucket, err := util.GetBucketName(st.Target)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", st.Target, err.Error())
+	}
+	key, err := util.GetFileName(st.Target)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", st.Target, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return err
+	}
+	input := &s3.CopyObjectInput{
+		Bucket:     &bucket,
+		Key:        &key,
+		CopySource: aws.String(url.PathEscape(srcBucket + "/" + srcKey)),
+	}
+	_, err = client.CopyObject(ctx, input)
+	return err
+}
+
+// Rename copies the object and then deletes the source, it is not atomic.
+func (a *AwsOss) Rename(ctx context.Context, st *file.RenameRequest) error {
+	return util.CopyAndDelete(ctx, a, st)
+}
+
 func (a *AwsOss) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	bucket, err := util.GetBucketName(st.FileName)
 	if err != nil {
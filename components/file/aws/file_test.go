@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"mosn.io/layotto/components/pkg/utils"
 
@@ -104,6 +105,27 @@ func TestAwsOss_Get(t *testing.T) {
 	assert.Equal(t, err.Error(), "aws.s3 get file[/a.txt] fail,err: invalid fileName format")
 }
 
+func TestAwsOss_SignURL(t *testing.T) {
+	oss := NewAwsFile()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: []byte(cfg)})
+	assert.Nil(t, err)
+	signer := oss.(file.Signer)
+
+	url, err := signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket1/a.txt", Method: "GET", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "a.txt")
+	assert.Contains(t, url, "X-Amz-Signature=")
+	assert.Contains(t, url, "X-Amz-Expires=60")
+	url, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket1/a.txt", Method: "PUT", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "X-Amz-Signature=")
+
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket1/a.txt", Method: "DELETE", Expires: time.Minute})
+	assert.Error(t, err)
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "/a.txt", Method: "GET", Expires: time.Minute})
+	assert.Equal(t, "aws.s3 sign file[/a.txt] fail,err: invalid fileName format", err.Error())
+}
+
 type fun = func() (string, error)
 
 func TestCopier(t *testing.T) {
@@ -124,3 +146,17 @@ func TestCopier(t *testing.T) {
 	fmt.Println(target)
 
 }
+
+func TestAwsOss_Copy(t *testing.T) {
+	oss := NewAwsFile()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: []byte(cfg)})
+	assert.Equal(t, nil, err)
+	c := oss.(file.Copier)
+
+	err = c.Copy(context.Background(), &file.CopyRequest{Source: "/a.txt", Target: "bucket/b.txt"})
+	assert.Equal(t, "aws.s3 copy file[/a.txt] fail,err: invalid fileName format", err.Error())
+	err = c.Copy(context.Background(), &file.CopyRequest{Source: "bucket/a.txt", Target: "b.txt"})
+	assert.Equal(t, "aws.s3 copy file[b.txt] fail,err: invalid fileName format", err.Error())
+	err = oss.(file.Renamer).Rename(context.Background(), &file.RenameRequest{Source: "a.txt", Target: "bucket/b.txt"})
+	assert.Equal(t, "aws.s3 copy file[a.txt] fail,err: invalid fileName format", err.Error())
+}
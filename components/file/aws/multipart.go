@@ -0,0 +1,115 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"mosn.io/layotto/components/file/util"
+)
+
+// multipart implements util.Multipart with the multipart api of s3
+type multipart struct {
+	client *s3.Client
+	bucket string
+}
+
+func (m *multipart) FindUpload(ctx context.Context, key string) (string, error) {
+	input := &s3.ListMultipartUploadsInput{Bucket: &m.bucket, Prefix: &key}
+	var uploadID string
+	var initiated int64
+	for {
+		out, err := m.client.ListMultipartUploads(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		for _, u := range out.Uploads {
+			if aws.ToString(u.Key) != key {
+				continue
+			}
+			if t := aws.ToTime(u.Initiated).UnixNano(); uploadID == "" || t > initiated {
+				uploadID, initiated = aws.ToString(u.UploadId), t
+			}
+		}
+		if !out.IsTruncated {
+			return uploadID, nil
+		}
+		input.KeyMarker, input.UploadIdMarker = out.NextKeyMarker, out.NextUploadIdMarker
+	}
+}
+
+func (m *multipart) CreateUpload(ctx context.Context, key string) (string, error) {
+	out, err := m.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{Bucket: &m.bucket, Key: &key})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.UploadId), nil
+}
+
+func (m *multipart) ListParts(ctx context.Context, key, uploadID string) ([]util.Part, error) {
+	var parts []util.Part
+	paginator := s3.NewListPartsPaginator(m.client, &s3.ListPartsInput{Bucket: &m.bucket, Key: &key, UploadId: &uploadID})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.Parts {
+			parts = append(parts, util.Part{Number: int(p.PartNumber), Size: p.Size, ETag: aws.ToString(p.ETag)})
+		}
+	}
+	return parts, nil
+}
+
+func (m *multipart) UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (util.Part, error) {
+	out, err := m.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        &m.bucket,
+		Key:           &key,
+		UploadId:      &uploadID,
+		PartNumber:    int32(number),
+		Body:          bytes.NewReader(data),
+		ContentLength: int64(len(data)),
+	})
+	if err != nil {
+		return util.Part{}, err
+	}
+	return util.Part{Number: number, Size: int64(len(data)), ETag: aws.ToString(out.ETag)}, nil
+}
+
+func (m *multipart) CompleteUpload(ctx context.Context, key, uploadID string, parts []util.Part) error {
+	completed := make([]types.CompletedPart, 0, len(parts))
+	for _, p := range parts {
+		completed = append(completed, types.CompletedPart{PartNumber: int32(p.Number), ETag: aws.String(p.ETag)})
+	}
+	_, err := m.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          &m.bucket,
+		Key:             &key,
+		UploadId:        &uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	return err
+}
+
+func (m *multipart) AbortUpload(ctx context.Context, key, uploadID string) error {
+	_, err := m.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{Bucket: &m.bucket, Key: &key, UploadId: &uploadID})
+	return err
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"hash"
+	"hash/crc64"
+	"io"
+	"strconv"
+)
+
+// Checksum holds the expected digests of the content of a file, the empty ones are not verified
+type Checksum struct {
+	// MD5 is the hex encoded md5 digest
+	MD5 string
+	// CRC64 is the decimal crc64 with the ECMA polynomial, as used by the object stores
+	CRC64 string
+}
+
+// IsEmpty returns whether there is nothing to verify
+func (c Checksum) IsEmpty() bool {
+	return c.MD5 == "" && c.CRC64 == ""
+}
+
+// Verify reads r to the end and checks its content against the checksum
+func (c Checksum) Verify(r io.Reader) error {
+	v := NewVerifyingReader(r, c)
+	if _, err := io.Copy(io.Discard, v); err != nil {
+		return err
+	}
+	return nil
+}
+
+// VerifyingReader checks the data read against a checksum,
+// it returns ErrChecksumMismatch instead of io.EOF if the data doesn't match
+// so that the components don't commit a corrupted file
+type VerifyingReader struct {
+	r        io.Reader
+	checksum Checksum
+	md5      hash.Hash
+	crc64    hash.Hash64
+	err      error
+}
+
+// NewVerifyingReader wraps r with the verification of the checksum
+func NewVerifyingReader(r io.Reader, c Checksum) *VerifyingReader {
+	v := &VerifyingReader{r: r, checksum: c}
+	if c.MD5 != "" {
+		v.md5 = md5.New()
+	}
+	if c.CRC64 != "" {
+		v.crc64 = crc64.New(crc64.MakeTable(crc64.ECMA))
+	}
+	return v
+}
+
+func (v *VerifyingReader) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	n, err := v.r.Read(p)
+	if n > 0 {
+		if v.md5 != nil {
+			v.md5.Write(p[:n])
+		}
+		if v.crc64 != nil {
+			v.crc64.Write(p[:n])
+		}
+	}
+	if err == io.EOF {
+		err = v.verify()
+	}
+	return n, err
+}
+
+// Err returns ErrChecksumMismatch once the data read is known not to match the checksum
+func (v *VerifyingReader) Err() error {
+	return v.err
+}
+
+func (v *VerifyingReader) verify() error {
+	if v.md5 != nil && hex.EncodeToString(v.md5.Sum(nil)) != v.checksum.MD5 {
+		v.err = ErrChecksumMismatch
+		return v.err
+	}
+	if v.crc64 != nil && strconv.FormatUint(v.crc64.Sum64(), 10) != v.checksum.CRC64 {
+		v.err = ErrChecksumMismatch
+		return v.err
+	}
+	return io.EOF
+}
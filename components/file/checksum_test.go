@@ -0,0 +1,81 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyingReader(t *testing.T) {
+	// md5 and crc64 (ECMA) of "hello"
+	c := Checksum{MD5: "5d41402abc4b2a76b9719d911017c592", CRC64: "11177612005948864433"}
+	assert.Nil(t, c.Verify(strings.NewReader("hello")))
+	assert.Equal(t, ErrChecksumMismatch, c.Verify(strings.NewReader("hellO")))
+	assert.Equal(t, ErrChecksumMismatch, Checksum{CRC64: "1"}.Verify(strings.NewReader("hello")))
+
+	v := NewVerifyingReader(strings.NewReader("hellO"), Checksum{MD5: "5d41402abc4b2a76b9719d911017c592"})
+	data, err := io.ReadAll(v)
+	assert.Equal(t, ErrChecksumMismatch, err)
+	assert.Equal(t, "hellO", string(data))
+	assert.Equal(t, ErrChecksumMismatch, v.Err())
+
+	assert.True(t, Checksum{}.IsEmpty())
+	assert.Nil(t, Checksum{}.Verify(strings.NewReader("hello")))
+}
+
+type fakeFile struct {
+	File
+	data string
+}
+
+func (f *fakeFile) Get(context.Context, *GetFileStu) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(f.data)), nil
+}
+
+func TestGetRange(t *testing.T) {
+	f := &fakeFile{data: "hello world"}
+	read := func(offset, length int64) (string, error) {
+		rc, err := GetRange(context.TODO(), f, &GetFileStu{Offset: offset, Length: length})
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		return string(data), err
+	}
+	data, err := read(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", data)
+	data, err = read(6, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "world", data)
+	data, err = read(2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "llo", data)
+	data, err = read(11, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "", data)
+	_, err = read(12, 0)
+	assert.Equal(t, ErrInvalidRange, err)
+	_, err = read(0, -1)
+	assert.Equal(t, ErrInvalidRange, err)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	CompressionNone = ""
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+func validCompression(c string) bool {
+	return c == CompressionNone || c == CompressionGzip || c == CompressionZstd
+}
+
+// extension is appended to the content path, so that the same content compressed differently is stored apart
+func extension(c string) string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionZstd:
+		return ".zst"
+	}
+	return ""
+}
+
+// compressWriter returns a writer compressing the data to w, it must be closed to flush the data
+func compressWriter(c string, w io.Writer) (io.WriteCloser, error) {
+	switch c {
+	case CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	}
+	return nil, fmt.Errorf("unknown compression %s", c)
+}
+
+// decompressReader returns a reader decompressing the data of rc, closing it closes rc
+func decompressReader(c string, rc io.ReadCloser) (io.ReadCloser, error) {
+	switch c {
+	case CompressionNone:
+		return rc, nil
+	case CompressionGzip:
+		r, err := gzip.NewReader(rc)
+		if err != nil {
+			return nil, err
+		}
+		return &readCloser{Reader: r, close: func() error {
+			r.Close()
+			return rc.Close()
+		}}, nil
+	case CompressionZstd:
+		r, err := zstd.NewReader(rc)
+		if err != nil {
+			return nil, err
+		}
+		return &readCloser{Reader: r, close: func() error {
+			r.Close()
+			return rc.Close()
+		}}, nil
+	}
+	return nil, fmt.Errorf("unknown compression %s", c)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r *readCloser) Close() error {
+	return r.close()
+}
@@ -0,0 +1,380 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/pkg/actuators"
+)
+
+const (
+	componentName = "file-dedup"
+	// ContentHash is the key of the sha256 of the content in the metadata of Stat
+	ContentHash = "ContentHash"
+)
+
+var (
+	ErrMissingStore = errors.New("store is required for dedup file")
+	ErrMissingDir   = errors.New("contentDir and indexDir are required for dedup file")
+
+	once               sync.Once
+	readinessIndicator *actuators.HealthIndicator
+	livenessIndicator  *actuators.HealthIndicator
+)
+
+func init() {
+	readinessIndicator = actuators.NewHealthIndicator()
+	livenessIndicator = actuators.NewHealthIndicator()
+}
+
+// Metadata is the config of the dedup file
+type Metadata struct {
+	// Store is the config of the wrapped file component
+	Store *file.FileConfig `json:"store"`
+	// ContentDir is the directory of the content in the wrapped store, the content is stored under its sha256
+	ContentDir string `json:"contentDir"`
+	// IndexDir is the directory of the index in the wrapped store, mapping the file names to the content hashes
+	IndexDir string `json:"indexDir"`
+	// Compression is the algorithm compressing the content, "gzip", "zstd" or empty for no compression
+	Compression string `json:"compression"`
+}
+
+// indexEntry is the content of the index file of a name
+type indexEntry struct {
+	Hash        string `json:"hash"`
+	Size        int64  `json:"size"`
+	Compression string `json:"compression,omitempty"`
+}
+
+// DedupFile wraps a file component to store the identical files once.
+// The content of a file is stored in the content directory under its hash,
+// and the file name is mapped to the hash by a small index file in the index directory.
+// The names referring to a content are counted in a "{hash}.refs" file next to it,
+// the content is deleted once no name refers to it. The counts are updated under a lock of the process,
+// so the wrapped store should not be written by the other runtimes at the same time.
+type DedupFile struct {
+	store file.File
+	meta  Metadata
+	// mu guards the reference counts
+	mu sync.Mutex
+}
+
+func NewDedupFile() file.File {
+	once.Do(func() {
+		indicators := &actuators.ComponentsIndicator{ReadinessIndicator: readinessIndicator, LivenessIndicator: livenessIndicator}
+		actuators.SetComponentsIndicator(componentName, indicators)
+	})
+	return &DedupFile{}
+}
+
+func (d *DedupFile) WrappedConfig(config *file.FileConfig) (*file.FileConfig, error) {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Store, nil
+}
+
+func (d *DedupFile) Wrap(store file.File) {
+	d.store = store
+}
+
+// Close closes the wrapped component
+func (d *DedupFile) Close() error {
+	return file.CloseWrapped(d.store)
+}
+
+func (d *DedupFile) Init(ctx context.Context, config *file.FileConfig) error {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	if d.store == nil {
+		return ErrMissingStore
+	}
+	d.meta = *meta
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func parseMetadata(config *file.FileConfig) (*Metadata, error) {
+	meta := &Metadata{}
+	if err := json.Unmarshal(config.Metadata, meta); err != nil {
+		return nil, err
+	}
+	if meta.Store == nil {
+		return nil, ErrMissingStore
+	}
+	if meta.ContentDir == "" || meta.IndexDir == "" {
+		return nil, ErrMissingDir
+	}
+	if !validCompression(meta.Compression) {
+		return nil, errors.New("unknown compression " + meta.Compression)
+	}
+	return meta, nil
+}
+
+// Put hashes and compresses the data to a temporary file, and stores it unless the same content is already stored.
+// The previous content of the name is released after the index refers to the new one
+func (d *DedupFile) Put(ctx context.Context, st *file.PutFileStu) error {
+	tmp, err := ioutil.TempFile("", "layotto-dedup-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+	w, err := compressWriter(d.meta.Compression, tmp)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(h, w), st.DataStream)
+	if err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	entry := &indexEntry{Hash: hex.EncodeToString(h.Sum(nil)), Size: size, Compression: d.meta.Compression}
+
+	content := d.contentPath(entry)
+	// the content is uploaded again if Stat fails for any reason, storing it twice is harmless
+	upload := func() error {
+		if _, err := d.store.Stat(ctx, &file.FileMetaRequest{FileName: content, Metadata: st.Metadata}); err == nil {
+			return nil
+		}
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		return d.store.Put(ctx, &file.PutFileStu{DataStream: tmp, FileName: content, Metadata: st.Metadata})
+	}
+	if err = upload(); err != nil {
+		return err
+	}
+	// the name may refer to the same content already
+	old, err := d.readIndex(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		old = nil
+	}
+	if old == nil || d.contentPath(old) != content {
+		if err = d.addRef(ctx, entry, upload, st.Metadata); err != nil {
+			return err
+		}
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = d.store.Put(ctx, &file.PutFileStu{DataStream: bytes.NewReader(data), FileName: d.indexPath(st.FileName), Metadata: st.Metadata}); err != nil {
+		return err
+	}
+	if old != nil && d.contentPath(old) != content {
+		// a failed release only leaves the previous content behind
+		d.releaseRef(ctx, old, st.Metadata)
+	}
+	return nil
+}
+
+func (d *DedupFile) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	entry, err := d.readIndex(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.store.Get(ctx, &file.GetFileStu{FileName: d.contentPath(entry), Metadata: st.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	r, err := decompressReader(entry.Compression, data)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// List lists the index, the sizes of the files are read from their index files
+func (d *DedupFile) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	resp, err := d.store.List(ctx, &file.ListRequest{
+		DirectoryName: d.indexPath(st.DirectoryName),
+		Marker:        st.Marker,
+		PageSize:      st.PageSize,
+		Metadata:      st.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range resp.Files {
+		name, ok := d.trimIndexDir(info.FileName)
+		if ok {
+			info.FileName = name
+		} else {
+			// the components listing the base names
+			name = path.Join(st.DirectoryName, info.FileName)
+		}
+		entry, err := d.readIndex(ctx, name, st.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		info.Size = entry.Size
+	}
+	return resp, nil
+}
+
+// Del removes the index file of the file, and the content if no other name refers to it
+func (d *DedupFile) Del(ctx context.Context, st *file.DelRequest) error {
+	entry, err := d.readIndex(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		return err
+	}
+	if err = d.store.Del(ctx, &file.DelRequest{FileName: d.indexPath(st.FileName), Metadata: st.Metadata}); err != nil {
+		return err
+	}
+	return d.releaseRef(ctx, entry, st.Metadata)
+}
+
+func (d *DedupFile) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
+	resp, err := d.store.Stat(ctx, &file.FileMetaRequest{FileName: d.indexPath(st.FileName), Metadata: st.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	entry, err := d.readIndex(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	resp.Size = entry.Size
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string][]string)
+	}
+	resp.Metadata[ContentHash] = []string{entry.Hash}
+	return resp, nil
+}
+
+func (d *DedupFile) readIndex(ctx context.Context, name string, metadata map[string]string) (*indexEntry, error) {
+	data, err := d.store.Get(ctx, &file.GetFileStu{FileName: d.indexPath(name), Metadata: metadata})
+	if err != nil {
+		return nil, err
+	}
+	defer data.Close()
+	entry := &indexEntry{}
+	if err = json.NewDecoder(data).Decode(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// addRef counts a reference of the content, the content collected since it is uploaded is uploaded again by upload
+func (d *DedupFile) addRef(ctx context.Context, entry *indexEntry, upload func() error, metadata map[string]string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	refs, err := d.readRefs(ctx, entry, metadata)
+	if err != nil {
+		return err
+	}
+	if refs == 0 {
+		if err = upload(); err != nil {
+			return err
+		}
+	}
+	return d.writeRefs(ctx, entry, refs+1, metadata)
+}
+
+// releaseRef removes a reference of the content, the content is deleted with its count once it is not referred
+func (d *DedupFile) releaseRef(ctx context.Context, entry *indexEntry, metadata map[string]string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	refs, err := d.readRefs(ctx, entry, metadata)
+	if err != nil {
+		return err
+	}
+	if refs > 1 {
+		return d.writeRefs(ctx, entry, refs-1, metadata)
+	}
+	if err = d.store.Del(ctx, &file.DelRequest{FileName: d.contentPath(entry), Metadata: metadata}); err != nil {
+		return err
+	}
+	return d.store.Del(ctx, &file.DelRequest{FileName: d.refsPath(entry), Metadata: metadata})
+}
+
+// readRefs returns the reference count of the content, it is 0 if the count doesn't exist
+func (d *DedupFile) readRefs(ctx context.Context, entry *indexEntry, metadata map[string]string) (int64, error) {
+	name := d.refsPath(entry)
+	if _, err := d.store.Stat(ctx, &file.FileMetaRequest{FileName: name, Metadata: metadata}); err != nil {
+		if err == file.ErrNotExist {
+			return 0, nil
+		}
+		return 0, err
+	}
+	data, err := d.store.Get(ctx, &file.GetFileStu{FileName: name, Metadata: metadata})
+	if err != nil {
+		return 0, err
+	}
+	defer data.Close()
+	b, err := ioutil.ReadAll(data)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(b), 10, 64)
+}
+
+func (d *DedupFile) writeRefs(ctx context.Context, entry *indexEntry, refs int64, metadata map[string]string) error {
+	data := strings.NewReader(strconv.FormatInt(refs, 10))
+	return d.store.Put(ctx, &file.PutFileStu{DataStream: data, FileName: d.refsPath(entry), Metadata: metadata})
+}
+
+func (d *DedupFile) contentPath(entry *indexEntry) string {
+	return path.Join(d.meta.ContentDir, entry.Hash+extension(entry.Compression))
+}
+
+func (d *DedupFile) refsPath(entry *indexEntry) string {
+	return d.contentPath(entry) + ".refs"
+}
+
+func (d *DedupFile) indexPath(name string) string {
+	return path.Join(d.meta.IndexDir, name)
+}
+
+// trimIndexDir returns the file name of a listed index file,
+// the object stores list the keys without the bucket of the index directory
+func (d *DedupFile) trimIndexDir(name string) (string, bool) {
+	dir := strings.TrimSuffix(d.meta.IndexDir, "/") + "/"
+	if strings.HasPrefix(name, dir) {
+		return name[len(dir):], true
+	}
+	if i := strings.Index(dir, "/"); i > 0 && i < len(dir)-1 && strings.HasPrefix(name, dir[i+1:]) {
+		return name[len(dir)-i-1:], true
+	}
+	return name, false
+}
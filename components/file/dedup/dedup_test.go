@@ -0,0 +1,145 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/filetest"
+)
+
+func newDedupFile(t *testing.T, compression string) (*DedupFile, *filetest.MemoryStore) {
+	store := filetest.NewMemoryStore()
+	store.TrimBucket = true
+	d := NewDedupFile().(*DedupFile)
+	config := &file.FileConfig{Metadata: []byte(`{"store":{"type":"memory"},"contentDir":"bucket/.content","indexDir":"bucket/.index","compression":"` + compression + `"}`)}
+	wrapped, err := d.WrappedConfig(config)
+	require.Nil(t, err)
+	assert.Equal(t, "memory", wrapped.Type)
+	d.Wrap(store)
+	require.Nil(t, d.Init(context.TODO(), config))
+	return d, store
+}
+
+func TestDedupFile(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionGzip, CompressionZstd} {
+		t.Run("compression "+compression, func(t *testing.T) {
+			ctx := context.TODO()
+			d, store := newDedupFile(t, compression)
+			content := strings.Repeat("hello world ", 1000)
+
+			for _, name := range []string{"bucket/a.txt", "bucket/dir/b.txt"} {
+				err := d.Put(ctx, &file.PutFileStu{FileName: name, DataStream: strings.NewReader(content)})
+				assert.Nil(t, err)
+			}
+			// the content is stored once with its reference count, plus the two index files
+			assert.Len(t, store.Files(), 4)
+			for name, data := range store.Files() {
+				if strings.HasSuffix(name, ".refs") {
+					assert.Equal(t, "2", string(data))
+				} else if strings.HasPrefix(name, "bucket/.content/") {
+					assert.True(t, strings.HasSuffix(name, extension(compression)))
+					if compression != CompressionNone {
+						assert.Less(t, len(data), len(content))
+					}
+				}
+			}
+
+			rc, err := d.Get(ctx, &file.GetFileStu{FileName: "bucket/dir/b.txt"})
+			require.Nil(t, err)
+			data, err := ioutil.ReadAll(rc)
+			assert.Nil(t, err)
+			assert.Nil(t, rc.Close())
+			assert.Equal(t, content, string(data))
+
+			meta, err := d.Stat(ctx, &file.FileMetaRequest{FileName: "bucket/a.txt"})
+			assert.Nil(t, err)
+			assert.Equal(t, int64(len(content)), meta.Size)
+			assert.Len(t, meta.Metadata[ContentHash][0], 64)
+
+			list, err := d.List(ctx, &file.ListRequest{DirectoryName: "bucket/"})
+			assert.Nil(t, err)
+			require.Len(t, list.Files, 2)
+			assert.Equal(t, "bucket/a.txt", list.Files[0].FileName)
+			assert.Equal(t, "bucket/dir/b.txt", list.Files[1].FileName)
+			assert.Equal(t, int64(len(content)), list.Files[1].Size)
+
+			assert.Nil(t, d.Del(ctx, &file.DelRequest{FileName: "bucket/a.txt"}))
+			_, err = d.Get(ctx, &file.GetFileStu{FileName: "bucket/a.txt"})
+			assert.Equal(t, file.ErrNotExist, err)
+			// the content is still referred by the other name
+			_, err = d.Get(ctx, &file.GetFileStu{FileName: "bucket/dir/b.txt"})
+			assert.Nil(t, err)
+			assert.Len(t, store.Files(), 3)
+
+			// the content is deleted with the last name
+			assert.Nil(t, d.Del(ctx, &file.DelRequest{FileName: "bucket/dir/b.txt"}))
+			assert.Len(t, store.Files(), 0)
+		})
+	}
+}
+
+func TestDedupFileOverwrite(t *testing.T) {
+	ctx := context.TODO()
+	d, store := newDedupFile(t, CompressionNone)
+	put := func(name, content string) {
+		require.Nil(t, d.Put(ctx, &file.PutFileStu{FileName: name, DataStream: strings.NewReader(content)}))
+	}
+	put("bucket/a.txt", "hello")
+	put("bucket/b.txt", "hello")
+	// putting the same content again doesn't count another reference
+	put("bucket/a.txt", "hello")
+	put("bucket/a.txt", "world")
+	contents := func() []string {
+		var names []string
+		for name := range store.Files() {
+			if strings.HasPrefix(name, "bucket/.content/") && !strings.HasSuffix(name, ".refs") {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	assert.Len(t, contents(), 2)
+
+	// the previous content is released when the last name refers to another content
+	put("bucket/b.txt", "world")
+	assert.Len(t, contents(), 1)
+	assert.Nil(t, d.Del(ctx, &file.DelRequest{FileName: "bucket/a.txt"}))
+	assert.Nil(t, d.Del(ctx, &file.DelRequest{FileName: "bucket/b.txt"}))
+	assert.Len(t, store.Files(), 0)
+	assert.Equal(t, file.ErrNotExist, d.Del(ctx, &file.DelRequest{FileName: "bucket/b.txt"}))
+}
+
+func TestDedupFileInit(t *testing.T) {
+	d := NewDedupFile().(*DedupFile)
+	_, err := d.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"contentDir":"c","indexDir":"i"}`)})
+	assert.Equal(t, ErrMissingStore, err)
+	_, err = d.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"store":{"type":"local"},"contentDir":"c"}`)})
+	assert.Equal(t, ErrMissingDir, err)
+	_, err = d.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"store":{"type":"local"},"contentDir":"c","indexDir":"i","compression":"lz4"}`)})
+	assert.EqualError(t, err, "unknown compression lz4")
+	// the wrapped store is set by the runtime before Init
+	err = d.Init(context.TODO(), &file.FileConfig{Metadata: []byte(`{"store":{"type":"local"},"contentDir":"c","indexDir":"i"}`)})
+	assert.Equal(t, ErrMissingStore, err)
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"mosn.io/layotto/components/cryption"
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/pkg/actuators"
+	"mosn.io/layotto/components/pkg/envelope"
+)
+
+const (
+	componentName = "file-encryption"
+)
+
+var (
+	ErrMissingStore = errors.New("store is required for encryption file")
+
+	once               sync.Once
+	readinessIndicator *actuators.HealthIndicator
+	livenessIndicator  *actuators.HealthIndicator
+)
+
+func init() {
+	readinessIndicator = actuators.NewHealthIndicator()
+	livenessIndicator = actuators.NewHealthIndicator()
+}
+
+// Metadata is the config of the encryption file
+type Metadata struct {
+	// Store is the config of the wrapped file component
+	Store *file.FileConfig `json:"store"`
+	// KeyId is the key of the cryption service wrapping the data keys, the default key of the service is used if empty
+	KeyId string `json:"keyId"`
+	// ChunkSize is the size of the plaintext chunks encrypted independently, 64KB by default
+	ChunkSize int `json:"chunkSize"`
+}
+
+// EncryptionFile wraps a file component to encrypt the files before they leave the sidecar.
+// Each file is encrypted by its own data key, which is wrapped by the cryption service
+// referred to by the "cryption_service" of the component_ref.
+// Since the file components don't keep the metadata of the files,
+// the envelope of the data key is written as a header before the encrypted data.
+// List reports the sizes of the stored files, while Stat reports the sizes of the plaintext.
+type EncryptionFile struct {
+	store     file.File
+	cryption  cryption.CryptionService
+	keys      *envelope.KeyManager
+	chunkSize int
+}
+
+func NewEncryptionFile() file.File {
+	once.Do(func() {
+		indicators := &actuators.ComponentsIndicator{ReadinessIndicator: readinessIndicator, LivenessIndicator: livenessIndicator}
+		actuators.SetComponentsIndicator(componentName, indicators)
+	})
+	return &EncryptionFile{}
+}
+
+func (e *EncryptionFile) SetCryptionService(cs cryption.CryptionService) error {
+	e.cryption = cs
+	return nil
+}
+
+func (e *EncryptionFile) WrappedConfig(config *file.FileConfig) (*file.FileConfig, error) {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Store, nil
+}
+
+func (e *EncryptionFile) Wrap(store file.File) {
+	e.store = store
+}
+
+// Close closes the wrapped component
+func (e *EncryptionFile) Close() error {
+	return file.CloseWrapped(e.store)
+}
+
+func (e *EncryptionFile) Init(ctx context.Context, config *file.FileConfig) error {
+	if err := e.init(config); err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (e *EncryptionFile) init(config *file.FileConfig) error {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return err
+	}
+	if e.store == nil {
+		return ErrMissingStore
+	}
+	if e.cryption == nil {
+		return envelope.ErrMissingCryption
+	}
+	e.keys = envelope.NewKeyManager(e.cryption, meta.KeyId)
+	e.chunkSize = meta.ChunkSize
+	return nil
+}
+
+func parseMetadata(config *file.FileConfig) (*Metadata, error) {
+	meta := &Metadata{}
+	if err := json.Unmarshal(config.Metadata, meta); err != nil {
+		return nil, err
+	}
+	if meta.Store == nil {
+		return nil, ErrMissingStore
+	}
+	if meta.ChunkSize < 0 || meta.ChunkSize > envelope.MaxChunkSize {
+		return nil, errors.New("chunkSize of encryption file must be between 0 and 16MB")
+	}
+	return meta, nil
+}
+
+// Put encrypts the data by a new data key, and stores it after the header of the envelope
+func (e *EncryptionFile) Put(ctx context.Context, st *file.PutFileStu) error {
+	key, env, err := e.keys.NewEnvelope(ctx, e.chunkSize)
+	if err != nil {
+		return err
+	}
+	r, err := envelope.NewEncryptReader(st.DataStream, key, env)
+	if err != nil {
+		return err
+	}
+	return e.store.Put(ctx, &file.PutFileStu{
+		DataStream: io.MultiReader(bytes.NewReader(env.Header()), r),
+		FileName:   st.FileName,
+		Metadata:   st.Metadata,
+	})
+}
+
+func (e *EncryptionFile) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	return e.GetRange(ctx, &file.GetFileStu{FileName: st.FileName, Metadata: st.Metadata})
+}
+
+// GetRange decrypts the chunks holding the range, they are read natively if the wrapped store is a file.RangeGetter
+func (e *EncryptionFile) GetRange(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	if st.Offset < 0 || st.Length < 0 {
+		return nil, file.ErrInvalidRange
+	}
+	data, env, err := e.open(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.keys.Unwrap(ctx, env)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	end := int64(-1)
+	if st.Length > 0 {
+		end = st.Offset + st.Length - 1
+	}
+	rg := envelope.NewRange(st.Offset, end, env.ChunkSize)
+	if st.Offset > 0 {
+		// the chunks after the end of the data can't be decrypted, so the offset is checked with the size first
+		size, err := e.plainSize(ctx, st.FileName, st.Metadata, env)
+		if err != nil || st.Offset >= size {
+			data.Close()
+			if err != nil {
+				return nil, err
+			}
+			if st.Offset > size {
+				return nil, file.ErrInvalidRange
+			}
+			return ioutil.NopCloser(bytes.NewReader(nil)), nil
+		}
+		if data, err = e.seek(ctx, st, data, env.HeaderSize(), rg.CipherStart); err != nil {
+			return nil, err
+		}
+	}
+	var src io.Reader = data
+	if rg.CipherEnd >= 0 {
+		src = io.LimitReader(data, rg.CipherEnd-rg.CipherStart+1)
+	}
+	r, err := envelope.NewDecryptReader(src, key, env, rg.FirstChunk, rg.Chunks)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	if _, err = io.CopyN(ioutil.Discard, r, rg.Skip); err != nil {
+		data.Close()
+		return nil, err
+	}
+	return file.LimitReadCloser(&readCloser{Reader: r, Closer: data}, st.Length), nil
+}
+
+// open opens the file and reads the header of the envelope
+func (e *EncryptionFile) open(ctx context.Context, name string, metadata map[string]string) (io.ReadCloser, *envelope.Envelope, error) {
+	data, err := e.store.Get(ctx, &file.GetFileStu{FileName: name, Metadata: metadata})
+	if err != nil {
+		return nil, nil, err
+	}
+	env, err := envelope.ReadHeader(data)
+	if err != nil {
+		data.Close()
+		return nil, nil, err
+	}
+	return data, env, nil
+}
+
+// seek moves the stream after the header to the offset of the encrypted data,
+// the file is reopened at the offset if the wrapped store reads ranges natively
+func (e *EncryptionFile) seek(ctx context.Context, st *file.GetFileStu, data io.ReadCloser, header, offset int64) (io.ReadCloser, error) {
+	if offset == 0 {
+		return data, nil
+	}
+	if _, ok := e.store.(file.RangeGetter); ok {
+		data.Close()
+		return file.GetRange(ctx, e.store, &file.GetFileStu{FileName: st.FileName, Metadata: st.Metadata, Offset: header + offset})
+	}
+	if _, err := io.CopyN(ioutil.Discard, data, offset); err != nil {
+		data.Close()
+		return nil, err
+	}
+	return data, nil
+}
+
+func (e *EncryptionFile) plainSize(ctx context.Context, name string, metadata map[string]string, env *envelope.Envelope) (int64, error) {
+	resp, err := e.store.Stat(ctx, &file.FileMetaRequest{FileName: name, Metadata: metadata})
+	if err != nil {
+		return 0, err
+	}
+	return envelope.PlainSize(resp.Size-env.HeaderSize(), env.ChunkSize), nil
+}
+
+// List lists the wrapped store, the sizes are the ones of the stored files
+func (e *EncryptionFile) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	return e.store.List(ctx, st)
+}
+
+func (e *EncryptionFile) Del(ctx context.Context, st *file.DelRequest) error {
+	return e.store.Del(ctx, st)
+}
+
+// Stat reads the header of the file to return the size of the plaintext
+func (e *EncryptionFile) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
+	data, env, err := e.open(ctx, st.FileName, st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	data.Close()
+	resp, err := e.store.Stat(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	resp.Size = envelope.PlainSize(resp.Size-env.HeaderSize(), env.ChunkSize)
+	return resp, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/filetest"
+	"mosn.io/layotto/components/file/local"
+	"mosn.io/layotto/components/pkg/envelope"
+)
+
+func newEncryptionFile(t *testing.T, store file.File) *EncryptionFile {
+	e := NewEncryptionFile().(*EncryptionFile)
+	config := &file.FileConfig{Metadata: []byte(`{"store":{"type":"local"},"keyId":"key1","chunkSize":16}`)}
+	wrapped, err := e.WrappedConfig(config)
+	require.Nil(t, err)
+	assert.Equal(t, "local", wrapped.Type)
+	e.Wrap(store)
+	assert.Equal(t, envelope.ErrMissingCryption, e.Init(context.TODO(), config))
+	require.Nil(t, e.SetCryptionService(&filetest.Cryption{}))
+	require.Nil(t, e.Init(context.TODO(), config))
+	return e
+}
+
+func TestEncryptionFile(t *testing.T) {
+	dir := t.TempDir()
+	stores := map[string]file.File{
+		"local":  local.NewLocalStore(),
+		"memory": filetest.NewMemoryStore(),
+	}
+	content := "0123456789abcdefghijklmnopqrstuvwxyz"
+	meta := map[string]string{local.FileMode: "420", local.FileFlag: strconv.Itoa(os.O_CREATE | os.O_RDWR | os.O_TRUNC)}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+			e := newEncryptionFile(t, store)
+			fileName := filepath.Join(dir, name+".txt")
+			err := e.Put(ctx, &file.PutFileStu{FileName: fileName, DataStream: strings.NewReader(content), Metadata: meta})
+			require.Nil(t, err)
+
+			// the stored data is encrypted
+			stored, err := store.Get(ctx, &file.GetFileStu{FileName: fileName})
+			require.Nil(t, err)
+			data, _ := ioutil.ReadAll(stored)
+			stored.Close()
+			assert.NotContains(t, string(data), "0123")
+
+			read := func(offset, length int64) (string, error) {
+				rc, err := e.GetRange(ctx, &file.GetFileStu{FileName: fileName, Offset: offset, Length: length})
+				if err != nil {
+					return "", err
+				}
+				defer rc.Close()
+				data, err := ioutil.ReadAll(rc)
+				return string(data), err
+			}
+			data2, err := read(0, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, content, data2)
+			data2, err = read(14, 5)
+			assert.Nil(t, err)
+			assert.Equal(t, "efghi", data2)
+			data2, err = read(33, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, "xyz", data2)
+			data2, err = read(36, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, "", data2)
+			_, err = read(37, 0)
+			assert.Equal(t, file.ErrInvalidRange, err)
+
+			rc, err := e.Get(ctx, &file.GetFileStu{FileName: fileName})
+			require.Nil(t, err)
+			data, _ = ioutil.ReadAll(rc)
+			rc.Close()
+			assert.Equal(t, content, string(data))
+
+			resp, err := e.Stat(ctx, &file.FileMetaRequest{FileName: fileName})
+			require.Nil(t, err)
+			assert.Equal(t, int64(len(content)), resp.Size)
+
+			require.Nil(t, e.Del(ctx, &file.DelRequest{FileName: fileName}))
+			_, err = e.Get(ctx, &file.GetFileStu{FileName: fileName})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEncryptionFileRejectsPlainFile(t *testing.T) {
+	store := filetest.NewMemoryStore()
+	store.SetFile("a.txt", []byte("plain data"))
+	e := newEncryptionFile(t, store)
+	_, err := e.Get(context.TODO(), &file.GetFileStu{FileName: "a.txt"})
+	assert.Equal(t, envelope.ErrInvalidEnvelope, err)
+}
@@ -26,4 +26,18 @@ var (
 	ErrExist      = errors.New("file already exists")
 	ErrNotExist   = errors.New("file does not exist")
 	ErrExpired    = errors.New("file expired")
+	// ErrInvalidRange is returned when the offset of a range read is beyond the end of the file
+	ErrInvalidRange = errors.New("invalid range")
+	// ErrOffsetMismatch is returned when a resumable upload doesn't continue from the persisted size
+	ErrOffsetMismatch = errors.New("offset does not match the uploaded size")
+	// ErrChecksumMismatch is returned when the content of a file doesn't match its expected checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrTooLarge is returned when a file is larger than the max object size of the policy of its store
+	ErrTooLarge = errors.New("file too large")
+	// ErrQuotaExceeded is returned when a file exceeds the quota of the app in the policy of its store
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrNotAllowed is returned when the type of a file is not allowed by the policy of its store
+	ErrNotAllowed = errors.New("file type not allowed")
+	// ErrNotSupported is returned when the store can't serve the request, e.g. a put of unknown size it can't append
+	ErrNotSupported = errors.New("operation not supported")
 )
@@ -19,6 +19,7 @@ package file
 import (
 	"context"
 	"io"
+	"net/url"
 )
 
 const ServiceName = "file"
@@ -31,3 +32,117 @@ type File interface {
 	Del(context.Context, *DelRequest) error
 	Stat(context.Context, *FileMetaRequest) (*FileMetaResp, error)
 }
+
+// Wrapper is implemented by the components adding a feature on top of another file component.
+// The config of the wrapped component is nested in the metadata of the wrapper,
+// the runtime creates and initializes the wrapped component before the wrapper
+type Wrapper interface {
+	File
+	// WrappedConfig returns the config of the wrapped component from the config of the wrapper
+	WrappedConfig(*FileConfig) (*FileConfig, error)
+	// Wrap sets the wrapped component, it is called before Init
+	Wrap(File)
+}
+
+// Replicator is implemented by the Wrappers writing the files to a replica besides the wrapped component,
+// the config of the replica is nested in the metadata of the wrapper too,
+// and the runtime creates and initializes the replica before the wrapper
+type Replicator interface {
+	Wrapper
+	// ReplicaConfig returns the config of the replica from the config of the wrapper
+	ReplicaConfig(*FileConfig) (*FileConfig, error)
+	// SetReplica sets the replica, it is called before Init
+	SetReplica(File)
+}
+
+// CloseWrapped closes the wrapped components which can be closed, the Wrappers call it when they are closed,
+// since the runtime only closes the outer component. The first error is returned after all of them are closed.
+func CloseWrapped(wrapped ...File) error {
+	var err error
+	for _, f := range wrapped {
+		closer, ok := f.(io.Closer)
+		if !ok {
+			continue
+		}
+		if e := closer.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// RangeGetter is implemented by the components able to read a byte range of a file,
+// the runtime skips the data out of the range for the other components
+type RangeGetter interface {
+	// GetRange reads the range of the file selected by the Offset and Length of GetFileStu
+	GetRange(context.Context, *GetFileStu) (io.ReadCloser, error)
+}
+
+// ResumableUploader is implemented by the components able to resume an interrupted upload of a file
+type ResumableUploader interface {
+	// UploadOffset returns the size of the data persisted by the unfinished upload of the file,
+	// it is 0 if the file has no unfinished upload
+	UploadOffset(context.Context, *FileMetaRequest) (int64, error)
+	// ResumePut writes the data to the unfinished upload of the file from the Offset of PutFileStu,
+	// which must be the one returned by UploadOffset. A zero Offset starts a new upload.
+	// The data persisted before an error of the stream is kept for the next resume,
+	// and the file is committed once the stream ends with io.EOF
+	ResumePut(context.Context, *PutFileStu) error
+}
+
+// ChecksumVerifier is implemented by the ResumableUploaders which verify the Checksum of PutFileStu over the whole file
+// before committing it, a mismatched upload fails with ErrChecksumMismatch and the previous file is kept.
+// The runtime reads back the files committed by the other ResumableUploaders to verify them
+type ChecksumVerifier interface {
+	// VerifiesChecksum reports whether ResumePut verifies the Checksum of PutFileStu
+	VerifiesChecksum() bool
+}
+
+// MultipartUploader is implemented by the components able to upload a file in parts,
+// the runtime uses it to upload the files larger than a part in parallel
+type MultipartUploader interface {
+	// PutMultipart uploads the data of PutFileStu in parts of the PartSize of MultipartConfig,
+	// and at most Concurrency parts are uploaded at the same time. The uploaded parts are discarded if the put fails
+	PutMultipart(context.Context, *PutFileStu, *MultipartConfig) error
+}
+
+// Signer is implemented by the components able to presign the url of a file,
+// with which the clients get or put the file directly without the credentials of the store
+type Signer interface {
+	// SignURL returns the url allowing the Method of SignURLStu on the file until it expires
+	SignURL(context.Context, *SignURLStu) (string, error)
+}
+
+// URLVerifier is implemented by the Signers whose urls are served by the sidecar rather than the store
+type URLVerifier interface {
+	// VerifyURL checks the query of a signed url, and returns the file operation it allows.
+	// ErrPermission is returned if the signature is wrong, and ErrExpired if the url expires
+	VerifyURL(ctx context.Context, query url.Values) (*SignURLStu, error)
+}
+
+// Watcher is implemented by the components notifying the changes of the files natively,
+// the runtime lists the directory periodically to find the changes for the other components
+type Watcher interface {
+	// Watch sends the events of the files created or deleted in the DirectoryName of WatchRequest.
+	// The channel is closed when the context is done, or when the watch fails and the events may be lost
+	Watch(context.Context, *WatchRequest) (<-chan *FileEvent, error)
+}
+
+// DirMaker is implemented by the components able to create directories
+type DirMaker interface {
+	// Mkdir creates the directory and its missing parents, it succeeds if the directory exists
+	Mkdir(context.Context, *MkdirRequest) error
+}
+
+// Renamer is implemented by the components able to rename or move a file,
+// the object stores copy and then delete the file, so the rename is not atomic on them
+type Renamer interface {
+	// Rename moves the Source file of RenameRequest to the Target, which is overwritten if it exists
+	Rename(context.Context, *RenameRequest) error
+}
+
+// Copier is implemented by the components able to copy a file in the store without downloading it
+type Copier interface {
+	// Copy copies the Source file of CopyRequest to the Target, which is overwritten if it exists
+	Copy(context.Context, *CopyRequest) error
+}
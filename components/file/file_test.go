@@ -0,0 +1,45 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type closingFile struct {
+	File
+	err    error
+	closed bool
+}
+
+func (f *closingFile) Close() error {
+	f.closed = true
+	return f.err
+}
+
+func TestCloseWrapped(t *testing.T) {
+	broken := &closingFile{err: errors.New("broken")}
+	closer := &closingFile{}
+	var notCloser File
+	assert.Equal(t, broken.err, CloseWrapped(broken, notCloser, closer))
+	assert.True(t, broken.closed)
+	assert.True(t, closer.closed)
+	assert.Nil(t, CloseWrapped())
+}
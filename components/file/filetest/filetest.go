@@ -0,0 +1,192 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package filetest provides the in-memory fakes for the tests of the file components and the components wrapping them.
+package filetest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"mosn.io/layotto/components/cryption"
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
+)
+
+// MemoryStore is a file component keeping the files in memory, without native range reads.
+// It lists the files in the directory after the marker in order, and the puts fail while it is broken
+type MemoryStore struct {
+	// TrimBucket lists the names without the bucket, like the object store components
+	TrimBucket bool
+
+	mu     sync.Mutex
+	files  map[string][]byte
+	broken bool
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{files: map[string][]byte{}}
+}
+
+// SetFile writes the file without a put
+func (m *MemoryStore) SetFile(name string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[name] = data
+}
+
+// File returns the data of the file
+func (m *MemoryStore) File(name string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[name]
+	return data, ok
+}
+
+// Files returns a copy of all the files
+func (m *MemoryStore) Files() map[string][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	files := make(map[string][]byte, len(m.files))
+	for name, data := range m.files {
+		files[name] = data
+	}
+	return files
+}
+
+// SetBroken makes the puts fail or succeed
+func (m *MemoryStore) SetBroken(broken bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.broken = broken
+}
+
+func (m *MemoryStore) Init(context.Context, *file.FileConfig) error {
+	return nil
+}
+
+func (m *MemoryStore) Put(ctx context.Context, st *file.PutFileStu) error {
+	data, err := ioutil.ReadAll(st.DataStream)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.broken {
+		return errors.New("broken")
+	}
+	m.files[st.FileName] = data
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	data, ok := m.File(st.FileName)
+	if !ok {
+		return nil, file.ErrNotExist
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *MemoryStore) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	listed := make(map[string]string)
+	var names []string
+	for name := range m.files {
+		if !strings.HasPrefix(name, st.DirectoryName) {
+			continue
+		}
+		key := name
+		if m.TrimBucket {
+			key, _ = util.GetFileName(name)
+		}
+		if key > st.Marker {
+			listed[key] = name
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	resp := &file.ListResp{}
+	for _, key := range names {
+		if st.PageSize > 0 && len(resp.Files) == int(st.PageSize) {
+			resp.IsTruncated = true
+			break
+		}
+		resp.Files = append(resp.Files, &file.FilesInfo{FileName: key, Size: int64(len(m.files[listed[key]]))})
+		resp.Marker = key
+	}
+	return resp, nil
+}
+
+func (m *MemoryStore) Del(ctx context.Context, st *file.DelRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.files[st.FileName]; !ok {
+		return file.ErrNotExist
+	}
+	delete(m.files, st.FileName)
+	return nil
+}
+
+func (m *MemoryStore) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
+	data, ok := m.File(st.FileName)
+	if !ok {
+		return nil, file.ErrNotExist
+	}
+	return &file.FileMetaResp{Size: int64(len(data)), Metadata: map[string][]string{}}, nil
+}
+
+// Cryption is a cryption service wrapping the keys by reversing them, it records the key id of the last encryption
+type Cryption struct {
+	mu    sync.Mutex
+	keyID string
+}
+
+// KeyID returns the key id of the last encryption
+func (c *Cryption) KeyID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.keyID
+}
+
+func (c *Cryption) Init(context.Context, *cryption.Config) error {
+	return nil
+}
+
+func (c *Cryption) Encrypt(ctx context.Context, req *cryption.EncryptRequest) (*cryption.EncryptResponse, error) {
+	c.mu.Lock()
+	c.keyID = req.KeyId
+	c.mu.Unlock()
+	return &cryption.EncryptResponse{CipherText: reverse(req.PlainText), KeyId: req.KeyId}, nil
+}
+
+func (c *Cryption) Decrypt(ctx context.Context, req *cryption.DecryptRequest) (*cryption.DecryptResponse, error) {
+	return &cryption.DecryptResponse{PlainText: reverse(req.CipherText)}, nil
+}
+
+func reverse(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[len(data)-1-i] = b
+	}
+	return out
+}
@@ -39,6 +39,8 @@ const (
 	endpointKey   = "endpoint"
 	fileSize      = "filesize"
 	componentName = "file-hdfs"
+	// defaultPartSize is the size of the appends by default
+	defaultPartSize = 8 << 20
 )
 
 var (
@@ -132,6 +134,65 @@ func (h *hdfs) Put(ctx context.Context, stu *file.PutFileStu) error {
 	return err
 }
 
+// appender is the append api of the storagers
+type appender interface {
+	CreateAppendWithContext(ctx context.Context, path string, pairs ...types.Pair) (*types.Object, error)
+	WriteAppendWithContext(ctx context.Context, o *types.Object, r io.Reader, size int64, pairs ...types.Pair) (int64, error)
+	CommitAppendWithContext(ctx context.Context, o *types.Object, pairs ...types.Pair) error
+}
+
+// PutMultipart writes the file by appending the parts of PartSize in order, the appends can't be parallel,
+// but the file size required by a single write of Put is not needed.
+// The appended file is deleted if the put fails. The storagers unable to append write the file by putWhole
+func (h *hdfs) PutMultipart(ctx context.Context, stu *file.PutFileStu, config *file.MultipartConfig) error {
+	if stu.Metadata[endpointKey] == "" {
+		return ErrMissingEndPoint
+	}
+
+	client, err := h.selectClient(stu.Metadata)
+	if err != nil {
+		return err
+	}
+	a, ok := client.(appender)
+	if !ok {
+		return h.putWhole(ctx, stu)
+	}
+	partSize := config.PartSize
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+
+	o, err := a.CreateAppendWithContext(ctx, stu.FileName)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, partSize)
+	for {
+		n, err := io.ReadFull(stu.DataStream, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			client.Delete(stu.FileName)
+			return err
+		}
+		if n > 0 {
+			if _, werr := a.WriteAppendWithContext(ctx, o, bytes.NewReader(buf[:n]), int64(n)); werr != nil {
+				client.Delete(stu.FileName)
+				return werr
+			}
+		}
+		if err != nil {
+			return a.CommitAppendWithContext(ctx, o)
+		}
+	}
+}
+
+// putWhole writes the file by a single write of Put, which requires the file size in the metadata
+func (h *hdfs) putWhole(ctx context.Context, stu *file.PutFileStu) error {
+	if _, ok := stu.Metadata[fileSize]; !ok {
+		return file.ErrNotSupported
+	}
+	return h.Put(ctx, stu)
+}
+
 func (h *hdfs) Get(ctx context.Context, stu *file.GetFileStu) (io.ReadCloser, error) {
 	if _, ok := stu.Metadata[endpointKey]; !ok {
 		return nil, ErrMissingEndPoint
@@ -217,6 +278,86 @@ func (h *hdfs) Del(ctx context.Context, request *file.DelRequest) error {
 	return client.Delete(request.FileName)
 }
 
+// direr, mover and copier are the directory, move and copy apis of the storagers
+type direr interface {
+	CreateDir(path string, pairs ...types.Pair) (*types.Object, error)
+}
+
+type mover interface {
+	Move(src string, dst string, pairs ...types.Pair) error
+}
+
+type copier interface {
+	Copy(src string, dst string, pairs ...types.Pair) error
+}
+
+func (h *hdfs) Mkdir(ctx context.Context, request *file.MkdirRequest) error {
+	if _, ok := request.Metadata[endpointKey]; !ok {
+		return ErrMissingEndPoint
+	}
+
+	client, err := h.selectClient(request.Metadata)
+	if err != nil {
+		return err
+	}
+	d, ok := client.(direr)
+	if !ok {
+		return fmt.Errorf("hdfs mkdir[%s] fail, err: directories are not supported", request.DirectoryName)
+	}
+	_, err = d.CreateDir(request.DirectoryName)
+	return err
+}
+
+// Rename moves the file by hdfs, which is atomic
+func (h *hdfs) Rename(ctx context.Context, request *file.RenameRequest) error {
+	if _, ok := request.Metadata[endpointKey]; !ok {
+		return ErrMissingEndPoint
+	}
+
+	client, err := h.selectClient(request.Metadata)
+	if err != nil {
+		return err
+	}
+	m, ok := client.(mover)
+	if !ok {
+		return fmt.Errorf("hdfs rename file[%s] fail, err: moves are not supported", request.Source)
+	}
+	return m.Move(request.Source, request.Target)
+}
+
+// Copy copies the file by hdfs, or streams it back through a pipe if the storager can't copy
+func (h *hdfs) Copy(ctx context.Context, request *file.CopyRequest) error {
+	if _, ok := request.Metadata[endpointKey]; !ok {
+		return ErrMissingEndPoint
+	}
+
+	client, err := h.selectClient(request.Metadata)
+	if err != nil {
+		return err
+	}
+	if c, ok := client.(copier); ok {
+		return c.Copy(request.Source, request.Target)
+	}
+	// the size is required by the write
+	stat, err := client.Stat(request.Source)
+	if err != nil {
+		return err
+	}
+	size, ok := stat.GetContentLength()
+	if !ok {
+		return fmt.Errorf("hdfs copy file[%s] fail, err: the size is unknown", request.Source)
+	}
+	r, w := io.Pipe()
+	go func() {
+		_, err := client.Read(request.Source, w)
+		w.CloseWithError(err)
+	}()
+	_, err = client.Write(request.Target, r, size)
+	// the read stops if the write fails
+	r.CloseWithError(err)
+	return err
+}
+
 func (h *hdfs) Stat(ctx context.Context, request *file.FileMetaRequest) (*file.FileMetaResp, error) {
 
 	clinet, err := h.selectClient(request.Metadata)
@@ -19,7 +19,11 @@ package hdfs
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -273,3 +277,169 @@ func TestHdfs_IsHdfsMetaValid(t *testing.T) {
 	mt.EndPoint = "a"
 	assert.True(t, mt.isHdfsMetaValid())
 }
+
+// fakeStorager keeps the files in memory, the other apis of types.Storager are not used by the tests
+type fakeStorager struct {
+	types.Storager
+	files map[string][]byte
+}
+
+func newFakeStorager() *fakeStorager {
+	return &fakeStorager{files: make(map[string][]byte)}
+}
+
+func (s *fakeStorager) Write(path string, r io.Reader, size int64, pairs ...types.Pair) (int64, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, size))
+	if err != nil {
+		return 0, err
+	}
+	s.files[path] = data
+	return int64(len(data)), nil
+}
+
+func (s *fakeStorager) Delete(path string, pairs ...types.Pair) error {
+	delete(s.files, path)
+	return nil
+}
+
+func (s *fakeStorager) CreateDir(path string, pairs ...types.Pair) (*types.Object, error) {
+	s.files[path+"/"] = nil
+	return &types.Object{}, nil
+}
+
+func (s *fakeStorager) Move(src string, dst string, pairs ...types.Pair) error {
+	data, ok := s.files[src]
+	if !ok {
+		return file.ErrNotExist
+	}
+	s.files[dst] = data
+	delete(s.files, src)
+	return nil
+}
+
+func (s *fakeStorager) Copy(src string, dst string, pairs ...types.Pair) error {
+	data, ok := s.files[src]
+	if !ok {
+		return file.ErrNotExist
+	}
+	s.files[dst] = append([]byte(nil), data...)
+	return nil
+}
+
+// appendingStorager writes the files by appends, the append failAt fails
+type appendingStorager struct {
+	*fakeStorager
+	path    string
+	appends int
+	failAt  int
+}
+
+func (s *appendingStorager) CreateAppendWithContext(ctx context.Context, path string, pairs ...types.Pair) (*types.Object, error) {
+	s.path = path
+	s.files[path] = []byte{}
+	return &types.Object{}, nil
+}
+
+func (s *appendingStorager) WriteAppendWithContext(ctx context.Context, o *types.Object, r io.Reader, size int64, pairs ...types.Pair) (int64, error) {
+	s.appends++
+	if s.appends == s.failAt {
+		return 0, errors.New("append fail")
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	s.files[s.path] = append(s.files[s.path], data...)
+	return int64(len(data)), nil
+}
+
+func (s *appendingStorager) CommitAppendWithContext(ctx context.Context, o *types.Object, pairs ...types.Pair) error {
+	return nil
+}
+
+func newFakeHdfs(client types.Storager) *hdfs {
+	return &hdfs{
+		client: map[string]types.Storager{tcpEndpoint: client},
+		meta:   map[string]*HdfsMetaData{tcpEndpoint: {EndPoint: tcpEndpoint}},
+	}
+}
+
+func TestHdfs_PutMultipart(t *testing.T) {
+	storager := &appendingStorager{fakeStorager: newFakeStorager()}
+	h := newFakeHdfs(storager)
+	config := &file.MultipartConfig{PartSize: 4}
+	put := func(name string) error {
+		return h.PutMultipart(context.TODO(), &file.PutFileStu{
+			DataStream: strings.NewReader("hello world"),
+			FileName:   name,
+			Metadata:   map[string]string{endpointKey: tcpEndpoint},
+		}, config)
+	}
+
+	assert.Equal(t, ErrMissingEndPoint, h.PutMultipart(context.TODO(), &file.PutFileStu{FileName: "a.txt"}, config))
+	assert.Nil(t, put("a.txt"))
+	assert.Equal(t, "hello world", string(storager.files["a.txt"]))
+	assert.Equal(t, 3, storager.appends)
+
+	// the appended file is deleted if an append fails
+	storager.appends, storager.failAt = 0, 2
+	assert.Error(t, put("b.txt"))
+	_, ok := storager.files["b.txt"]
+	assert.False(t, ok)
+}
+
+func TestHdfs_putWhole(t *testing.T) {
+	storager := newFakeStorager()
+	h := newFakeHdfs(storager)
+	stu := &file.PutFileStu{
+		DataStream: strings.NewReader("hello"),
+		FileName:   "a.txt",
+		Metadata:   map[string]string{endpointKey: tcpEndpoint},
+	}
+
+	// the size is required by the write
+	assert.Equal(t, file.ErrNotSupported, h.putWhole(context.TODO(), stu))
+	stu.Metadata[fileSize] = "5"
+	assert.Nil(t, h.putWhole(context.TODO(), stu))
+	assert.Equal(t, "hello", string(storager.files["a.txt"]))
+}
+
+func TestHdfs_Mkdir(t *testing.T) {
+	storager := newFakeStorager()
+	h := newFakeHdfs(storager)
+
+	err := h.Mkdir(context.TODO(), &file.MkdirRequest{DirectoryName: "dir", Metadata: map[string]string{}})
+	assert.Equal(t, ErrMissingEndPoint, err)
+	err = h.Mkdir(context.TODO(), &file.MkdirRequest{DirectoryName: "dir", Metadata: map[string]string{endpointKey: tcpEndpoint}})
+	assert.Nil(t, err)
+	_, ok := storager.files["dir/"]
+	assert.True(t, ok)
+}
+
+func TestHdfs_Rename(t *testing.T) {
+	storager := newFakeStorager()
+	storager.files["a.txt"] = []byte("hello")
+	h := newFakeHdfs(storager)
+	meta := map[string]string{endpointKey: tcpEndpoint}
+
+	err := h.Rename(context.TODO(), &file.RenameRequest{Source: "a.txt", Target: "b.txt", Metadata: map[string]string{}})
+	assert.Equal(t, ErrMissingEndPoint, err)
+	assert.Nil(t, h.Rename(context.TODO(), &file.RenameRequest{Source: "a.txt", Target: "b.txt", Metadata: meta}))
+	assert.Equal(t, map[string][]byte{"b.txt": []byte("hello")}, storager.files)
+	err = h.Rename(context.TODO(), &file.RenameRequest{Source: "a.txt", Target: "c.txt", Metadata: meta})
+	assert.Equal(t, file.ErrNotExist, err)
+}
+
+func TestHdfs_Copy(t *testing.T) {
+	storager := newFakeStorager()
+	storager.files["a.txt"] = []byte("hello")
+	h := newFakeHdfs(storager)
+	meta := map[string]string{endpointKey: tcpEndpoint}
+
+	err := h.Copy(context.TODO(), &file.CopyRequest{Source: "a.txt", Target: "b.txt", Metadata: map[string]string{}})
+	assert.Equal(t, ErrMissingEndPoint, err)
+	assert.Nil(t, h.Copy(context.TODO(), &file.CopyRequest{Source: "a.txt", Target: "b.txt", Metadata: meta}))
+	assert.Equal(t, map[string][]byte{"a.txt": []byte("hello"), "b.txt": []byte("hello")}, storager.files)
+	err = h.Copy(context.TODO(), &file.CopyRequest{Source: "c.txt", Target: "d.txt", Metadata: meta})
+	assert.Equal(t, file.ErrNotExist, err)
+}
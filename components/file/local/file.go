@@ -18,13 +18,23 @@ package local
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
 
 	"mosn.io/layotto/components/file"
 	"mosn.io/layotto/components/pkg/actuators"
@@ -49,6 +59,16 @@ func init() {
 }
 
 type LocalStore struct {
+	signKey []byte
+	signURL string
+}
+
+// LocalMetadata is the optional metadata of the local store
+type LocalMetadata struct {
+	// SignKey is the hmac key signing the urls of the files
+	SignKey string `json:"signKey"`
+	// SignURL is the sidecar url serving the signed files, e.g. http://127.0.0.1:34999/gateway/signed_file/{store_name}
+	SignURL string `json:"signURL"`
 }
 
 func NewLocalStore() file.File {
@@ -60,6 +80,14 @@ func NewLocalStore() file.File {
 }
 
 func (lf *LocalStore) Init(ctx context.Context, f *file.FileConfig) error {
+	if len(f.Metadata) > 0 {
+		m := &LocalMetadata{}
+		if err := json.Unmarshal(f.Metadata, m); err != nil {
+			return file.ErrInvalid
+		}
+		lf.signKey = []byte(m.SignKey)
+		lf.signURL = strings.TrimSuffix(m.SignURL, "/")
+	}
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
 	return nil
@@ -82,6 +110,7 @@ func (lf *LocalStore) Put(ctx context.Context, f *file.PutFileStu) error {
 		return fmt.Errorf("wrong fileFlag value:%+v in metadata", err)
 	}
 
+	_, statErr := os.Stat(f.FileName)
 	fileObj, err := os.OpenFile(f.FileName, fl, os.FileMode(m))
 	if err != nil {
 		return err
@@ -100,6 +129,11 @@ func (lf *LocalStore) Put(ctx context.Context, f *file.PutFileStu) error {
 				}
 				break
 			}
+			// don't leave a partial file behind if it is created by this put
+			if os.IsNotExist(statErr) {
+				fileObj.Close()
+				os.Remove(f.FileName)
+			}
 			return err
 		}
 		_, err = fileObj.Write(data[:n])
@@ -116,6 +150,173 @@ func (lf *LocalStore) Get(ctx context.Context, f *file.GetFileStu) (io.ReadClose
 	}
 	return fileObj, nil
 }
+func (lf *LocalStore) GetRange(ctx context.Context, f *file.GetFileStu) (io.ReadCloser, error) {
+	if f.Offset < 0 || f.Length < 0 {
+		return nil, file.ErrInvalidRange
+	}
+	fileObj, err := os.Open(f.FileName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := fileObj.Stat()
+	if err != nil {
+		fileObj.Close()
+		return nil, err
+	}
+	if f.Offset > info.Size() {
+		fileObj.Close()
+		return nil, file.ErrInvalidRange
+	}
+	if _, err = fileObj.Seek(f.Offset, io.SeekStart); err != nil {
+		fileObj.Close()
+		return nil, err
+	}
+	return file.LimitReadCloser(fileObj, f.Length), nil
+}
+
+// UploadOffset returns the size of the staged data of the unfinished upload of the file
+func (lf *LocalStore) UploadOffset(ctx context.Context, f *file.FileMetaRequest) (int64, error) {
+	info, err := os.Stat(uploadPath(f.FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// ResumePut appends the data to the staged file of the upload, which is renamed to the file once the data ends.
+// The staged file is verified against the Checksum before the rename, and removed if it doesn't match.
+// The FileMode in metadata is optional, the file mode defaults to 0644
+func (lf *LocalStore) ResumePut(ctx context.Context, f *file.PutFileStu) error {
+	mode := os.FileMode(0644)
+	if v, ok := f.Metadata[FileMode]; ok {
+		m, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("wrong fileMode value:%+v in metadata", err)
+		}
+		mode = os.FileMode(m)
+	}
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if f.Offset > 0 {
+		flag = os.O_WRONLY | os.O_APPEND
+	}
+	staged := uploadPath(f.FileName)
+	fileObj, err := os.OpenFile(staged, flag, mode)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return file.ErrOffsetMismatch
+		}
+		return err
+	}
+	defer fileObj.Close()
+	info, err := fileObj.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() != f.Offset {
+		return file.ErrOffsetMismatch
+	}
+	if _, err = io.Copy(fileObj, f.DataStream); err != nil {
+		// keep the data written so far for the next resume
+		fileObj.Sync()
+		return err
+	}
+	if err = fileObj.Close(); err != nil {
+		return err
+	}
+	if !f.Checksum.IsEmpty() {
+		if err = verifyStaged(staged, f.Checksum); err != nil {
+			return err
+		}
+	}
+	if err = os.Chmod(staged, mode); err != nil {
+		return err
+	}
+	return os.Rename(staged, f.FileName)
+}
+
+// VerifiesChecksum implements file.ChecksumVerifier
+func (lf *LocalStore) VerifiesChecksum() bool {
+	return true
+}
+
+// verifyStaged checks the staged file of an upload against the checksum, it is removed if it doesn't match
+func verifyStaged(staged string, checksum file.Checksum) error {
+	fileObj, err := os.Open(staged)
+	if err != nil {
+		return err
+	}
+	err = checksum.Verify(fileObj)
+	fileObj.Close()
+	if err == file.ErrChecksumMismatch {
+		os.Remove(staged)
+	}
+	return err
+}
+
+// uploadPath is the path of the staged data of an upload, it is in the same directory as the file for the rename
+func uploadPath(fileName string) string {
+	dir, name := filepath.Split(fileName)
+	return filepath.Join(dir, "."+name+".upload")
+}
+
+func isUploadPath(fileName string) bool {
+	name := filepath.Base(fileName)
+	return strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".upload")
+}
+
+// Watch notifies the files created or deleted in the directory by fsnotify, the staged data of the uploads are skipped
+func (lf *LocalStore) Watch(ctx context.Context, f *file.WatchRequest) (<-chan *file.FileEvent, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err = watcher.Add(f.DirectoryName); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	events := make(chan *file.FileEvent)
+	go func() {
+		defer close(events)
+		defer watcher.Close()
+		for {
+			var e fsnotify.Event
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case <-watcher.Errors:
+				return
+			case e, ok = <-watcher.Events:
+				if !ok {
+					return
+				}
+			}
+			if isUploadPath(e.Name) {
+				continue
+			}
+			event := &file.FileEvent{FileName: filepath.Base(e.Name)}
+			switch {
+			case e.Op&fsnotify.Create != 0:
+				event.Type = file.EventCreate
+			case e.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				// the renamed file is gone from its name
+				event.Type = file.EventDelete
+			default:
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
 func (lf *LocalStore) List(ctx context.Context, f *file.ListRequest) (*file.ListResp, error) {
 	res := &file.ListResp{}
 	files, err := ioutil.ReadDir(f.DirectoryName)
@@ -163,6 +364,60 @@ func (lf *LocalStore) Del(ctx context.Context, f *file.DelRequest) error {
 	return err
 }
 
+// Mkdir creates the directory with the FileMode in the metadata, 0755 by default
+func (lf *LocalStore) Mkdir(ctx context.Context, f *file.MkdirRequest) error {
+	mode := os.FileMode(0755)
+	if v, ok := f.Metadata[FileMode]; ok {
+		m, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("wrong fileMode value:%+v in metadata", err)
+		}
+		mode = os.FileMode(m)
+	}
+	return os.MkdirAll(f.DirectoryName, mode)
+}
+
+// Rename moves the file atomically, the directory of the target must exist
+func (lf *LocalStore) Rename(ctx context.Context, f *file.RenameRequest) error {
+	if _, err := os.Lstat(f.Source); os.IsNotExist(err) {
+		return file.ErrNotExist
+	}
+	return os.Rename(f.Source, f.Target)
+}
+
+// Copy copies the file with its mode, the directory of the target must exist
+func (lf *LocalStore) Copy(ctx context.Context, f *file.CopyRequest) error {
+	src, err := os.Open(f.Source)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return file.ErrNotExist
+		}
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("copy directory %s is not supported", f.Source)
+	}
+	_, statErr := os.Stat(f.Target)
+	dst, err := os.OpenFile(f.Target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	// don't leave a partial file behind if it is created by this copy
+	if err != nil && os.IsNotExist(statErr) {
+		os.Remove(f.Target)
+	}
+	return err
+}
+
 func (lf *LocalStore) Stat(ctx context.Context, f *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	fileInfo, err := os.Stat(f.FileName)
 	if err != nil {
@@ -186,3 +441,98 @@ func (lf *LocalStore) Stat(ctx context.Context, f *file.FileMetaRequest) (*file.
 	resp.Metadata[FileIsDir] = append(resp.Metadata[FileIsDir], isDir)
 	return resp, nil
 }
+
+// the query parameters of the signed urls
+const (
+	QueryName      = "name"
+	QueryMethod    = "method"
+	QueryExpires   = "expires"
+	QuerySignature = "signature"
+	// QueryMetadataPrefix prefixes the keys of the metadata of the signed request
+	QueryMetadataPrefix = "meta."
+)
+
+// SignURL returns the url of the sidecar serving the file, which is signed by the signKey with hmac-sha256.
+// The url is like {signURL}?name=a.txt&method=GET&expires=1650000000&meta.key=value&signature=hex,
+// the metadata of the request is signed too, since it is passed to the file operation allowed by the url
+func (lf *LocalStore) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	if len(lf.signKey) == 0 || lf.signURL == "" {
+		return "", fmt.Errorf("sign file[%s] fail, err: signKey and signURL are not configured", st.FileName)
+	}
+	if st.Method != http.MethodGet && st.Method != http.MethodPut {
+		return "", fmt.Errorf("sign file[%s] fail, err: unsupported method %s", st.FileName, st.Method)
+	}
+	expires := time.Now().Add(st.Expires).Unix()
+	query := url.Values{}
+	query.Set(QueryName, st.FileName)
+	query.Set(QueryMethod, st.Method)
+	query.Set(QueryExpires, strconv.FormatInt(expires, 10))
+	for k, v := range st.Metadata {
+		query.Set(QueryMetadataPrefix+k, v)
+	}
+	query.Set(QuerySignature, Sign(lf.signKey, st.Method, st.FileName, expires, st.Metadata))
+	return lf.signURL + "?" + query.Encode(), nil
+}
+
+// VerifyURL checks the signature and the expiry of the url signed by SignURL, and returns the signed metadata.
+// The file put by the url is created with the mode 0644 unless the metadata specifies it, or truncated if it exists
+func (lf *LocalStore) VerifyURL(ctx context.Context, query url.Values) (*file.SignURLStu, error) {
+	if len(lf.signKey) == 0 {
+		return nil, file.ErrPermission
+	}
+	expires, err := strconv.ParseInt(query.Get(QueryExpires), 10, 64)
+	if err != nil {
+		return nil, file.ErrPermission
+	}
+	st := &file.SignURLStu{
+		FileName: query.Get(QueryName),
+		Method:   query.Get(QueryMethod),
+		Metadata: make(map[string]string),
+	}
+	for k := range query {
+		if strings.HasPrefix(k, QueryMetadataPrefix) {
+			st.Metadata[strings.TrimPrefix(k, QueryMetadataPrefix)] = query.Get(k)
+		}
+	}
+	if err = Verify(lf.signKey, st.Method, st.FileName, expires, st.Metadata, query.Get(QuerySignature), time.Now()); err != nil {
+		return nil, err
+	}
+	st.Expires = time.Until(time.Unix(expires, 0))
+	if st.Method == http.MethodPut {
+		if _, ok := st.Metadata[FileMode]; !ok {
+			st.Metadata[FileMode] = strconv.Itoa(0644)
+		}
+		if _, ok := st.Metadata[FileFlag]; !ok {
+			st.Metadata[FileFlag] = strconv.Itoa(os.O_WRONLY | os.O_CREATE | os.O_TRUNC)
+		}
+	}
+	return st, nil
+}
+
+// Sign returns the hex encoded hmac-sha256 of the method, the file name, the expiry in unix seconds
+// and the metadata encoded in the order of the keys
+func Sign(key []byte, method, fileName string, expires int64, metadata map[string]string) string {
+	meta := url.Values{}
+	for k, v := range metadata {
+		meta.Set(k, v)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(method + "\n" + fileName + "\n" + strconv.FormatInt(expires, 10) + "\n" + meta.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature returned by Sign, file.ErrExpired is returned if it expires before now
+func Verify(key []byte, method, fileName string, expires int64, metadata map[string]string, signature string, now time.Time) error {
+	expected, err := hex.DecodeString(Sign(key, method, fileName, expires, metadata))
+	if err != nil {
+		return err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return file.ErrPermission
+	}
+	if now.Unix() > expires {
+		return file.ErrExpired
+	}
+	return nil
+}
@@ -19,9 +19,13 @@ package local
 import (
 	"context"
 	"io"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -117,3 +121,244 @@ func TestFile(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, exist, false)
 }
+
+func TestGetRange(t *testing.T) {
+	ls := &LocalStore{}
+	name := filepath.Join(t.TempDir(), FileName)
+	assert.Nil(t, os.WriteFile(name, []byte("hello world"), 0644))
+
+	read := func(offset, length int64) (string, error) {
+		rc, err := ls.GetRange(context.TODO(), &file.GetFileStu{FileName: name, Offset: offset, Length: length})
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		return string(data), err
+	}
+	data, err := read(6, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "world", data)
+	data, err = read(0, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", data)
+	data, err = read(4, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, "o world", data)
+	_, err = read(12, 0)
+	assert.Equal(t, file.ErrInvalidRange, err)
+	_, err = read(-1, 0)
+	assert.Equal(t, file.ErrInvalidRange, err)
+}
+
+type brokenReader struct {
+	data []byte
+}
+
+func (r *brokenReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestResumePut(t *testing.T) {
+	ls := &LocalStore{}
+	name := filepath.Join(t.TempDir(), FileName)
+	req := &file.FileMetaRequest{FileName: name}
+
+	offset, err := ls.UploadOffset(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), offset)
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, Offset: 3, DataStream: strings.NewReader("lo")})
+	assert.Equal(t, file.ErrOffsetMismatch, err)
+
+	// the stream breaks after "hel"
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, DataStream: &brokenReader{data: []byte("hel")}})
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	exist, _ := CheckFileExist(name)
+	assert.False(t, exist)
+	offset, err = ls.UploadOffset(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), offset)
+
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, Offset: 2, DataStream: strings.NewReader("llo")})
+	assert.Equal(t, file.ErrOffsetMismatch, err)
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, Offset: 3, DataStream: strings.NewReader("lo")})
+	assert.Nil(t, err)
+	data, err := os.ReadFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	offset, err = ls.UploadOffset(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), offset)
+}
+
+func TestResumePutChecksum(t *testing.T) {
+	ls := &LocalStore{}
+	name := filepath.Join(t.TempDir(), FileName)
+	assert.Nil(t, os.WriteFile(name, []byte("previous"), 0644))
+	// md5 of "hello"
+	checksum := file.Checksum{MD5: "5d41402abc4b2a76b9719d911017c592"}
+
+	err := ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, DataStream: &brokenReader{data: []byte("hel")}})
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	// the mismatched upload is dropped before replacing the file
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, Offset: 3, DataStream: strings.NewReader("lO"), Checksum: checksum})
+	assert.Equal(t, file.ErrChecksumMismatch, err)
+	data, err := os.ReadFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "previous", string(data))
+	offset, err := ls.UploadOffset(context.TODO(), &file.FileMetaRequest{FileName: name})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), offset)
+
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, DataStream: &brokenReader{data: []byte("hel")}})
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	err = ls.ResumePut(context.TODO(), &file.PutFileStu{FileName: name, Offset: 3, DataStream: strings.NewReader("lo"), Checksum: checksum})
+	assert.Nil(t, err)
+	data, err = os.ReadFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.True(t, ls.VerifiesChecksum())
+}
+
+func TestPutRemovesPartialFile(t *testing.T) {
+	ls := &LocalStore{}
+	name := filepath.Join(t.TempDir(), FileName)
+	f := &file.PutFileStu{FileName: name, DataStream: &brokenReader{data: []byte("hel")}, Metadata: map[string]string{
+		FileMode: strconv.Itoa(0644),
+		FileFlag: strconv.Itoa(os.O_RDWR | os.O_CREATE),
+	}}
+	err := ls.Put(context.TODO(), f)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	exist, _ := CheckFileExist(name)
+	assert.False(t, exist)
+}
+
+func TestSignURL(t *testing.T) {
+	ls := &LocalStore{}
+	_, err := ls.SignURL(context.TODO(), &file.SignURLStu{FileName: FileName, Method: "GET", Expires: time.Minute})
+	assert.Error(t, err)
+
+	err = ls.Init(context.TODO(), &file.FileConfig{Metadata: []byte(`{"signKey":"key","signURL":"http://127.0.0.1:34999/gateway/signed_file/file_demo/"}`)})
+	assert.Nil(t, err)
+	_, err = ls.SignURL(context.TODO(), &file.SignURLStu{FileName: FileName, Method: "DELETE", Expires: time.Minute})
+	assert.Error(t, err)
+	signed, err := ls.SignURL(context.TODO(), &file.SignURLStu{FileName: FileName, Method: "PUT", Expires: time.Minute, Metadata: map[string]string{"k": "v"}})
+	assert.Nil(t, err)
+	u, err := url.Parse(signed)
+	assert.Nil(t, err)
+	assert.Equal(t, "/gateway/signed_file/file_demo", u.Path)
+
+	st, err := ls.VerifyURL(context.TODO(), u.Query())
+	assert.Nil(t, err)
+	assert.Equal(t, FileName, st.FileName)
+	assert.Equal(t, "PUT", st.Method)
+	assert.Equal(t, strconv.Itoa(0644), st.Metadata[FileMode])
+	assert.Equal(t, "v", st.Metadata["k"])
+
+	// the method is signed
+	query := u.Query()
+	query.Set(QueryMethod, "GET")
+	_, err = ls.VerifyURL(context.TODO(), query)
+	assert.Equal(t, file.ErrPermission, err)
+	// so is the file name
+	query = u.Query()
+	query.Set(QueryName, "other.txt")
+	_, err = ls.VerifyURL(context.TODO(), query)
+	assert.Equal(t, file.ErrPermission, err)
+	// so is the metadata
+	query = u.Query()
+	query.Set(QueryMetadataPrefix+"k", "other")
+	_, err = ls.VerifyURL(context.TODO(), query)
+	assert.Equal(t, file.ErrPermission, err)
+	query = u.Query()
+	query.Set(QueryMetadataPrefix+FileMode, "511")
+	_, err = ls.VerifyURL(context.TODO(), query)
+	assert.Equal(t, file.ErrPermission, err)
+	// and the key
+	other := &LocalStore{signKey: []byte("other")}
+	_, err = other.VerifyURL(context.TODO(), u.Query())
+	assert.Equal(t, file.ErrPermission, err)
+}
+
+func TestVerify(t *testing.T) {
+	key := []byte("key")
+	now := time.Now()
+	meta := map[string]string{"k": "v"}
+	signature := Sign(key, "GET", FileName, now.Unix(), meta)
+	assert.Nil(t, Verify(key, "GET", FileName, now.Unix(), meta, signature, now))
+	assert.Equal(t, file.ErrExpired, Verify(key, "GET", FileName, now.Unix(), meta, signature, now.Add(time.Second)))
+	assert.Equal(t, file.ErrPermission, Verify(key, "GET", FileName, now.Unix()+1, meta, signature, now))
+	assert.Equal(t, file.ErrPermission, Verify(key, "GET", FileName, now.Unix(), nil, signature, now))
+	assert.Equal(t, file.ErrPermission, Verify(key, "GET", FileName, now.Unix(), meta, "not hex", now))
+}
+
+func TestWatch(t *testing.T) {
+	ls := &LocalStore{}
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.TODO())
+	events, err := ls.Watch(ctx, &file.WatchRequest{DirectoryName: dir})
+	assert.Nil(t, err)
+	next := func() *file.FileEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event")
+			return nil
+		}
+	}
+
+	// the staged data of the upload is skipped
+	assert.Nil(t, os.WriteFile(uploadPath(filepath.Join(dir, FileName)), []byte("hello"), 0644))
+	assert.Nil(t, os.Rename(uploadPath(filepath.Join(dir, FileName)), filepath.Join(dir, FileName)))
+	assert.Equal(t, &file.FileEvent{Type: file.EventCreate, FileName: FileName}, next())
+	assert.Nil(t, os.Remove(filepath.Join(dir, FileName)))
+	assert.Equal(t, &file.FileEvent{Type: file.EventDelete, FileName: FileName}, next())
+
+	cancel()
+	for range events {
+	}
+	_, err = ls.Watch(context.TODO(), &file.WatchRequest{DirectoryName: filepath.Join(dir, "not_exist")})
+	assert.NotNil(t, err)
+}
+
+func TestMkdirRenameCopy(t *testing.T) {
+	ls := &LocalStore{}
+	dir := filepath.Join(t.TempDir(), "a", "b")
+	assert.Nil(t, ls.Mkdir(context.TODO(), &file.MkdirRequest{DirectoryName: dir, Metadata: map[string]string{FileMode: strconv.Itoa(0700)}}))
+	info, err := os.Stat(dir)
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir())
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	// the existing directory is fine
+	assert.Nil(t, ls.Mkdir(context.TODO(), &file.MkdirRequest{DirectoryName: dir}))
+	assert.NotNil(t, ls.Mkdir(context.TODO(), &file.MkdirRequest{DirectoryName: dir, Metadata: map[string]string{FileMode: "rwx"}}))
+
+	source := filepath.Join(dir, FileName)
+	assert.Nil(t, os.WriteFile(source, []byte("hello"), 0600))
+	copied := filepath.Join(dir, "copied.txt")
+	assert.Nil(t, ls.Copy(context.TODO(), &file.CopyRequest{Source: source, Target: copied}))
+	data, err := os.ReadFile(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	info, err = os.Stat(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	assert.NotNil(t, ls.Copy(context.TODO(), &file.CopyRequest{Source: dir, Target: copied}))
+
+	renamed := filepath.Join(dir, "renamed.txt")
+	assert.Nil(t, ls.Rename(context.TODO(), &file.RenameRequest{Source: source, Target: renamed}))
+	exist, _ := CheckFileExist(source)
+	assert.False(t, exist)
+	data, err = os.ReadFile(renamed)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	assert.Equal(t, file.ErrNotExist, ls.Rename(context.TODO(), &file.RenameRequest{Source: source, Target: renamed}))
+	assert.Equal(t, file.ErrNotExist, ls.Copy(context.TODO(), &file.CopyRequest{Source: source, Target: copied}))
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/minio/minio-go/v7"
+
+	"mosn.io/layotto/components/file/util"
+)
+
+// multipart implements util.Multipart with the multipart api of minio
+type multipart struct {
+	core   *minio.Core
+	bucket string
+}
+
+func (m *multipart) FindUpload(ctx context.Context, key string) (string, error) {
+	var uploadID, keyMarker, uploadIDMarker string
+	var initiated int64
+	for {
+		out, err := m.core.ListMultipartUploads(ctx, m.bucket, key, keyMarker, uploadIDMarker, "", 0)
+		if err != nil {
+			return "", err
+		}
+		for _, u := range out.Uploads {
+			if u.Key != key {
+				continue
+			}
+			if t := u.Initiated.UnixNano(); uploadID == "" || t > initiated {
+				uploadID, initiated = u.UploadID, t
+			}
+		}
+		if !out.IsTruncated {
+			return uploadID, nil
+		}
+		keyMarker, uploadIDMarker = out.NextKeyMarker, out.NextUploadIDMarker
+	}
+}
+
+func (m *multipart) CreateUpload(ctx context.Context, key string) (string, error) {
+	return m.core.NewMultipartUpload(ctx, m.bucket, key, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+}
+
+func (m *multipart) ListParts(ctx context.Context, key, uploadID string) ([]util.Part, error) {
+	var parts []util.Part
+	marker := 0
+	for {
+		out, err := m.core.ListObjectParts(ctx, m.bucket, key, uploadID, marker, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.ObjectParts {
+			parts = append(parts, util.Part{Number: p.PartNumber, Size: p.Size, ETag: p.ETag})
+		}
+		if !out.IsTruncated {
+			return parts, nil
+		}
+		marker = out.NextPartNumberMarker
+	}
+}
+
+func (m *multipart) UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (util.Part, error) {
+	p, err := m.core.PutObjectPart(ctx, m.bucket, key, uploadID, number, bytes.NewReader(data), int64(len(data)), "", "", nil)
+	if err != nil {
+		return util.Part{}, err
+	}
+	return util.Part{Number: number, Size: int64(len(data)), ETag: p.ETag}, nil
+}
+
+func (m *multipart) CompleteUpload(ctx context.Context, key, uploadID string, parts []util.Part) error {
+	completed := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		completed = append(completed, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+	_, err := m.core.CompleteMultipartUpload(ctx, m.bucket, key, uploadID, completed, minio.PutObjectOptions{})
+	return err
+}
+
+func (m *multipart) AbortUpload(ctx context.Context, key, uploadID string) error {
+	return m.core.AbortMultipartUpload(ctx, m.bucket, key, uploadID)
+}
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"strconv"
 	"sync"
 
@@ -156,6 +158,90 @@ func (m *MinioOss) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser,
 	return obj, nil
 }
 
+func (m *MinioOss) GetRange(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	if st.Offset < 0 || st.Length < 0 {
+		return nil, file.ErrInvalidRange
+	}
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("minio get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("minio get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	core, err := m.selectClient(st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	opts := minio.GetObjectOptions{}
+	if st.Offset > 0 || st.Length > 0 {
+		opts.Set("Range", util.HttpRange(st.Offset, st.Length))
+	}
+	obj, _, _, err := core.GetObject(ctx, bucket, key, opts)
+	if err != nil {
+		if resp, ok := err.(minio.ErrorResponse); ok && resp.Code == "InvalidRange" {
+			return nil, file.ErrInvalidRange
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (m *MinioOss) UploadOffset(ctx context.Context, st *file.FileMetaRequest) (int64, error) {
+	mp, key, err := m.multipart(st.FileName, st.Metadata)
+	if err != nil {
+		return 0, err
+	}
+	return util.UploadedSize(ctx, mp, key)
+}
+
+func (m *MinioOss) ResumePut(ctx context.Context, st *file.PutFileStu) error {
+	mp, key, err := m.multipart(st.FileName, st.Metadata)
+	if err != nil {
+		return err
+	}
+	return util.ResumeUpload(ctx, mp, key, st.DataStream, st.Offset, util.MinPartSize)
+}
+
+// PutMultipart uploads the parts of the file in parallel by the multipart api
+func (m *MinioOss) PutMultipart(ctx context.Context, st *file.PutFileStu, config *file.MultipartConfig) error {
+	mp, key, err := m.multipart(st.FileName, st.Metadata)
+	if err != nil {
+		return err
+	}
+	return util.ParallelUpload(ctx, mp, key, st.DataStream, config.PartSize, config.Concurrency)
+}
+
+// SignURL presigns the url getting or putting the object
+func (m *MinioOss) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return "", fmt.Errorf("minio sign file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return "", fmt.Errorf("minio sign file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	core, err := m.selectClient(st.Metadata)
+	if err != nil {
+		return "", err
+	}
+	var u *url.URL
+	switch st.Method {
+	case http.MethodGet:
+		u, err = core.Client.PresignedGetObject(ctx, bucket, key, st.Expires, nil)
+	case http.MethodPut:
+		u, err = core.Client.PresignedPutObject(ctx, bucket, key, st.Expires)
+	default:
+		return "", fmt.Errorf("minio sign file[%s] fail,err: unsupported method %s", st.FileName, st.Method)
+	}
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
 func (m *MinioOss) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	bucket, err := util.GetBucketName(st.DirectoryName)
 	marker := ""
@@ -202,6 +288,37 @@ func (m *MinioOss) Del(ctx context.Context, st *file.DelRequest) error {
 	return core.Client.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{})
 }
 
+// Copy copies the object by the server side copy of minio
+func (m *MinioOss) Copy(ctx context.Context, st *file.CopyRequest) error {
+	srcBucket, err := util.GetBucketName(st.Source)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", st.Source, err.Error())
+	}
+	srcKey, err := util.GetFileName(st.Source)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", st.Source, err.Error())
+	}
+	bucket, err := util.GetBucketName(st.Target)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", st.Target, err.Error())
+	}
+	key, err := util.GetFileName(st.Target)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", st.Target, err.Error())
+	}
+	core, err := m.selectClient(st.Metadata)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", st.Source, err.Error())
+	}
+	_, err = core.Client.CopyObject(ctx, minio.CopyDestOptions{Bucket: bucket, Object: key}, minio.CopySrcOptions{Bucket: srcBucket, Object: srcKey})
+	return err
+}
+
+// Rename copies the object and then deletes the source, it is not atomic
+func (m *MinioOss) Rename(ctx context.Context, st *file.RenameRequest) error {
+	return util.CopyAndDelete(ctx, m, st)
+}
+
 func (m *MinioOss) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	bucket, err := util.GetBucketName(st.FileName)
 	if err != nil {
@@ -235,6 +352,22 @@ func (m *MinioOss) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.Fi
 	return resp, nil
 }
 
+func (m *MinioOss) multipart(fileName string, meta map[string]string) (*multipart, string, error) {
+	bucket, err := util.GetBucketName(fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("minio upload file[%s] fail,err: %s", fileName, err.Error())
+	}
+	key, err := util.GetFileName(fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("minio upload file[%s] fail,err: %s", fileName, err.Error())
+	}
+	core, err := m.selectClient(meta)
+	if err != nil {
+		return nil, "", err
+	}
+	return &multipart{core: core, bucket: bucket}, key, nil
+}
+
 func (m *MinioOss) createOssClient(meta *MinioMetaData) (*minio.Core, error) {
 	client, err := minio.New(
 		meta.EndPoint,
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
@@ -138,3 +139,37 @@ func TestMinioOss_Get(t *testing.T) {
 	_, err = oss.Get(context.TODO(), getReq)
 	assert.Nil(t, err)
 }
+
+func TestMinioOss_SignURL(t *testing.T) {
+	oss := NewMinioOss()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: json.RawMessage(cfg)})
+	assert.Nil(t, err)
+	signer := oss.(file.Signer)
+
+	url, err := signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket/a.txt", Method: "GET", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "https://endpoint/bucket/a.txt?")
+	assert.Contains(t, url, "X-Amz-Signature=")
+	url, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket/a.txt", Method: "PUT", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.Contains(t, url, "X-Amz-Signature=")
+
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "bucket/a.txt", Method: "DELETE", Expires: time.Minute})
+	assert.Error(t, err)
+	_, err = signer.SignURL(context.TODO(), &file.SignURLStu{FileName: "a.txt", Method: "GET", Expires: time.Minute})
+	assert.Error(t, err)
+}
+
+func TestMinioOss_Copy(t *testing.T) {
+	oss := NewMinioOss()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: json.RawMessage(cfg)})
+	assert.Nil(t, err)
+	copier := oss.(file.Copier)
+
+	err = copier.Copy(context.TODO(), &file.CopyRequest{Source: "a.txt", Target: "bucket/b.txt"})
+	assert.Equal(t, "minio copy file[a.txt] fail,err: invalid fileName format", err.Error())
+	err = copier.Copy(context.TODO(), &file.CopyRequest{Source: "bucket/a.txt", Target: "/b.txt"})
+	assert.Equal(t, "minio copy file[/b.txt] fail,err: invalid fileName format", err.Error())
+	err = oss.(file.Renamer).Rename(context.TODO(), &file.RenameRequest{Source: "bucket/a.txt", Target: "b.txt"})
+	assert.Error(t, err)
+}
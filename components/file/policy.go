@@ -0,0 +1,171 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultQuotaKey is the key of the quota of the app ids without their own quotas
+	DefaultQuotaKey = "*"
+	// AppIdPlaceholder in Directory and NamePrefix is replaced by the app id of the sidecar
+	AppIdPlaceholder = "{app_id}"
+)
+
+// Policy limits the files of a store, it is enforced by the runtime before calling the file component
+// so that a misbehaving app can't fill a shared store
+type Policy struct {
+	// MaxObjectSize is the max size of a file in bytes, 0 means no limit
+	MaxObjectSize int64 `json:"max_object_size"`
+	// Quotas are the max total sizes in bytes of the files of the store, looked up by the app id of the sidecar,
+	// 0 means no limit. The key "*" is the quota of the other app ids.
+	// The usage is the total size of the files in Directory, so each app id has its own usage
+	// if Directory and NamePrefix contain "{app_id}", otherwise the app ids share the usage of the directory.
+	// It is recounted by listing the directory every ExpireInterval, so the files written by the other sidecars
+	// of the app id are seen by the next count
+	Quotas map[string]int64 `json:"quotas"`
+	// AllowedExtensions are the extensions of the file names allowed, e.g. ".png", all are allowed if it is empty
+	AllowedExtensions []string `json:"allowed_extensions"`
+	// AllowedContentTypes are the media types detected from the content allowed, e.g. "image/png" or "image/*",
+	// all are allowed if it is empty
+	AllowedContentTypes []string `json:"allowed_content_types"`
+	// ExpireDays is the days after which the files are deleted, 0 means the files never expire
+	ExpireDays int `json:"expire_days"`
+	// ExpireInterval is the interval in seconds between the scans of the expired files, 3600 by default.
+	// The usage of the quota is recounted by the scans too
+	ExpireInterval int `json:"expire_interval"`
+	// Directory is the directory listing the files of the store,
+	// it is listed to count the usage of the quota and to find the expired files
+	Directory string `json:"directory"`
+	// NamePrefix is prepended to the listed names to delete the expired files,
+	// since the components list the names relative to the bucket or the directory.
+	// The names put by the app must start with it if it contains "{app_id}"
+	NamePrefix string `json:"name_prefix"`
+	// Metadata is the metadata of the requests listing and deleting the files
+	Metadata map[string]string `json:"metadata"`
+
+	// scoped is set by ForApp if the names of the files are scoped by the app id
+	scoped bool
+}
+
+// ForApp returns the policy of the app id, whose Directory and NamePrefix have "{app_id}" replaced by it
+func (p *Policy) ForApp(appId string) *Policy {
+	if !strings.Contains(p.Directory, AppIdPlaceholder) && !strings.Contains(p.NamePrefix, AppIdPlaceholder) {
+		return p
+	}
+	resolved := *p
+	resolved.Directory = strings.ReplaceAll(p.Directory, AppIdPlaceholder, appId)
+	resolved.NamePrefix = strings.ReplaceAll(p.NamePrefix, AppIdPlaceholder, appId)
+	resolved.scoped = strings.Contains(p.NamePrefix, AppIdPlaceholder)
+	return &resolved
+}
+
+// Quota returns the quota of the app id, 0 means no limit
+func (p *Policy) Quota(appId string) int64 {
+	if q, ok := p.Quotas[appId]; ok {
+		return q
+	}
+	return p.Quotas[DefaultQuotaKey]
+}
+
+// AllowName checks the extension of the file name, and its prefix if the names are scoped by the app id
+func (p *Policy) AllowName(name string) bool {
+	if p.scoped && !strings.HasPrefix(name, p.NamePrefix) {
+		return false
+	}
+	if len(p.AllowedExtensions) == 0 {
+		return true
+	}
+	ext := path.Ext(name)
+	for _, allowed := range p.AllowedExtensions {
+		if strings.EqualFold(ext, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowContentType checks the content type, the parameters like charset are ignored
+func (p *Policy) AllowContentType(contentType string) bool {
+	if len(p.AllowedContentTypes) == 0 {
+		return true
+	}
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	for _, allowed := range p.AllowedContentTypes {
+		if strings.EqualFold(mediaType, allowed) {
+			return true
+		}
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(strings.ToLower(mediaType), strings.ToLower(strings.TrimSuffix(allowed, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChecksContent returns whether the content of the files is needed to enforce the policy
+func (p *Policy) ChecksContent() bool {
+	return len(p.AllowedContentTypes) > 0
+}
+
+// Expired returns whether the file modified at lastModified is expired at now,
+// the files with unknown modified time never expire
+func (p *Policy) Expired(lastModified string, now time.Time) bool {
+	if p.ExpireDays <= 0 {
+		return false
+	}
+	t, ok := ParseLastModified(lastModified)
+	return ok && now.Sub(t) > time.Duration(p.ExpireDays)*24*time.Hour
+}
+
+// lastModifiedLayouts are the layouts of the LastModified returned by the components
+var lastModifiedLayouts = []string{
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	time.RFC3339Nano,
+	time.RFC1123,
+	time.RFC1123Z,
+}
+
+// ParseLastModified parses the LastModified of FilesInfo and FileMetaResp.
+// The integers are taken as the unix time in seconds, milliseconds, 100 nanoseconds (qiniu) or nanoseconds by their magnitudes
+func ParseLastModified(s string) (time.Time, bool) {
+	// drop the monotonic clock reading of time.Time.String
+	if i := strings.Index(s, " m="); i > 0 {
+		s = s[:i]
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		switch {
+		case n < 1e11:
+			return time.Unix(n, 0), true
+		case n < 1e14:
+			return time.UnixMilli(n), true
+		case n < 1e17:
+			return time.Unix(0, n*100), true
+		default:
+			return time.Unix(0, n), true
+		}
+	}
+	for _, layout := range lastModifiedLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy(t *testing.T) {
+	p := &Policy{
+		Quotas:              map[string]int64{"app1": 10, DefaultQuotaKey: 5},
+		AllowedExtensions:   []string{".png", ".JPG"},
+		AllowedContentTypes: []string{"image/*", "text/plain"},
+	}
+	assert.Equal(t, int64(10), p.Quota("app1"))
+	assert.Equal(t, int64(5), p.Quota("app2"))
+	assert.Equal(t, int64(0), (&Policy{}).Quota("app1"))
+
+	assert.True(t, p.AllowName("dir/a.png"))
+	assert.True(t, p.AllowName("a.jpg"))
+	assert.False(t, p.AllowName("a.exe"))
+	assert.False(t, p.AllowName("png"))
+	assert.True(t, (&Policy{}).AllowName("a.exe"))
+
+	assert.True(t, p.ChecksContent())
+	assert.True(t, p.AllowContentType("image/png"))
+	assert.True(t, p.AllowContentType("text/plain; charset=utf-8"))
+	assert.False(t, p.AllowContentType("text/html; charset=utf-8"))
+	assert.False(t, p.AllowContentType("application/octet-stream"))
+	assert.True(t, (&Policy{}).AllowContentType("application/octet-stream"))
+}
+
+func TestPolicyForApp(t *testing.T) {
+	p := &Policy{Directory: "layotto", NamePrefix: "layotto/"}
+	assert.Same(t, p, p.ForApp("app1"))
+	assert.True(t, p.ForApp("app1").AllowName("a.png"))
+
+	p = &Policy{Directory: "layotto/{app_id}", NamePrefix: "layotto/{app_id}/"}
+	resolved := p.ForApp("app1")
+	assert.Equal(t, "layotto/app1", resolved.Directory)
+	assert.Equal(t, "layotto/app1/", resolved.NamePrefix)
+	assert.True(t, resolved.AllowName("layotto/app1/a.png"))
+	assert.False(t, resolved.AllowName("layotto/app2/a.png"))
+	assert.Equal(t, "layotto/{app_id}", p.Directory)
+}
+
+func TestPolicyExpired(t *testing.T) {
+	now := time.Date(2022, 5, 10, 0, 0, 0, 0, time.UTC)
+	p := &Policy{ExpireDays: 7}
+	assert.True(t, p.Expired(now.Add(-8*24*time.Hour).String(), now))
+	assert.False(t, p.Expired(now.Add(-6*24*time.Hour).String(), now))
+	assert.True(t, p.Expired("2022-05-01T08:44:07.000Z", now))
+	assert.False(t, p.Expired("unknown", now))
+	assert.False(t, (&Policy{}).Expired("2000-01-01T00:00:00Z", now))
+}
+
+func TestParseLastModified(t *testing.T) {
+	expected := time.Date(2022, 5, 10, 8, 0, 0, 0, time.UTC)
+	for _, s := range []string{
+		expected.String(),
+		expected.In(time.FixedZone("CST", 8*3600)).String(),
+		"2022-05-10T08:00:00Z",
+		"Tue, 10 May 2022 08:00:00 GMT",
+		"1652169600",
+		"1652169600000",
+		"16521696000000000",
+		"1652169600000000000",
+	} {
+		parsed, ok := ParseLastModified(s)
+		assert.True(t, ok, s)
+		assert.True(t, expected.Equal(parsed), s)
+	}
+	_, ok := ParseLastModified("yesterday")
+	assert.False(t, ok)
+}
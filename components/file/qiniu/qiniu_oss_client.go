@@ -48,6 +48,8 @@ type FormUploader interface {
 type BucketManager interface {
 	Stat(bucket, key string) (storage.FileInfo, error)
 	Delete(bucket, key string) (err error)
+	Copy(srcBucket, srcKey, destBucket, destKey string, force bool) (err error)
+	Move(srcBucket, srcKey, destBucket, destKey string, force bool) (err error)
 	ListFiles(bucket, prefix, delimiter, marker string,
 		limit int) (entries []storage.ListItem, commonPrefixes []string, nextMarker string, hasNext bool, err error)
 }
@@ -112,6 +114,17 @@ func (s *QiniuOSSClient) get(_ context.Context, fileName string) (io.ReadCloser,
 	return resp.Body, nil
 }
 
+// signURL returns the url downloading the file, the url of a private bucket is valid until the deadline
+func (s *QiniuOSSClient) signURL(fileName string, deadline time.Time) (string, error) {
+	if err := s.checkFileName(fileName); err != nil {
+		return "", err
+	}
+	if !s.Private {
+		return storage.MakePublicURL(s.Domain, fileName), nil
+	}
+	return storage.MakePrivateURL(s.mac, s.Domain, fileName, deadline.Unix()), nil
+}
+
 func (s *QiniuOSSClient) stat(_ context.Context, fileName string) (*storage.FileInfo, error) {
 	if err := s.checkFileName(fileName); err != nil {
 		return nil, err
@@ -129,6 +142,30 @@ func (s *QiniuOSSClient) del(_ context.Context, fileName string) error {
 	return s.bm.Delete(s.Bucket, fileName)
 }
 
+// copy copies the file in the bucket, the target is overwritten if it exists
+func (s *QiniuOSSClient) copy(_ context.Context, source, target string) error {
+	if err := s.checkFileName(source); err != nil {
+		return err
+	}
+	if err := s.checkFileName(target); err != nil {
+		return err
+	}
+
+	return s.bm.Copy(s.Bucket, source, s.Bucket, target, true)
+}
+
+// move moves the file in the bucket, the target is overwritten if it exists
+func (s *QiniuOSSClient) move(_ context.Context, source, target string) error {
+	if err := s.checkFileName(source); err != nil {
+		return err
+	}
+	if err := s.checkFileName(target); err != nil {
+		return err
+	}
+
+	return s.bm.Move(s.Bucket, source, s.Bucket, target, true)
+}
+
 func (s *QiniuOSSClient) list(_ context.Context, prefix string, limit int, marker string) (entries []storage.ListItem, commonPrefixes []string, nextMarker string, hasNext bool, err error) {
 	if limit > 1000 {
 		return nil, nil, "", false, errors.New("limit must be <=1000")
@@ -20,7 +20,9 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/jarcoal/httpmock"
@@ -164,6 +166,22 @@ func TestDeleteFile(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCopyAndMoveFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fu := mock.NewMockFormUploader(ctrl)
+	bm := mock.NewMockBucketManager(ctrl)
+
+	bm.EXPECT().Copy(gomock.Eq("xc2022"), gomock.Eq("a.txt"), gomock.Eq("xc2022"), gomock.Eq("b.txt"), gomock.Eq(true)).Return(nil)
+	bm.EXPECT().Move(gomock.Eq("xc2022"), gomock.Eq("a.txt"), gomock.Eq("xc2022"), gomock.Eq("c.txt"), gomock.Eq(true)).Return(errors.New("aa"))
+	s := newMockQiniuOSSClient("ak", "sk", "xc2022", "https://example.com", false, fu, bm)
+
+	assert.NoError(t, s.copy(context.Background(), "a.txt", "b.txt"))
+	assert.Error(t, s.move(context.Background(), "a.txt", "c.txt"))
+	assert.Error(t, s.copy(context.Background(), "a.txt", "/b.txt"))
+	assert.Error(t, s.move(context.Background(), "/a.txt", "c.txt"))
+}
+
 func TestListFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -197,6 +215,21 @@ func TestListFile(t *testing.T) {
 
 }
 
+func TestSignURL(t *testing.T) {
+	s := newMockQiniuOSSClient("ak", "sk", "xc2022", "https://example.com", true, nil, nil)
+	url, err := s.signURL("b/a.txt", time.Unix(1700000000, 0))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(url, "https://example.com/b/a.txt?e=1700000000&token=a:"))
+
+	s.Private = false
+	url, err = s.signURL("b/a.txt", time.Unix(1700000000, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/b/a.txt", url)
+
+	_, err = s.signURL("/a.txt", time.Now())
+	assert.Error(t, err)
+}
+
 func newMockQiniuOSSClient(ak, sk, bucket, domain string, private bool, fu FormUploader, bm BucketManager) *QiniuOSSClient {
 	s := &QiniuOSSClient{
 		AccessKey: ak,
@@ -20,9 +20,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"mosn.io/layotto/components/file"
 	"mosn.io/layotto/components/pkg/actuators"
@@ -175,6 +178,19 @@ func (q *QiniuOSS) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser,
 	return client.get(ctx, st.FileName)
 }
 
+// SignURL returns the url downloading the file,
+// the uploads to qiniu need an upload token instead of a signed url, so PUT is not supported
+func (q *QiniuOSS) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	if st.Method != http.MethodGet {
+		return "", fmt.Errorf("qiniu sign file[%s] fail,err: unsupported method %s", st.FileName, st.Method)
+	}
+	client, err := q.selectClient(st.Metadata)
+	if err != nil {
+		return "", err
+	}
+	return client.signURL(st.FileName, time.Now().Add(st.Expires))
+}
+
 func (q *QiniuOSS) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	client, err := q.selectClient(st.Metadata)
 	if err != nil {
@@ -219,6 +235,25 @@ func (q *QiniuOSS) Del(ctx context.Context, st *file.DelRequest) error {
 	return client.del(ctx, st.FileName)
 }
 
+// Rename moves the file by kodo, which is atomic
+func (q *QiniuOSS) Rename(ctx context.Context, st *file.RenameRequest) error {
+	client, err := q.selectClient(st.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.move(ctx, st.Source, st.Target)
+}
+
+func (q *QiniuOSS) Copy(ctx context.Context, st *file.CopyRequest) error {
+	client, err := q.selectClient(st.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.copy(ctx, st.Source, st.Target)
+}
+
 func (q *QiniuOSS) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	client, err := q.selectClient(st.Metadata)
 	if err != nil {
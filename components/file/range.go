@@ -0,0 +1,62 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package file
+
+import (
+	"context"
+	"io"
+)
+
+// GetRange reads the byte range of st from f, natively if f is a RangeGetter
+// and otherwise by skipping the data before the offset of the whole file
+func GetRange(ctx context.Context, f File, st *GetFileStu) (io.ReadCloser, error) {
+	if st.Offset < 0 || st.Length < 0 {
+		return nil, ErrInvalidRange
+	}
+	if rg, ok := f.(RangeGetter); ok {
+		return rg.GetRange(ctx, st)
+	}
+	rc, err := f.Get(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	if st.Offset > 0 {
+		n, err := io.CopyN(io.Discard, rc, st.Offset)
+		if err != nil && err != io.EOF {
+			rc.Close()
+			return nil, err
+		}
+		if n < st.Offset {
+			rc.Close()
+			return nil, ErrInvalidRange
+		}
+	}
+	return LimitReadCloser(rc, st.Length), nil
+}
+
+// LimitReadCloser limits rc to n bytes, a zero n means no limit
+func LimitReadCloser(rc io.ReadCloser, n int64) io.ReadCloser {
+	if n <= 0 {
+		return rc
+	}
+	return &limitReadCloser{Reader: io.LimitReader(rc, n), Closer: rc}
+}
+
+type limitReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"mosn.io/layotto/components/file"
+)
+
+const defaultPageSize = 100
+
+// MigrationOptions selects the files copied by a Migration
+type MigrationOptions struct {
+	// Directory is the directory listing the files to copy
+	Directory string `json:"directory"`
+	// SourcePrefix is prepended to the listed names to get the files from the source,
+	// since the components list the names relative to the bucket or the directory
+	SourcePrefix string `json:"source_prefix"`
+	// TargetPrefix is prepended to the listed names to put the files to the target
+	TargetPrefix string `json:"target_prefix"`
+	// PageSize is the number of the files listed at a time, 100 by default
+	PageSize int32 `json:"page_size"`
+	// Checkpoint is the file saving the progress after each page, the migration is resumed from it if it exists
+	Checkpoint string `json:"checkpoint"`
+	// SourceMetadata is the metadata of the requests to the source
+	SourceMetadata map[string]string `json:"source_metadata"`
+	// TargetMetadata is the metadata of the requests to the target
+	TargetMetadata map[string]string `json:"target_metadata"`
+}
+
+// MigrationProgress is the progress of a Migration, it is saved as the checkpoint
+type MigrationProgress struct {
+	// Marker is the marker listing the next page
+	Marker string `json:"marker"`
+	// Copied is the number of the copied files
+	Copied int64 `json:"copied"`
+	// Failed are the names of the files failed to copy
+	Failed []string `json:"failed,omitempty"`
+	// Done is whether all the files are listed
+	Done bool `json:"done"`
+}
+
+// Migration copies all the files listed in a directory from one file component to another once.
+// The files failed to copy are recorded in the progress rather than stopping the migration,
+// while a failure of the listing stops it, and it can be resumed from the checkpoint later.
+type Migration struct {
+	source  file.File
+	target  file.File
+	options MigrationOptions
+
+	mu       sync.RWMutex
+	progress MigrationProgress
+}
+
+func NewMigration(source file.File, target file.File, options MigrationOptions) (*Migration, error) {
+	if source == nil || target == nil {
+		return nil, errors.New("source and target are required for migration")
+	}
+	if options.Checkpoint == "" {
+		return nil, errors.New("checkpoint is required for migration")
+	}
+	if options.PageSize <= 0 {
+		options.PageSize = defaultPageSize
+	}
+	m := &Migration{source: source, target: target, options: options}
+	data, err := ioutil.ReadFile(options.Checkpoint)
+	if err == nil {
+		err = json.Unmarshal(data, &m.progress)
+	} else if os.IsNotExist(err) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Progress returns a snapshot of the progress
+func (m *Migration) Progress() MigrationProgress {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p := m.progress
+	p.Failed = append([]string(nil), m.progress.Failed...)
+	return p
+}
+
+// Run copies the files page by page from the checkpoint, until all the files are listed or the context is done
+func (m *Migration) Run(ctx context.Context) error {
+	p := m.Progress()
+	for !p.Done {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		resp, err := m.source.List(ctx, &file.ListRequest{
+			DirectoryName: m.options.Directory,
+			Marker:        p.Marker,
+			PageSize:      m.options.PageSize,
+			Metadata:      m.options.SourceMetadata,
+		})
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.Files {
+			if err = m.copy(ctx, info.FileName); err != nil {
+				p.Failed = append(p.Failed, info.FileName)
+			} else {
+				p.Copied++
+			}
+		}
+		p.Marker = resp.Marker
+		p.Done = !resp.IsTruncated || resp.Marker == ""
+		if err = m.save(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migration) copy(ctx context.Context, name string) error {
+	data, err := m.source.Get(ctx, &file.GetFileStu{FileName: m.options.SourcePrefix + name, Metadata: m.options.SourceMetadata})
+	if err != nil {
+		return err
+	}
+	defer data.Close()
+	return m.target.Put(ctx, &file.PutFileStu{DataStream: data, FileName: m.options.TargetPrefix + name, Metadata: m.options.TargetMetadata})
+}
+
+// save writes the checkpoint by renaming a temporary file, so that a crash doesn't leave a partial checkpoint
+func (m *Migration) save(p MigrationProgress) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	dir, name := filepath.Split(m.options.Checkpoint)
+	tmp := filepath.Join(dir, "."+name+".tmp")
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, m.options.Checkpoint); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.progress = p
+	m.mu.Unlock()
+	return nil
+}
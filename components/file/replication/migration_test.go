@@ -0,0 +1,86 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/filetest"
+)
+
+// failingListStore fails the listing after the pages are listed
+type failingListStore struct {
+	*filetest.MemoryStore
+	pages int
+}
+
+func (f *failingListStore) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	if f.pages == 0 {
+		return nil, errors.New("list fail")
+	}
+	f.pages--
+	return f.MemoryStore.List(ctx, st)
+}
+
+func TestMigration(t *testing.T) {
+	source, target := filetest.NewMemoryStore(), filetest.NewMemoryStore()
+	for _, name := range []string{"dir/a", "dir/b", "dir/c", "dir/d", "dir/e", "other/f"} {
+		source.SetFile(name, []byte(name))
+	}
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint.json")
+	options := MigrationOptions{Directory: "dir/", TargetPrefix: "copy/", PageSize: 2, Checkpoint: checkpoint}
+
+	// the listing fails after the first page
+	m, err := NewMigration(&failingListStore{MemoryStore: source, pages: 1}, target, options)
+	require.Nil(t, err)
+	assert.Error(t, m.Run(context.TODO()))
+	assert.Equal(t, MigrationProgress{Marker: "dir/b", Copied: 2}, m.Progress())
+
+	// it is resumed from the checkpoint
+	require.Nil(t, source.Del(context.TODO(), &file.DelRequest{FileName: "dir/a"}))
+	target.SetBroken(true)
+	m, err = NewMigration(source, target, options)
+	require.Nil(t, err)
+	assert.Equal(t, "dir/b", m.Progress().Marker)
+	// the files failed to copy don't stop the migration
+	assert.Nil(t, m.Run(context.TODO()))
+	assert.Equal(t, MigrationProgress{Marker: "dir/e", Copied: 2, Failed: []string{"dir/c", "dir/d", "dir/e"}, Done: true}, m.Progress())
+	data, _ := target.File("copy/dir/a")
+	assert.Equal(t, "dir/a", string(data))
+	_, ok := target.File("copy/other/f")
+	assert.False(t, ok)
+
+	// a finished migration does nothing
+	target.SetBroken(false)
+	m, err = NewMigration(source, target, options)
+	require.Nil(t, err)
+	assert.Nil(t, m.Run(context.TODO()))
+	_, ok = target.File("copy/dir/c")
+	assert.False(t, ok)
+
+	_, err = NewMigration(source, target, MigrationOptions{})
+	assert.Error(t, err)
+	_, err = NewMigration(nil, target, options)
+	assert.Error(t, err)
+}
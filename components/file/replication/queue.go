@@ -0,0 +1,140 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	opPut = "put"
+	opDel = "del"
+
+	taskSuffix = ".json"
+)
+
+// task is a file waiting to be replicated, the replica is synchronized with the current state of the file in the store,
+// so the tasks of the same file don't need to be replayed in order
+type task struct {
+	Op       string            `json:"op"`
+	FileName string            `json:"fileName"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// queue is the persistent retry queue of the replication, each task is a json file in the directory.
+// The files are named by the sequence of the tasks, so the tasks left by the last run are replayed first after a restart
+type queue struct {
+	dir    string
+	mu     sync.Mutex
+	seq    uint64
+	notify chan struct{}
+}
+
+func newQueue(dir string) (*queue, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	q := &queue{dir: dir, notify: make(chan struct{}, 1)}
+	names, err := q.names()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > 0 {
+		last, _ := strconv.ParseUint(strings.TrimSuffix(names[len(names)-1], taskSuffix), 10, 64)
+		q.seq = last
+	}
+	return q, nil
+}
+
+// push persists the task, it is written to a temporary file first so that a crash doesn't leave a partial task
+func (q *queue) push(t *task) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	q.mu.Lock()
+	q.seq++
+	name := fmt.Sprintf("%020d%s", q.seq, taskSuffix)
+	q.mu.Unlock()
+	tmp := filepath.Join(q.dir, "."+name)
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(q.dir, name)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// peek returns the oldest task with its name, the task is nil if the queue is empty
+func (q *queue) peek() (string, *task, error) {
+	names, err := q.names()
+	if err != nil || len(names) == 0 {
+		return "", nil, err
+	}
+	data, err := ioutil.ReadFile(filepath.Join(q.dir, names[0]))
+	if err != nil {
+		return "", nil, err
+	}
+	t := &task{}
+	if err = json.Unmarshal(data, t); err != nil {
+		// a broken task can't be retried, drop it
+		q.remove(names[0])
+		return "", nil, fmt.Errorf("broken replication task %s: %v", names[0], err)
+	}
+	return names[0], t, nil
+}
+
+func (q *queue) remove(name string) error {
+	return os.Remove(filepath.Join(q.dir, name))
+}
+
+func (q *queue) len() (int, error) {
+	names, err := q.names()
+	return len(names), err
+}
+
+// names returns the names of the tasks in the order of their sequences
+func (q *queue) names() ([]string, error) {
+	infos, err := ioutil.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, taskSuffix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
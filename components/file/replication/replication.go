@@ -0,0 +1,297 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/pkg/actuators"
+	"mosn.io/layotto/kit/logger"
+)
+
+const (
+	componentName = "file-replication"
+
+	// ModeSync replicates the files before the writes return
+	ModeSync = "sync"
+	// ModeAsync replicates the files in the background after the writes return
+	ModeAsync = "async"
+
+	defaultRetryInterval = 1000
+)
+
+var (
+	ErrMissingStore   = errors.New("store is required for replication file")
+	ErrMissingReplica = errors.New("replica is required for replication file")
+
+	once               sync.Once
+	readinessIndicator *actuators.HealthIndicator
+	livenessIndicator  *actuators.HealthIndicator
+)
+
+func init() {
+	readinessIndicator = actuators.NewHealthIndicator()
+	livenessIndicator = actuators.NewHealthIndicator()
+}
+
+// Metadata is the config of the replication file
+type Metadata struct {
+	// Store is the config of the wrapped file component, which serves the reads
+	Store *file.FileConfig `json:"store"`
+	// Replica is the config of the file component the writes are mirrored to
+	Replica *file.FileConfig `json:"replica"`
+	// Mode is sync or async, async by default
+	Mode string `json:"mode"`
+	// QueueDir is the directory of the persistent retry queue
+	QueueDir string `json:"queueDir"`
+	// RetryInterval is the interval in milliseconds between the retries of a failed replication, 1000 by default
+	RetryInterval int `json:"retryInterval"`
+}
+
+// ReplicationFile wraps a file component to mirror the puts and the deletes to a replica.
+// The reads are served by the wrapped component only.
+// A write is replicated by copying the file from the wrapped component to the replica after the write,
+// or deleting it from the replica if the file doesn't exist anymore.
+// In the sync mode the writes return the errors of the replication, while in the async mode they return once the write is queued.
+// The failed replications are kept in the queue and retried until they succeed, even after a restart.
+type ReplicationFile struct {
+	store         file.File
+	replica       file.File
+	mode          string
+	queue         *queue
+	retryInterval time.Duration
+	stop          chan struct{}
+	stopOnce      sync.Once
+	logger        logger.Logger
+}
+
+func NewReplicationFile() file.File {
+	once.Do(func() {
+		indicators := &actuators.ComponentsIndicator{ReadinessIndicator: readinessIndicator, LivenessIndicator: livenessIndicator}
+		actuators.SetComponentsIndicator(componentName, indicators)
+	})
+	r := &ReplicationFile{
+		stop:   make(chan struct{}),
+		logger: logger.NewLayottoLogger("file/replication"),
+	}
+	logger.RegisterComponentLoggerListener("file/replication", r)
+	return r
+}
+
+func (r *ReplicationFile) OnLogLevelChanged(level logger.LogLevel) {
+	r.logger.SetLogLevel(level)
+}
+
+func (r *ReplicationFile) WrappedConfig(config *file.FileConfig) (*file.FileConfig, error) {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Store, nil
+}
+
+func (r *ReplicationFile) Wrap(store file.File) {
+	r.store = store
+}
+
+func (r *ReplicationFile) ReplicaConfig(config *file.FileConfig) (*file.FileConfig, error) {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Replica, nil
+}
+
+func (r *ReplicationFile) SetReplica(replica file.File) {
+	r.replica = replica
+}
+
+func (r *ReplicationFile) Init(ctx context.Context, config *file.FileConfig) error {
+	if err := r.init(config); err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	go r.run()
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (r *ReplicationFile) init(config *file.FileConfig) error {
+	meta, err := parseMetadata(config)
+	if err != nil {
+		return err
+	}
+	if r.store == nil {
+		return ErrMissingStore
+	}
+	if r.replica == nil {
+		return ErrMissingReplica
+	}
+	if r.queue, err = newQueue(meta.QueueDir); err != nil {
+		return err
+	}
+	r.mode = meta.Mode
+	r.retryInterval = time.Duration(meta.RetryInterval) * time.Millisecond
+	return nil
+}
+
+func parseMetadata(config *file.FileConfig) (*Metadata, error) {
+	meta := &Metadata{}
+	if err := json.Unmarshal(config.Metadata, meta); err != nil {
+		return nil, err
+	}
+	if meta.Store == nil {
+		return nil, ErrMissingStore
+	}
+	if meta.Replica == nil {
+		return nil, ErrMissingReplica
+	}
+	if meta.Mode == "" {
+		meta.Mode = ModeAsync
+	}
+	if meta.Mode != ModeSync && meta.Mode != ModeAsync {
+		return nil, fmt.Errorf("unknown mode %s of replication file", meta.Mode)
+	}
+	if meta.QueueDir == "" {
+		return nil, errors.New("queueDir is required for replication file")
+	}
+	if meta.RetryInterval <= 0 {
+		meta.RetryInterval = defaultRetryInterval
+	}
+	return meta, nil
+}
+
+// Close stops retrying the queued replications, and closes the store and the replica.
+// The queued replications are retried by the component initialized with the same queue.
+func (r *ReplicationFile) Close() error {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+	return file.CloseWrapped(r.store, r.replica)
+}
+
+func (r *ReplicationFile) Put(ctx context.Context, st *file.PutFileStu) error {
+	if err := r.store.Put(ctx, st); err != nil {
+		return err
+	}
+	return r.replicate(ctx, &task{Op: opPut, FileName: st.FileName, Metadata: st.Metadata})
+}
+
+func (r *ReplicationFile) Del(ctx context.Context, st *file.DelRequest) error {
+	if err := r.store.Del(ctx, st); err != nil {
+		return err
+	}
+	return r.replicate(ctx, &task{Op: opDel, FileName: st.FileName, Metadata: st.Metadata})
+}
+
+func (r *ReplicationFile) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	return r.store.Get(ctx, st)
+}
+
+func (r *ReplicationFile) GetRange(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	return file.GetRange(ctx, r.store, st)
+}
+
+func (r *ReplicationFile) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	return r.store.List(ctx, st)
+}
+
+func (r *ReplicationFile) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
+	return r.store.Stat(ctx, st)
+}
+
+// Pending returns the number of the replications waiting in the queue
+func (r *ReplicationFile) Pending() (int, error) {
+	return r.queue.len()
+}
+
+// replicate synchronizes the replica in the sync mode, the task is queued if it fails or in the async mode
+func (r *ReplicationFile) replicate(ctx context.Context, t *task) error {
+	if r.mode == ModeAsync {
+		return r.queue.push(t)
+	}
+	err := r.sync(ctx, t)
+	if err == nil {
+		return nil
+	}
+	if qerr := r.queue.push(t); qerr != nil {
+		return fmt.Errorf("replicate file[%s] fail, err: %v, and it can't be queued, err: %v", t.FileName, err, qerr)
+	}
+	return fmt.Errorf("replicate file[%s] fail, it is queued for retry, err: %v", t.FileName, err)
+}
+
+// sync copies the file to the replica if it exists in the store, or deletes it from the replica
+func (r *ReplicationFile) sync(ctx context.Context, t *task) error {
+	data, err := r.store.Get(ctx, &file.GetFileStu{FileName: t.FileName, Metadata: t.Metadata})
+	if err == nil {
+		defer data.Close()
+		return r.replica.Put(ctx, &file.PutFileStu{DataStream: data, FileName: t.FileName, Metadata: t.Metadata})
+	}
+	if !r.notExist(ctx, r.store, t) {
+		return err
+	}
+	if err = r.replica.Del(ctx, &file.DelRequest{FileName: t.FileName, Metadata: t.Metadata}); err != nil && !r.notExist(ctx, r.replica, t) {
+		return err
+	}
+	return nil
+}
+
+func (r *ReplicationFile) notExist(ctx context.Context, store file.File, t *task) bool {
+	_, err := store.Stat(ctx, &file.FileMetaRequest{FileName: t.FileName, Metadata: t.Metadata})
+	return err == file.ErrNotExist
+}
+
+// run retries the queued replications in order, a failed one is retried after the retry interval
+func (r *ReplicationFile) run() {
+	for {
+		name, t, err := r.queue.peek()
+		if err != nil {
+			r.logger.Errorf("[file/replication] read the queue fail, err: %v", err)
+		}
+		if t != nil {
+			if err = r.sync(context.Background(), t); err == nil {
+				if err = r.queue.remove(name); err == nil {
+					continue
+				}
+			}
+			r.logger.Warnf("[file/replication] replicate file[%s] fail, it will be retried, err: %v", t.FileName, err)
+		}
+		wait := time.NewTimer(r.retryInterval)
+		notify := r.queue.notify
+		if t != nil {
+			// the failed one is not retried until the interval passes
+			notify = nil
+		}
+		select {
+		case <-r.stop:
+			wait.Stop()
+			return
+		case <-notify:
+		case <-wait.C:
+		}
+		wait.Stop()
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/filetest"
+)
+
+func newReplicationFile(t *testing.T, mode string, queueDir string, store, replica file.File) *ReplicationFile {
+	r := NewReplicationFile().(*ReplicationFile)
+	config := &file.FileConfig{Metadata: []byte(`{"store":{"type":"primary"},"replica":{"type":"secondary"},"mode":"` + mode + `","queueDir":"` + queueDir + `","retryInterval":10}`)}
+	wrapped, err := r.WrappedConfig(config)
+	require.Nil(t, err)
+	assert.Equal(t, "primary", wrapped.Type)
+	replicaConfig, err := r.ReplicaConfig(config)
+	require.Nil(t, err)
+	assert.Equal(t, "secondary", replicaConfig.Type)
+	r.Wrap(store)
+	r.SetReplica(replica)
+	require.Nil(t, r.Init(context.TODO(), config))
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+func put(r file.File, name string, data string) error {
+	return r.Put(context.TODO(), &file.PutFileStu{FileName: name, DataStream: strings.NewReader(data)})
+}
+
+func TestReplicationFileSync(t *testing.T) {
+	store, replica := filetest.NewMemoryStore(), filetest.NewMemoryStore()
+	r := newReplicationFile(t, ModeSync, t.TempDir(), store, replica)
+
+	require.Nil(t, put(r, "a.txt", "hello"))
+	data, _ := replica.File("a.txt")
+	assert.Equal(t, "hello", string(data))
+	require.Nil(t, r.Del(context.TODO(), &file.DelRequest{FileName: "a.txt"}))
+	_, ok := replica.File("a.txt")
+	assert.False(t, ok)
+	// deleting a file missing in the replica succeeds
+	store.SetFile("b.txt", []byte("b"))
+	require.Nil(t, r.Del(context.TODO(), &file.DelRequest{FileName: "b.txt"}))
+
+	// the failed replication is returned and retried in the background
+	replica.SetBroken(true)
+	err := put(r, "c.txt", "world")
+	assert.Contains(t, err.Error(), "queued for retry")
+	data, _ = store.File("c.txt")
+	assert.Equal(t, "world", string(data))
+	pending, err := r.Pending()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, pending)
+	replica.SetBroken(false)
+	assert.Eventually(t, func() bool {
+		data, _ := replica.File("c.txt")
+		return string(data) == "world"
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		pending, _ := r.Pending()
+		return pending == 0
+	}, time.Second, 10*time.Millisecond)
+
+	// the reads are served by the store
+	store.SetFile("d.txt", []byte("store only"))
+	reader, err := r.Get(context.TODO(), &file.GetFileStu{FileName: "d.txt"})
+	require.Nil(t, err)
+	data, _ = ioutil.ReadAll(reader)
+	assert.Equal(t, "store only", string(data))
+	reader, err = r.GetRange(context.TODO(), &file.GetFileStu{FileName: "d.txt", Offset: 6})
+	require.Nil(t, err)
+	data, _ = ioutil.ReadAll(reader)
+	assert.Equal(t, "only", string(data))
+}
+
+func TestReplicationFileAsync(t *testing.T) {
+	store, replica := filetest.NewMemoryStore(), filetest.NewMemoryStore()
+	replica.SetBroken(true)
+	queueDir := t.TempDir()
+	r := newReplicationFile(t, ModeAsync, queueDir, store, replica)
+
+	require.Nil(t, put(r, "a.txt", "hello"))
+	require.Nil(t, put(r, "b.txt", "world"))
+	require.Nil(t, r.Del(context.TODO(), &file.DelRequest{FileName: "b.txt"}))
+	// the queue survives a restart
+	r.Close()
+	pending, err := r.Pending()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pending)
+
+	replica.SetBroken(false)
+	r = newReplicationFile(t, ModeAsync, queueDir, store, replica)
+	assert.Eventually(t, func() bool {
+		pending, _ := r.Pending()
+		return pending == 0
+	}, time.Second, 10*time.Millisecond)
+	data, _ := replica.File("a.txt")
+	assert.Equal(t, "hello", string(data))
+	_, ok := replica.File("b.txt")
+	assert.False(t, ok)
+
+	require.Nil(t, put(r, "c.txt", "async"))
+	assert.Eventually(t, func() bool {
+		data, _ := replica.File("c.txt")
+		return string(data) == "async"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestReplicationFileInit(t *testing.T) {
+	r := NewReplicationFile().(*ReplicationFile)
+	_, err := r.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"replica":{"type":"memory"},"queueDir":"q"}`)})
+	assert.Equal(t, ErrMissingStore, err)
+	_, err = r.ReplicaConfig(&file.FileConfig{Metadata: []byte(`{"store":{"type":"memory"},"queueDir":"q"}`)})
+	assert.Equal(t, ErrMissingReplica, err)
+	_, err = r.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"store":{"type":"memory"},"replica":{"type":"memory"}}`)})
+	assert.Error(t, err)
+	_, err = r.WrappedConfig(&file.FileConfig{Metadata: []byte(`{"store":{"type":"memory"},"replica":{"type":"memory"},"queueDir":"q","mode":"other"}`)})
+	assert.Error(t, err)
+	err = r.Init(context.TODO(), &file.FileConfig{Metadata: []byte(`{"store":{"type":"memory"},"replica":{"type":"memory"},"queueDir":"q"}`)})
+	assert.Equal(t, ErrMissingStore, err)
+}
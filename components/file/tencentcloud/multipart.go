@@ -0,0 +1,63 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tencentcloud
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+
+	"mosn.io/layotto/components/file/util"
+)
+
+// partUploader implements util.PartUploader with the multipart api of cos
+type partUploader struct {
+	client *cos.Client
+	// options of the uploaded object
+	options *cos.InitiateMultipartUploadOptions
+}
+
+func (p *partUploader) CreateUpload(ctx context.Context, key string) (string, error) {
+	out, _, err := p.client.Object.InitiateMultipartUpload(ctx, key, p.options)
+	if err != nil {
+		return "", err
+	}
+	return out.UploadID, nil
+}
+
+func (p *partUploader) UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (util.Part, error) {
+	resp, err := p.client.Object.UploadPart(ctx, key, uploadID, number, bytes.NewReader(data), nil)
+	if err != nil {
+		return util.Part{}, err
+	}
+	return util.Part{Number: number, Size: int64(len(data)), ETag: resp.Header.Get("ETag")}, nil
+}
+
+func (p *partUploader) CompleteUpload(ctx context.Context, key, uploadID string, parts []util.Part) error {
+	opt := &cos.CompleteMultipartUploadOptions{}
+	for _, part := range parts {
+		opt.Parts = append(opt.Parts, cos.Object{PartNumber: part.Number, ETag: part.ETag})
+	}
+	_, _, err := p.client.Object.CompleteMultipartUpload(ctx, key, uploadID, opt)
+	return err
+}
+
+func (p *partUploader) AbortUpload(ctx context.Context, key, uploadID string) error {
+	_, err := p.client.Object.AbortMultipartUpload(ctx, key, uploadID)
+	return err
+}
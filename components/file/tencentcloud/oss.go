@@ -32,6 +32,7 @@ import (
 	"github.com/tencentyun/cos-go-sdk-v5"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
 	"mosn.io/layotto/components/pkg/actuators"
 )
 
@@ -151,14 +152,38 @@ func (t *TencentCloudOSS) Put(ctx context.Context, st *file.PutFileStu) error {
 		return err
 	}
 
+	_, err = client.Object.Put(ctx, st.FileName, st.DataStream, putOptions(st.Metadata))
+	return err
+}
+
+// PutMultipart uploads the parts of the file in parallel by the multipart api
+func (t *TencentCloudOSS) PutMultipart(ctx context.Context, st *file.PutFileStu, config *file.MultipartConfig) error {
+	if err := t.checkFileName(st.FileName); err != nil {
+		return err
+	}
+
+	client, err := t.selectClient(st.Metadata)
+	if err != nil {
+		return err
+	}
+
+	opt := putOptions(st.Metadata)
+	p := &partUploader{client: client, options: &cos.InitiateMultipartUploadOptions{
+		ACLHeaderOptions:       opt.ACLHeaderOptions,
+		ObjectPutHeaderOptions: opt.ObjectPutHeaderOptions,
+	}}
+	return util.ParallelUpload(ctx, p, st.FileName, st.DataStream, config.PartSize, config.Concurrency)
+}
+
+func putOptions(metadata map[string]string) *cos.ObjectPutOptions {
 	opt := &cos.ObjectPutOptions{}
-	if v, ok := st.Metadata[contentTypeKey]; ok {
+	if v, ok := metadata[contentTypeKey]; ok {
 		opt.ObjectPutHeaderOptions = &cos.ObjectPutHeaderOptions{
 			ContentType: v,
 		}
 	}
 
-	if v, ok := st.Metadata[aclKey]; ok {
+	if v, ok := metadata[aclKey]; ok {
 		opt.ACLHeaderOptions = &cos.ACLHeaderOptions{
 			XCosACL: v,
 		}
@@ -167,9 +192,7 @@ func (t *TencentCloudOSS) Put(ctx context.Context, st *file.PutFileStu) error {
 			XCosACL: "public-read",
 		}
 	}
-
-	_, err = client.Object.Put(ctx, st.FileName, st.DataStream, opt)
-	return err
+	return opt
 }
 
 func (t *TencentCloudOSS) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
@@ -245,6 +268,30 @@ func (t *TencentCloudOSS) Del(ctx context.Context, st *file.DelRequest) error {
 	return err
 }
 
+// Copy copies the object in the bucket by the server side copy of cos
+func (t *TencentCloudOSS) Copy(ctx context.Context, st *file.CopyRequest) error {
+	if err := t.checkFileName(st.Source); err != nil {
+		return err
+	}
+	if err := t.checkFileName(st.Target); err != nil {
+		return err
+	}
+
+	client, err := t.selectClient(st.Metadata)
+	if err != nil {
+		return err
+	}
+
+	source := client.BaseURL.BucketURL.Host + "/" + st.Source
+	_, _, err = client.Object.Copy(ctx, st.Target, source, nil)
+	return err
+}
+
+// Rename copies the object and then deletes the source, it is not atomic
+func (t *TencentCloudOSS) Rename(ctx context.Context, st *file.RenameRequest) error {
+	return util.CopyAndDelete(ctx, t, st)
+}
+
 func (t *TencentCloudOSS) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	if err := t.checkFileName(st.FileName); err != nil {
 		return nil, err
@@ -286,6 +333,31 @@ func (t *TencentCloudOSS) Stat(ctx context.Context, st *file.FileMetaRequest) (*
 	return resp, nil
 }
 
+// SignURL presigns the url getting or putting the object
+func (t *TencentCloudOSS) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	if err := t.checkFileName(st.FileName); err != nil {
+		return "", err
+	}
+	if st.Method != http.MethodGet && st.Method != http.MethodPut {
+		return "", fmt.Errorf("tencentcloud sign file[%s] fail,err: unsupported method %s", st.FileName, st.Method)
+	}
+
+	client, err := t.selectClient(st.Metadata)
+	if err != nil {
+		return "", err
+	}
+	credential := client.GetCredential()
+	if credential == nil {
+		return "", fmt.Errorf("tencentcloud sign file[%s] fail,err: no credential", st.FileName)
+	}
+
+	u, err := client.Object.GetPresignedURL(ctx, st.Method, st.FileName, credential.SecretID, credential.SecretKey, st.Expires, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
 func (t *TencentCloudOSS) selectClient(meta map[string]string) (*cos.Client, error) {
 	var (
 		endpoint string
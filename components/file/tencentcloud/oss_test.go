@@ -21,6 +21,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -281,3 +282,39 @@ func TestCheckFileName(t *testing.T) {
 	assert.Nil(t, tcOSS.checkFileName("a"))
 	assert.Nil(t, tcOSS.checkFileName("a/aa.txt"))
 }
+
+func TestSignURL(t *testing.T) {
+	fc := file.FileConfig{}
+	oss := NewTencentCloudOSS()
+	fc.Metadata = []byte(data)
+	err := oss.Init(context.TODO(), &fc)
+	assert.Nil(t, err)
+
+	signer := oss.(file.Signer)
+	u, err := signer.SignURL(context.Background(), &file.SignURLStu{FileName: "a.txt", Method: "GET", Expires: time.Minute})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(u, "https://xxx-1251058690.cos.ap-chengdu.myqcloud.com/a.txt?"))
+	assert.Contains(t, u, "q-sign-algorithm=sha1")
+
+	_, err = signer.SignURL(context.Background(), &file.SignURLStu{FileName: "a.txt", Method: "DELETE", Expires: time.Minute})
+	assert.Error(t, err)
+
+	_, err = signer.SignURL(context.Background(), &file.SignURLStu{FileName: "/a.txt", Method: "GET", Expires: time.Minute})
+	assert.Error(t, err)
+}
+
+func TestCopy(t *testing.T) {
+	fc := file.FileConfig{}
+	oss := NewTencentCloudOSS()
+	fc.Metadata = []byte(data)
+	err := oss.Init(context.TODO(), &fc)
+	assert.Nil(t, err)
+	copier := oss.(file.Copier)
+
+	err = copier.Copy(context.Background(), &file.CopyRequest{Source: "/a.txt", Target: "b.txt"})
+	assert.Error(t, err)
+	err = copier.Copy(context.Background(), &file.CopyRequest{Source: "a.txt", Target: "/b.txt"})
+	assert.Error(t, err)
+	err = oss.(file.Renamer).Rename(context.Background(), &file.RenameRequest{Source: "/a.txt", Target: "b.txt"})
+	assert.Error(t, err)
+}
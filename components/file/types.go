@@ -19,6 +19,7 @@ package file
 import (
 	"encoding/json"
 	"io"
+	"time"
 
 	"mosn.io/layotto/components/ref"
 )
@@ -28,17 +29,38 @@ type FileConfig struct {
 	ref.Config
 	Metadata json.RawMessage `json:"metadata"`
 	Type     string          `json:"type"`
+	// Policy is enforced by the runtime before calling the component
+	Policy *Policy `json:"policy,omitempty"`
+	// Multipart configures the multipart uploads of the runtime, if the component is a MultipartUploader
+	Multipart *MultipartConfig `json:"multipart,omitempty"`
+}
+
+// MultipartConfig configures the parallel multipart uploads of the large files
+type MultipartConfig struct {
+	// PartSize is the size of the parts in bytes, the files larger than it are uploaded in parts
+	PartSize int64 `json:"part_size"`
+	// Concurrency is the number of the parts uploaded at the same time
+	Concurrency int `json:"concurrency"`
+	// Disabled turns off the multipart uploads of the store
+	Disabled bool `json:"disabled"`
 }
 
 type PutFileStu struct {
 	DataStream io.Reader
 	FileName   string
 	Metadata   map[string]string
+	// Offset is the position of the data in the file, it is used by the resumable uploads
+	Offset int64
+	// Checksum is the expected checksum of the whole file of a resumable upload, see ChecksumVerifier
+	Checksum Checksum
 }
 
 type GetFileStu struct {
 	FileName string
 	Metadata map[string]string
+	// Offset and Length select a byte range of the file, a zero Length reads to the end
+	Offset int64
+	Length int64
 }
 
 type DelRequest struct {
@@ -76,3 +98,51 @@ type FileMetaResp struct {
 	LastModified string
 	Metadata     map[string][]string
 }
+
+type SignURLStu struct {
+	FileName string
+	// Method is the http method allowed by the url, GET or PUT
+	Method string
+	// Expires is how long the url is valid
+	Expires  time.Duration
+	Metadata map[string]string
+}
+
+// EventType is the type of FileEvent
+type EventType int
+
+const (
+	// EventCreate means the file is created
+	EventCreate EventType = iota
+	// EventDelete means the file is deleted
+	EventDelete
+)
+
+type WatchRequest struct {
+	DirectoryName string
+	Metadata      map[string]string
+}
+
+// FileEvent is sent when a file is created or deleted in the watched directory
+type FileEvent struct {
+	Type EventType
+	// FileName is the name of the file as the FileName of FilesInfo listed in the directory
+	FileName string
+}
+
+type MkdirRequest struct {
+	DirectoryName string
+	Metadata      map[string]string
+}
+
+type RenameRequest struct {
+	Source   string
+	Target   string
+	Metadata map[string]string
+}
+
+type CopyRequest struct {
+	Source   string
+	Target   string
+	Metadata map[string]string
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"mosn.io/layotto/components/file"
+)
+
+// MinPartSize is the minimal size of a part except the last one in the multipart uploads of the object stores
+const MinPartSize int64 = 5 << 20
+
+// Part is an uploaded part of a multipart upload
+type Part struct {
+	Number int
+	Size   int64
+	ETag   string
+}
+
+// PartUploader uploads the parts of a multipart upload of an object store bound to a bucket,
+// it is used to implement file.MultipartUploader on the object stores
+type PartUploader interface {
+	CreateUpload(ctx context.Context, key string) (string, error)
+	// UploadPart may be called concurrently for the different parts of an upload
+	UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (Part, error)
+	CompleteUpload(ctx context.Context, key, uploadID string, parts []Part) error
+	AbortUpload(ctx context.Context, key, uploadID string) error
+}
+
+// Multipart is the multipart upload api of an object store bound to a bucket,
+// it is used to implement file.ResumableUploader on the object stores
+type Multipart interface {
+	PartUploader
+	// FindUpload returns the id of the latest unfinished upload of the key, or "" if there is none
+	FindUpload(ctx context.Context, key string) (string, error)
+	// ListParts returns the uploaded parts ordered by their numbers
+	ListParts(ctx context.Context, key, uploadID string) ([]Part, error)
+}
+
+// UploadedSize returns the size of the parts persisted by the unfinished upload of the key
+func UploadedSize(ctx context.Context, m Multipart, key string) (int64, error) {
+	uploadID, err := m.FindUpload(ctx, key)
+	if err != nil || uploadID == "" {
+		return 0, err
+	}
+	parts, err := m.ListParts(ctx, key, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	return partsSize(parts), nil
+}
+
+// ResumeUpload uploads the data of r as the parts following the ones of the unfinished upload of the key,
+// whose size must be offset. A zero offset aborts the unfinished upload and starts a new one.
+// Only the complete parts are kept if r fails, the upload is completed once r ends with io.EOF.
+func ResumeUpload(ctx context.Context, m Multipart, key string, r io.Reader, offset int64, partSize int64) error {
+	if partSize < MinPartSize {
+		partSize = MinPartSize
+	}
+	uploadID, err := m.FindUpload(ctx, key)
+	if err != nil {
+		return err
+	}
+	var parts []Part
+	if offset == 0 {
+		if uploadID != "" {
+			if err = m.AbortUpload(ctx, key, uploadID); err != nil {
+				return err
+			}
+		}
+		if uploadID, err = m.CreateUpload(ctx, key); err != nil {
+			return err
+		}
+	} else {
+		if uploadID == "" {
+			return file.ErrOffsetMismatch
+		}
+		if parts, err = m.ListParts(ctx, key, uploadID); err != nil {
+			return err
+		}
+		if partsSize(parts) != offset {
+			return file.ErrOffsetMismatch
+		}
+	}
+	buf := make([]byte, partSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			// the last part can be smaller than the minimal size, and an empty file still needs a part
+			if n > 0 || len(parts) == 0 {
+				part, err := m.UploadPart(ctx, key, uploadID, len(parts)+1, buf[:n])
+				if err != nil {
+					return err
+				}
+				parts = append(parts, part)
+			}
+			return m.CompleteUpload(ctx, key, uploadID, parts)
+		default:
+			// drop the incomplete part, the upload is resumed from the end of the previous one
+			return err
+		}
+		part, err := m.UploadPart(ctx, key, uploadID, len(parts)+1, buf)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+	}
+}
+
+// ParallelUpload uploads the data of r as the parts of a new upload of the key, and at most concurrency parts
+// are uploaded at the same time. The upload is aborted if r or any part fails, and completed once r ends with io.EOF
+func ParallelUpload(ctx context.Context, m PartUploader, key string, r io.Reader, partSize int64, concurrency int) error {
+	if partSize < MinPartSize {
+		partSize = MinPartSize
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	uploadID, err := m.CreateUpload(ctx, key)
+	if err != nil {
+		return err
+	}
+	partCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		parts    []Part
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+	// a buffer is taken by each part being uploaded, which limits the concurrency
+	buffers := make(chan []byte, concurrency)
+	for i := 0; i < concurrency; i++ {
+		buffers <- nil
+	}
+	for number := 1; ; number++ {
+		buf := <-buffers
+		if failed() {
+			break
+		}
+		if buf == nil {
+			buf = make([]byte, partSize)
+		}
+		n, err := io.ReadFull(r, buf)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			fail(err)
+			break
+		}
+		// the last part can be smaller than the minimal size, and an empty file still needs a part
+		if n == 0 && number > 1 {
+			break
+		}
+		wg.Add(1)
+		go func(number int, data []byte) {
+			defer wg.Done()
+			part, err := m.UploadPart(partCtx, key, uploadID, number, data)
+			buffers <- data[:cap(data)]
+			if err != nil {
+				fail(err)
+				return
+			}
+			mu.Lock()
+			parts = append(parts, part)
+			mu.Unlock()
+		}(number, buf[:n])
+		if last {
+			break
+		}
+	}
+	wg.Wait()
+	if firstErr != nil {
+		if err = m.AbortUpload(ctx, key, uploadID); err != nil {
+			return fmt.Errorf("%w, and abort the upload fail: %v", firstErr, err)
+		}
+		return firstErr
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Number < parts[j].Number
+	})
+	return m.CompleteUpload(ctx, key, uploadID, parts)
+}
+
+func partsSize(parts []Part) int64 {
+	var size int64
+	for _, p := range parts {
+		size += p.Size
+	}
+	return size
+}
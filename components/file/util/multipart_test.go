@@ -0,0 +1,207 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/file"
+)
+
+type fakeUpload struct {
+	key   string
+	parts map[int][]byte
+}
+
+// fakeMultipart keeps the uploads and the completed objects in memory
+type fakeMultipart struct {
+	sync.Mutex
+	uploads map[string]*fakeUpload
+	objects map[string][]byte
+	next    int
+	// failPart fails the upload of the part of the number
+	failPart int
+	// uploading and maxUploading count the parts being uploaded at the same time
+	uploading    int
+	maxUploading int
+}
+
+func newFakeMultipart() *fakeMultipart {
+	return &fakeMultipart{uploads: map[string]*fakeUpload{}, objects: map[string][]byte{}}
+}
+
+func (m *fakeMultipart) FindUpload(ctx context.Context, key string) (string, error) {
+	for id, u := range m.uploads {
+		if u.key == key {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+func (m *fakeMultipart) CreateUpload(ctx context.Context, key string) (string, error) {
+	m.next++
+	id := fmt.Sprintf("upload-%d", m.next)
+	m.uploads[id] = &fakeUpload{key: key, parts: map[int][]byte{}}
+	return id, nil
+}
+
+func (m *fakeMultipart) ListParts(ctx context.Context, key, uploadID string) ([]Part, error) {
+	u := m.uploads[uploadID]
+	var parts []Part
+	for i := 1; i <= len(u.parts); i++ {
+		parts = append(parts, Part{Number: i, Size: int64(len(u.parts[i])), ETag: fmt.Sprint(i)})
+	}
+	return parts, nil
+}
+
+func (m *fakeMultipart) UploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (Part, error) {
+	m.Lock()
+	m.uploading++
+	if m.uploading > m.maxUploading {
+		m.maxUploading = m.uploading
+	}
+	m.Unlock()
+	// let the other parts start
+	time.Sleep(time.Millisecond)
+
+	m.Lock()
+	defer m.Unlock()
+	m.uploading--
+	if number == m.failPart {
+		return Part{}, errBroken
+	}
+	m.uploads[uploadID].parts[number] = append([]byte(nil), data...)
+	return Part{Number: number, Size: int64(len(data)), ETag: fmt.Sprint(number)}, nil
+}
+
+func (m *fakeMultipart) CompleteUpload(ctx context.Context, key, uploadID string, parts []Part) error {
+	var data []byte
+	for _, p := range parts {
+		data = append(data, m.uploads[uploadID].parts[p.Number]...)
+	}
+	m.objects[key] = data
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+func (m *fakeMultipart) AbortUpload(ctx context.Context, key, uploadID string) error {
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+var errBroken = errors.New("broken stream")
+
+func TestResumeUpload(t *testing.T) {
+	ctx := context.TODO()
+	m := newFakeMultipart()
+	data := bytes.Repeat([]byte("0123456789"), int(MinPartSize)/4)
+
+	// the stream breaks in the middle of the third part, only the first two parts are kept
+	broken := io.MultiReader(bytes.NewReader(data[:2*MinPartSize+10]), &errReader{err: errBroken})
+	err := ResumeUpload(ctx, m, "key", broken, 0, 0)
+	assert.Equal(t, errBroken, err)
+	size, err := UploadedSize(ctx, m, "key")
+	assert.Nil(t, err)
+	assert.Equal(t, 2*MinPartSize, size)
+
+	err = ResumeUpload(ctx, m, "key", bytes.NewReader(data[MinPartSize:]), MinPartSize, 0)
+	assert.Equal(t, file.ErrOffsetMismatch, err)
+	err = ResumeUpload(ctx, m, "key", bytes.NewReader(data[size:]), size, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, data, m.objects["key"])
+	size, err = UploadedSize(ctx, m, "key")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), size)
+
+	// a resume without an unfinished upload
+	err = ResumeUpload(ctx, m, "key", bytes.NewReader(data), 10, 0)
+	assert.Equal(t, file.ErrOffsetMismatch, err)
+}
+
+func TestResumeUploadRestart(t *testing.T) {
+	ctx := context.TODO()
+	m := newFakeMultipart()
+	data := bytes.Repeat([]byte("a"), int(MinPartSize)+1)
+
+	broken := io.MultiReader(bytes.NewReader(data), &errReader{err: errBroken})
+	assert.Equal(t, errBroken, ResumeUpload(ctx, m, "key", broken, 0, 0))
+	assert.Len(t, m.uploads, 1)
+
+	// a zero offset restarts the upload
+	assert.Nil(t, ResumeUpload(ctx, m, "key", bytes.NewReader([]byte("hello")), 0, 0))
+	assert.Equal(t, []byte("hello"), m.objects["key"])
+	assert.Len(t, m.uploads, 0)
+
+	// an empty file is uploaded as an empty part
+	assert.Nil(t, ResumeUpload(ctx, m, "empty", bytes.NewReader(nil), 0, 0))
+	assert.Equal(t, 0, len(m.objects["empty"]))
+	_, ok := m.objects["empty"]
+	assert.True(t, ok)
+}
+
+func TestParallelUpload(t *testing.T) {
+	ctx := context.TODO()
+	m := newFakeMultipart()
+	data := bytes.Repeat([]byte("0123456789"), int(MinPartSize)/2+1)
+
+	assert.Nil(t, ParallelUpload(ctx, m, "key", bytes.NewReader(data), 0, 3))
+	assert.Equal(t, data, m.objects["key"])
+	assert.Len(t, m.uploads, 0)
+	assert.True(t, m.maxUploading > 1 && m.maxUploading <= 3)
+
+	// the upload is aborted if a part fails
+	m.failPart = 2
+	assert.Equal(t, errBroken, ParallelUpload(ctx, m, "failed", bytes.NewReader(data), 0, 3))
+	_, ok := m.objects["failed"]
+	assert.False(t, ok)
+	assert.Len(t, m.uploads, 0)
+
+	// or the stream fails
+	m.failPart = 0
+	broken := io.MultiReader(bytes.NewReader(data[:MinPartSize+10]), &errReader{err: errBroken})
+	assert.Equal(t, errBroken, ParallelUpload(ctx, m, "broken", broken, 0, 2))
+	assert.Len(t, m.uploads, 0)
+
+	// an empty file is uploaded as an empty part
+	assert.Nil(t, ParallelUpload(ctx, m, "empty", bytes.NewReader(nil), 0, 0))
+	_, ok = m.objects["empty"]
+	assert.True(t, ok)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestHttpRange(t *testing.T) {
+	assert.Equal(t, "bytes=10-", HttpRange(10, 0))
+	assert.Equal(t, "bytes=0-9", HttpRange(0, 10))
+	assert.Equal(t, "bytes=5-5", HttpRange(5, 1))
+}
@@ -17,8 +17,11 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"strings"
+
+	"mosn.io/layotto/components/file"
 )
 
 const (
@@ -52,3 +55,24 @@ func GetFileName(fileName string) (string, error) {
 	}
 	return name, nil
 }
+
+// HttpRange returns the value of the http Range header selecting length bytes from offset,
+// a zero length selects the rest of the file
+func HttpRange(offset, length int64) string {
+	if length <= 0 {
+		return fmt.Sprintf("bytes=%d-", offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+}
+
+// CopyAndDelete renames the file of an object store by copying and then deleting it, so the rename is not atomic.
+// The copy is kept if the delete fails
+func CopyAndDelete(ctx context.Context, store interface {
+	file.File
+	file.Copier
+}, st *file.RenameRequest) error {
+	if err := store.Copy(ctx, &file.CopyRequest{Source: st.Source, Target: st.Target, Metadata: st.Metadata}); err != nil {
+		return err
+	}
+	return store.Del(ctx, &file.DelRequest{FileName: st.Source, Metadata: st.Metadata})
+}
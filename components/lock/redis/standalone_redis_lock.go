@@ -16,6 +16,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -34,6 +35,8 @@ func init() {
 
 // Standalone Redis lock store.Any fail-over related features are not supported,such as Sentinel and Redis Cluster.
 type StandaloneRedisLock struct {
+	// mu protects client and metadata, which can be replaced by ApplyConfig
+	mu       sync.RWMutex
 	client   *redis.Client
 	metadata utils.RedisMetadata
 
@@ -87,6 +90,35 @@ func (p *StandaloneRedisLock) Init(metadata lock.Metadata) error {
 	return err
 }
 
+// ApplyConfig replaces the redis client with one built from the new metadata.
+// The new client must be able to connect to redis, otherwise the current one is kept.
+// Note that the locks held in the old redis server are not migrated if the host changes.
+func (p *StandaloneRedisLock) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	// 1. parse and validate config
+	m, err := utils.ParseRedisMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	// 2. connect to redis with the new client
+	client := utils.NewRedisClient(m)
+	if _, err = client.Ping(ctx).Result(); err != nil {
+		client.Close()
+		return fmt.Errorf("[standaloneRedisLock]: error connecting to redis at %s: %s", m.Host, err)
+	}
+	// 3. swap and close the old client
+	p.mu.Lock()
+	old := p.client
+	p.client = client
+	p.metadata = m
+	p.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
 // Features is to get StandaloneRedisLock's features
 func (p *StandaloneRedisLock) Features() []lock.Feature {
 	return p.features
@@ -100,6 +132,8 @@ func (p *StandaloneRedisLock) LockKeepAlive(ctx context.Context, request *lock.L
 
 // Node tries to acquire a redis lock
 func (p *StandaloneRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	// 1.Setting redis expiration time
 	nx := p.client.SetNX(p.ctx, req.ResourceId, req.LockOwner, time.Second*time.Duration(req.Expire))
 	if nx == nil {
@@ -120,6 +154,8 @@ const unlockScript = "local v = redis.call(\"get\",KEYS[1]); if v==false then re
 
 // Node tries to release a redis lock
 func (p *StandaloneRedisLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	// 1. delegate to client.eval lua script
 	eval := p.client.Eval(p.ctx, unlockScript, []string{req.ResourceId}, req.LockOwner)
 	// 2. check error
@@ -162,6 +198,8 @@ func (p *StandaloneRedisLock) Close() error {
 	if p.cancel != nil {
 		p.cancel()
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	if p.client != nil {
 		return p.client.Close()
 	}
@@ -149,3 +149,37 @@ func TestStandaloneRedisLock_TryLock(t *testing.T) {
 	}()
 	wg.Wait()
 }
+
+func TestStandaloneRedisLock_ApplyConfig(t *testing.T) {
+	s1, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s2.Close()
+	comp := NewStandaloneRedisLock()
+	defer comp.Close()
+	err = comp.Init(lock.Metadata{Properties: map[string]string{"redisHost": s1.Addr()}})
+	assert.NoError(t, err)
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		err := comp.ApplyConfig(context.TODO(), map[string]string{"redisHost": ""})
+		assert.Error(t, err)
+		err = comp.ApplyConfig(context.TODO(), map[string]string{"redisHost": "127.0.0.1:1"})
+		assert.Error(t, err)
+		// the old client still works
+		resp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: resourceId, LockOwner: "owner1", Expire: 10})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.True(t, s1.Exists(resourceId))
+	})
+
+	t.Run("switch to another redis", func(t *testing.T) {
+		err := comp.ApplyConfig(context.TODO(), map[string]string{"redisHost": s2.Addr()})
+		assert.NoError(t, err)
+		resp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: resourceId, LockOwner: "owner2", Expire: 10})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.True(t, s2.Exists(resourceId))
+	})
+}
@@ -49,6 +49,8 @@ func init() {
 }
 
 type AliyunOSS struct {
+	// mu protects client, which can be replaced by ApplyConfig
+	mu        sync.RWMutex
 	client    *oss.Client
 	basicConf json.RawMessage
 }
@@ -62,37 +64,58 @@ func NewAliyunOss() l8oss.Oss {
 }
 
 func (a *AliyunOSS) Init(ctx context.Context, config *l8oss.Config) error {
-	connectTimeout, readWriteTimeout := l8oss.DefaultConnectTimeout, l8oss.DefaultReadWriteTimeout
-	a.basicConf = config.Metadata[l8oss.BasicConfiguration]
-	m := utils.OssMetadata{}
-	if err := json.Unmarshal(a.basicConf, &m); err != nil {
+	client, err := a.createClient(config.Metadata)
+	if err != nil {
 		readinessIndicator.ReportError(err.Error())
 		livenessIndicator.ReportError(err.Error())
-		return l8oss.ErrInvalid
-	}
-	if t, ok := config.Metadata[connectTimeoutSec]; ok {
-		if v, err := strconv.Atoi(string(t)); err == nil {
-			connectTimeout = v
-		}
-	}
-	if t, ok := config.Metadata[readWriteTimeoutSec]; ok {
-		if v, err := strconv.Atoi(string(t)); err == nil {
-			readWriteTimeout = v
-		}
+		return err
 	}
+	a.basicConf = config.Metadata[l8oss.BasicConfiguration]
+	a.client = client
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
 
-	client, err := oss.New(m.Endpoint, m.AccessKeyID, m.AccessKeySecret, oss.Timeout(int64(connectTimeout), int64(readWriteTimeout)))
+// ApplyConfig replaces the client with one built from the new metadata, e.g. to rotate the access key.
+// The current client is kept if the new metadata is invalid.
+func (a *AliyunOSS) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	m := l8oss.DynamicMetadata(metadata)
+	client, err := a.createClient(m)
 	if err != nil {
-		readinessIndicator.ReportError(err.Error())
-		livenessIndicator.ReportError(err.Error())
 		return err
 	}
+	a.mu.Lock()
+	a.basicConf = m[l8oss.BasicConfiguration]
 	a.client = client
+	a.mu.Unlock()
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
 	return nil
 }
 
+func (a *AliyunOSS) createClient(metadata map[string]json.RawMessage) (*oss.Client, error) {
+	connectTimeout, readWriteTimeout := l8oss.DefaultConnectTimeout, l8oss.DefaultReadWriteTimeout
+	m := utils.OssMetadata{}
+	if err := json.Unmarshal(metadata[l8oss.BasicConfiguration], &m); err != nil {
+		return nil, l8oss.ErrInvalid
+	}
+	if m.Endpoint == "" || m.AccessKeyID == "" || m.AccessKeySecret == "" {
+		return nil, l8oss.ErrInvalid
+	}
+	if t, ok := metadata[connectTimeoutSec]; ok {
+		if v, err := strconv.Atoi(string(t)); err == nil {
+			connectTimeout = v
+		}
+	}
+	if t, ok := metadata[readWriteTimeoutSec]; ok {
+		if v, err := strconv.Atoi(string(t)); err == nil {
+			readWriteTimeout = v
+		}
+	}
+	return oss.New(m.Endpoint, m.AccessKeyID, m.AccessKeySecret, oss.Timeout(int64(connectTimeout), int64(readWriteTimeout)))
+}
+
 func (a *AliyunOSS) GetObject(ctx context.Context, req *l8oss.GetObjectInput) (*l8oss.GetObjectOutput, error) {
 	client, err := a.getClient()
 	if err != nil {
@@ -656,6 +679,8 @@ func (a *AliyunOSS) ListParts(ctx context.Context, req *l8oss.ListPartsInput) (*
 }
 
 func (a *AliyunOSS) getClient() (*oss.Client, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
 	if a.client == nil {
 		return nil, utils.ErrNotInitClient
 	}
@@ -136,3 +136,21 @@ func TestAliyunOss(t *testing.T) {
 	assert.Nil(t, err)
 
 }
+
+func TestAliyunOss_ApplyConfig(t *testing.T) {
+	a := &AliyunOSS{}
+	err := a.Init(context.TODO(), &l8oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(confWithoutUidAndBucket)}})
+	assert.Nil(t, err)
+	old, _ := a.getClient()
+
+	err = a.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"endpoint": "endpoint_address"}`})
+	assert.Equal(t, l8oss.ErrInvalid, err)
+	client, _ := a.getClient()
+	assert.Equal(t, old, client)
+
+	err = a.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"endpoint": "endpoint_address", "accessKeyID": "newKey", "accessKeySecret": "newSecret"}`})
+	assert.Nil(t, err)
+	client, _ = a.getClient()
+	assert.NotEqual(t, old, client)
+	assert.Equal(t, "newKey", client.Config.AccessKeyID)
+}
@@ -60,6 +60,8 @@ func init() {
 }
 
 type AwsOss struct {
+	// mu protects client, which can be replaced by ApplyConfig
+	mu        sync.RWMutex
 	client    *s3.Client
 	basicConf json.RawMessage
 	logger    logger.Logger
@@ -82,13 +84,43 @@ func (a *AwsOss) OnLogLevelChanged(level logger.LogLevel) {
 }
 
 func (a *AwsOss) Init(ctx context.Context, config *oss.Config) error {
-	a.basicConf = config.Metadata[oss.BasicConfiguration]
-	m := &utils.OssMetadata{}
-	err := json.Unmarshal(a.basicConf, &m)
+	client, err := a.createClient(ctx, config.Metadata)
 	if err != nil {
 		readinessIndicator.ReportError(err.Error())
 		livenessIndicator.ReportError(err.Error())
-		return oss.ErrInvalid
+		return err
+	}
+	a.basicConf = config.Metadata[oss.BasicConfiguration]
+	a.client = client
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+// ApplyConfig replaces the client with one built from the new metadata, e.g. to rotate the access key.
+// The current client is kept if the new metadata is invalid.
+func (a *AwsOss) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	m := oss.DynamicMetadata(metadata)
+	client, err := a.createClient(ctx, m)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.basicConf = m[oss.BasicConfiguration]
+	a.client = client
+	a.mu.Unlock()
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (a *AwsOss) createClient(ctx context.Context, metadata map[string]json.RawMessage) (*s3.Client, error) {
+	m := &utils.OssMetadata{}
+	if err := json.Unmarshal(metadata[oss.BasicConfiguration], &m); err != nil {
+		return nil, oss.ErrInvalid
+	}
+	if m.AccessKeyID == "" || m.AccessKeySecret == "" {
+		return nil, oss.ErrInvalid
 	}
 	optFunc := []func(options *aws_config.LoadOptions) error{
 		aws_config.WithRegion(m.Region),
@@ -99,17 +131,11 @@ func (a *AwsOss) Init(ctx context.Context, config *oss.Config) error {
 			},
 		}),
 	}
-	cfg, err := aws_config.LoadDefaultConfig(context.TODO(), optFunc...)
+	cfg, err := aws_config.LoadDefaultConfig(ctx, optFunc...)
 	if err != nil {
-		readinessIndicator.ReportError(err.Error())
-		livenessIndicator.ReportError(err.Error())
-		return err
+		return nil, err
 	}
-	client := s3.NewFromConfig(cfg)
-	a.client = client
-	readinessIndicator.SetStarted()
-	livenessIndicator.SetStarted()
-	return nil
+	return s3.NewFromConfig(cfg), nil
 }
 
 func (a *AwsOss) GetObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
@@ -527,6 +553,8 @@ func (a *AwsOss) ListParts(ctx context.Context, req *oss.ListPartsInput) (*oss.L
 }
 
 func (a *AwsOss) getClient() (*s3.Client, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
 	if a.client == nil {
 		return nil, utils.ErrNotInitClient
 	}
@@ -185,3 +185,20 @@ func TestDeepCopy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, tovalue.Owner.DisplayName, value)
 }
+
+func TestAwsOss_ApplyConfig(t *testing.T) {
+	a := &AwsOss{}
+	err := a.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(confWithoutUidAndBucket)}})
+	assert.Nil(t, err)
+	old, _ := a.getClient()
+
+	err = a.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: "hello"})
+	assert.Equal(t, oss.ErrInvalid, err)
+	client, _ := a.getClient()
+	assert.Equal(t, old, client)
+
+	err = a.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"region": "us-east-1", "accessKeyID": "newKey", "accessKeySecret": "newSecret"}`})
+	assert.Nil(t, err)
+	client, _ = a.getClient()
+	assert.NotEqual(t, old, client)
+}
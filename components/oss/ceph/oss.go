@@ -57,6 +57,8 @@ func init() {
 }
 
 type CephOSS struct {
+	// mu protects client, which can be replaced by ApplyConfig
+	mu        sync.RWMutex
 	client    *s3.Client
 	basicConf json.RawMessage
 	logger    logger.Logger
@@ -79,13 +81,43 @@ func (c *CephOSS) OnLogLevelChanged(level logger.LogLevel) {
 }
 
 func (c *CephOSS) Init(ctx context.Context, config *oss.Config) error {
-	c.basicConf = config.Metadata[oss.BasicConfiguration]
-	m := &utils.OssMetadata{}
-	err := json.Unmarshal(c.basicConf, &m)
+	client, err := c.createClient(ctx, config.Metadata)
 	if err != nil {
 		readinessIndicator.ReportError(err.Error())
 		livenessIndicator.ReportError(err.Error())
-		return oss.ErrInvalid
+		return err
+	}
+	c.basicConf = config.Metadata[oss.BasicConfiguration]
+	c.client = client
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+// ApplyConfig replaces the client with one built from the new metadata, e.g. to rotate the access key.
+// The current client is kept if the new metadata is invalid.
+func (c *CephOSS) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	m := oss.DynamicMetadata(metadata)
+	client, err := c.createClient(ctx, m)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.basicConf = m[oss.BasicConfiguration]
+	c.client = client
+	c.mu.Unlock()
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (c *CephOSS) createClient(ctx context.Context, metadata map[string]json.RawMessage) (*s3.Client, error) {
+	m := &utils.OssMetadata{}
+	if err := json.Unmarshal(metadata[oss.BasicConfiguration], &m); err != nil {
+		return nil, oss.ErrInvalid
+	}
+	if m.Endpoint == "" || m.AccessKeyID == "" || m.AccessKeySecret == "" {
+		return nil, oss.ErrInvalid
 	}
 
 	customResolver := aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...interface{}) (aws.Endpoint, error) {
@@ -103,19 +135,13 @@ func (c *CephOSS) Init(ctx context.Context, config *oss.Config) error {
 		}),
 		aws_config.WithEndpointResolverWithOptions(customResolver),
 	}
-	cfg, err := aws_config.LoadDefaultConfig(context.TODO(), optFunc...)
+	cfg, err := aws_config.LoadDefaultConfig(ctx, optFunc...)
 	if err != nil {
-		readinessIndicator.ReportError(err.Error())
-		livenessIndicator.ReportError(err.Error())
-		return err
+		return nil, err
 	}
-	client := s3.NewFromConfig(cfg, func(options *s3.Options) {
+	return s3.NewFromConfig(cfg, func(options *s3.Options) {
 		options.UsePathStyle = true
-	})
-	c.client = client
-	readinessIndicator.SetStarted()
-	livenessIndicator.SetStarted()
-	return nil
+	}), nil
 }
 
 func (c *CephOSS) GetObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
@@ -585,6 +611,8 @@ func (c *CephOSS) AppendObject(ctx context.Context, req *oss.AppendObjectInput)
 }
 
 func (c *CephOSS) getClient() (*s3.Client, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	if c.client == nil {
 		return nil, utils.ErrNotInitClient
 	}
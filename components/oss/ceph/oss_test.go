@@ -160,3 +160,20 @@ func TestCephOss(t *testing.T) {
 	_, err = oss.GetListObjectVersionsOutput(&s3.ListObjectVersionsOutput{})
 	assert.Nil(t, err)
 }
+
+func TestCephOss_ApplyConfig(t *testing.T) {
+	c := &CephOSS{}
+	err := c.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(confWithoutUidAndBucket)}})
+	assert.Nil(t, err)
+	old, _ := c.getClient()
+
+	err = c.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"accessKeyID": "newKey", "accessKeySecret": "newSecret"}`})
+	assert.Equal(t, oss.ErrInvalid, err)
+	client, _ := c.getClient()
+	assert.Equal(t, old, client)
+
+	err = c.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"endpoint": "endpoint_address", "accessKeyID": "newKey", "accessKeySecret": "newSecret"}`})
+	assert.Nil(t, err)
+	client, _ = c.getClient()
+	assert.NotEqual(t, old, client)
+}
@@ -0,0 +1,446 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package conformance is a suite checking that an oss.Oss implementation behaves like an object storage service.
+package conformance
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/oss"
+)
+
+// Config configures the conformance suite
+type Config struct {
+	// Bucket is an existing bucket used by the suite, the objects of the suite are put under the "conformance/" prefix
+	Bucket string
+	// Versioning tells whether the component keeps the noncurrent versions of the objects
+	Versioning bool
+	// NoMultipart skips the multipart uploads, for the components not supporting them
+	NoMultipart bool
+	// NoAppend skips the appendable objects, for the components not supporting them
+	NoAppend bool
+}
+
+// Run runs the conformance suite against an initialized component
+func Run(t *testing.T, o oss.Oss, config Config) {
+	s := &suite{o: o, bucket: config.Bucket, ctx: context.Background()}
+	t.Run("PutAndGet", s.testPutAndGet)
+	t.Run("RangeGet", s.testRangeGet)
+	t.Run("HeadAndExist", s.testHeadAndExist)
+	t.Run("Tagging", s.testTagging)
+	t.Run("CannedAcl", s.testCannedAcl)
+	t.Run("Copy", s.testCopy)
+	t.Run("List", s.testList)
+	t.Run("Delete", s.testDelete)
+	if !config.NoMultipart {
+		t.Run("Multipart", s.testMultipart)
+	}
+	if !config.NoAppend {
+		t.Run("Append", s.testAppend)
+	}
+	if config.Versioning {
+		t.Run("Versions", s.testVersions)
+	}
+}
+
+type suite struct {
+	o      oss.Oss
+	bucket string
+	ctx    context.Context
+}
+
+func (s *suite) put(t *testing.T, key, data string) *oss.PutObjectOutput {
+	out, err := s.o.PutObject(s.ctx, &oss.PutObjectInput{Bucket: s.bucket, Key: key, DataStream: strings.NewReader(data)})
+	require.Nil(t, err)
+	return out
+}
+
+func (s *suite) get(t *testing.T, key, versionId string) string {
+	out, err := s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key, VersionId: versionId})
+	require.Nil(t, err)
+	return readAll(t, out.DataStream)
+}
+
+func (s *suite) exist(t *testing.T, key string) bool {
+	out, err := s.o.IsObjectExist(s.ctx, &oss.IsObjectExistInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	return out.FileExist
+}
+
+func readAll(t *testing.T, r io.ReadCloser) string {
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	require.Nil(t, err)
+	return string(data)
+}
+
+func (s *suite) testPutAndGet(t *testing.T) {
+	key := "conformance/put/a.txt"
+	_, err := s.o.PutObject(s.ctx, &oss.PutObjectInput{
+		Bucket:       s.bucket,
+		Key:          key,
+		DataStream:   strings.NewReader("hello world"),
+		Meta:         map[string]string{"owner": "layotto"},
+		CacheControl: "no-cache",
+	})
+	require.Nil(t, err)
+
+	out, err := s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, "hello world", readAll(t, out.DataStream))
+	assert.Equal(t, int64(11), out.ContentLength)
+	assert.Equal(t, "no-cache", out.CacheControl)
+	assert.Equal(t, "layotto", out.Metadata["owner"])
+	assert.NotEmpty(t, out.ETag)
+	assert.NotZero(t, out.LastModified)
+
+	// overwrite
+	s.put(t, key, "hello layotto")
+	assert.Equal(t, "hello layotto", s.get(t, key, ""))
+
+	// empty object
+	_, err = s.o.PutObject(s.ctx, &oss.PutObjectInput{Bucket: s.bucket, Key: "conformance/put/empty"})
+	require.Nil(t, err)
+	assert.Equal(t, "", s.get(t, "conformance/put/empty", ""))
+
+	_, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: "conformance/put/missing"})
+	assert.Error(t, err)
+	_, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket + "-missing", Key: key})
+	assert.Error(t, err)
+}
+
+func (s *suite) testRangeGet(t *testing.T) {
+	key := "conformance/range/a.txt"
+	s.put(t, key, "0123456789")
+
+	out, err := s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key, Start: 2, End: 5})
+	require.Nil(t, err)
+	assert.Equal(t, "2345", readAll(t, out.DataStream))
+	assert.Equal(t, int64(4), out.ContentLength)
+
+	out, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key, Start: 7})
+	require.Nil(t, err)
+	assert.Equal(t, "789", readAll(t, out.DataStream))
+
+	_, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key, Start: 20, End: 30})
+	assert.Error(t, err)
+}
+
+func (s *suite) testHeadAndExist(t *testing.T) {
+	key := "conformance/head/a.txt"
+	assert.False(t, s.exist(t, key))
+	_, err := s.o.HeadObject(s.ctx, &oss.HeadObjectInput{Bucket: s.bucket, Key: key})
+	assert.Error(t, err)
+
+	_, err = s.o.PutObject(s.ctx, &oss.PutObjectInput{
+		Bucket:     s.bucket,
+		Key:        key,
+		DataStream: strings.NewReader("hello"),
+		Meta:       map[string]string{"owner": "layotto"},
+	})
+	require.Nil(t, err)
+	assert.True(t, s.exist(t, key))
+	out, err := s.o.HeadObject(s.ctx, &oss.HeadObjectInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, "layotto", out.ResultMetadata["owner"])
+	assert.Equal(t, "5", out.ResultMetadata["Content-Length"])
+}
+
+func (s *suite) testTagging(t *testing.T) {
+	key := "conformance/tagging/a.txt"
+	_, err := s.o.PutObject(s.ctx, &oss.PutObjectInput{
+		Bucket:     s.bucket,
+		Key:        key,
+		DataStream: strings.NewReader("hello"),
+		Tagging:    map[string]string{"env": "test"},
+	})
+	require.Nil(t, err)
+	out, err := s.o.GetObjectTagging(s.ctx, &oss.GetObjectTaggingInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "test"}, out.Tags)
+
+	_, err = s.o.PutObjectTagging(s.ctx, &oss.PutObjectTaggingInput{Bucket: s.bucket, Key: key, Tags: map[string]string{"env": "prod", "team": "a"}})
+	require.Nil(t, err)
+	out, err = s.o.GetObjectTagging(s.ctx, &oss.GetObjectTaggingInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "team": "a"}, out.Tags)
+
+	_, err = s.o.DeleteObjectTagging(s.ctx, &oss.DeleteObjectTaggingInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	out, err = s.o.GetObjectTagging(s.ctx, &oss.GetObjectTaggingInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Empty(t, out.Tags)
+
+	_, err = s.o.PutObjectTagging(s.ctx, &oss.PutObjectTaggingInput{Bucket: s.bucket, Key: "conformance/tagging/missing", Tags: map[string]string{"a": "b"}})
+	assert.Error(t, err)
+}
+
+func (s *suite) testCannedAcl(t *testing.T) {
+	key := "conformance/acl/a.txt"
+	s.put(t, key, "hello")
+	out, err := s.o.GetObjectCannedAcl(s.ctx, &oss.GetObjectCannedAclInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, "private", out.CannedAcl)
+
+	_, err = s.o.PutObjectCannedAcl(s.ctx, &oss.PutObjectCannedAclInput{Bucket: s.bucket, Key: key, Acl: "public-read"})
+	require.Nil(t, err)
+	out, err = s.o.GetObjectCannedAcl(s.ctx, &oss.GetObjectCannedAclInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, "public-read", out.CannedAcl)
+
+	_, err = s.o.PutObjectCannedAcl(s.ctx, &oss.PutObjectCannedAclInput{Bucket: s.bucket, Key: key, Acl: "everyone"})
+	assert.Error(t, err)
+}
+
+func (s *suite) testCopy(t *testing.T) {
+	src := "conformance/copy/src.txt"
+	_, err := s.o.PutObject(s.ctx, &oss.PutObjectInput{
+		Bucket:     s.bucket,
+		Key:        src,
+		DataStream: strings.NewReader("hello"),
+		Meta:       map[string]string{"owner": "layotto"},
+	})
+	require.Nil(t, err)
+
+	out, err := s.o.CopyObject(s.ctx, &oss.CopyObjectInput{
+		Bucket:     s.bucket,
+		Key:        "conformance/copy/dst.txt",
+		CopySource: &oss.CopySource{CopySourceBucket: s.bucket, CopySourceKey: src},
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, out.CopyObjectResult.ETag)
+	got, err := s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: "conformance/copy/dst.txt"})
+	require.Nil(t, err)
+	assert.Equal(t, "hello", readAll(t, got.DataStream))
+	assert.Equal(t, "layotto", got.Metadata["owner"])
+
+	_, err = s.o.CopyObject(s.ctx, &oss.CopyObjectInput{
+		Bucket:            s.bucket,
+		Key:               "conformance/copy/replaced.txt",
+		CopySource:        &oss.CopySource{CopySourceBucket: s.bucket, CopySourceKey: src},
+		MetadataDirective: "REPLACE",
+		Metadata:          map[string]string{"owner": "app"},
+	})
+	require.Nil(t, err)
+	got, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: "conformance/copy/replaced.txt"})
+	require.Nil(t, err)
+	readAll(t, got.DataStream)
+	assert.Equal(t, "app", got.Metadata["owner"])
+
+	_, err = s.o.CopyObject(s.ctx, &oss.CopyObjectInput{Bucket: s.bucket, Key: "conformance/copy/dst.txt"})
+	assert.Error(t, err)
+	_, err = s.o.CopyObject(s.ctx, &oss.CopyObjectInput{
+		Bucket:     s.bucket,
+		Key:        "conformance/copy/dst.txt",
+		CopySource: &oss.CopySource{CopySourceBucket: s.bucket, CopySourceKey: "conformance/copy/missing"},
+	})
+	assert.Error(t, err)
+}
+
+func (s *suite) testList(t *testing.T) {
+	for _, key := range []string{"a", "b/1", "b/2", "c/1", "d"} {
+		s.put(t, "conformance/list/"+key, key)
+	}
+
+	out, err := s.o.ListObjects(s.ctx, &oss.ListObjectsInput{Bucket: s.bucket, Prefix: "conformance/list/"})
+	require.Nil(t, err)
+	var keys []string
+	for _, obj := range out.Contents {
+		keys = append(keys, strings.TrimPrefix(obj.Key, "conformance/list/"))
+	}
+	assert.Equal(t, []string{"a", "b/1", "b/2", "c/1", "d"}, keys)
+	assert.False(t, out.IsTruncated)
+	assert.Equal(t, int64(3), out.Contents[1].Size)
+
+	out, err = s.o.ListObjects(s.ctx, &oss.ListObjectsInput{Bucket: s.bucket, Prefix: "conformance/list/", Delimiter: "/"})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"conformance/list/b/", "conformance/list/c/"}, out.CommonPrefixes)
+	assert.Len(t, out.Contents, 2)
+
+	// paging
+	var paged []string
+	marker := ""
+	for i := 0; i < 10; i++ {
+		out, err = s.o.ListObjects(s.ctx, &oss.ListObjectsInput{Bucket: s.bucket, Prefix: "conformance/list/", MaxKeys: 2, Marker: marker})
+		require.Nil(t, err)
+		for _, obj := range out.Contents {
+			paged = append(paged, strings.TrimPrefix(obj.Key, "conformance/list/"))
+		}
+		if !out.IsTruncated {
+			break
+		}
+		marker = out.NextMarker
+	}
+	assert.Equal(t, []string{"a", "b/1", "b/2", "c/1", "d"}, paged)
+}
+
+func (s *suite) testDelete(t *testing.T) {
+	key := "conformance/delete/a.txt"
+	s.put(t, key, "hello")
+	_, err := s.o.DeleteObject(s.ctx, &oss.DeleteObjectInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.False(t, s.exist(t, key))
+	_, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key})
+	assert.Error(t, err)
+
+	// deleting a missing object succeeds
+	_, err = s.o.DeleteObject(s.ctx, &oss.DeleteObjectInput{Bucket: s.bucket, Key: key})
+	assert.Nil(t, err)
+
+	s.put(t, "conformance/delete/b", "b")
+	s.put(t, "conformance/delete/c", "c")
+	out, err := s.o.DeleteObjects(s.ctx, &oss.DeleteObjectsInput{
+		Bucket: s.bucket,
+		Delete: &oss.Delete{Objects: []*oss.ObjectIdentifier{{Key: "conformance/delete/b"}, {Key: "conformance/delete/c"}}},
+	})
+	require.Nil(t, err)
+	assert.Len(t, out.Deleted, 2)
+	assert.False(t, s.exist(t, "conformance/delete/b"))
+	assert.False(t, s.exist(t, "conformance/delete/c"))
+}
+
+func (s *suite) testMultipart(t *testing.T) {
+	key := "conformance/multipart/a.txt"
+	created, err := s.o.CreateMultipartUpload(s.ctx, &oss.CreateMultipartUploadInput{
+		Bucket:   s.bucket,
+		Key:      key,
+		MetaData: map[string]string{"owner": "layotto"},
+	})
+	require.Nil(t, err)
+	require.NotEmpty(t, created.UploadId)
+
+	part1, err := s.o.UploadPart(s.ctx, &oss.UploadPartInput{
+		Bucket: s.bucket, Key: key, UploadId: created.UploadId, PartNumber: 1, DataStream: strings.NewReader("hello "),
+	})
+	require.Nil(t, err)
+	s.put(t, "conformance/multipart/src.txt", "big world")
+	part2, err := s.o.UploadPartCopy(s.ctx, &oss.UploadPartCopyInput{
+		Bucket: s.bucket, Key: key, UploadId: created.UploadId, PartNumber: 2,
+		CopySource:    &oss.CopySource{CopySourceBucket: s.bucket, CopySourceKey: "conformance/multipart/src.txt"},
+		StartPosition: 4,
+		PartSize:      5,
+	})
+	require.Nil(t, err)
+
+	uploads, err := s.o.ListMultipartUploads(s.ctx, &oss.ListMultipartUploadsInput{Bucket: s.bucket, Prefix: "conformance/multipart/"})
+	require.Nil(t, err)
+	require.Len(t, uploads.Uploads, 1)
+	assert.Equal(t, created.UploadId, uploads.Uploads[0].UploadId)
+
+	parts, err := s.o.ListParts(s.ctx, &oss.ListPartsInput{Bucket: s.bucket, Key: key, UploadId: created.UploadId})
+	require.Nil(t, err)
+	require.Len(t, parts.Parts, 2)
+	assert.Equal(t, int64(1), parts.Parts[0].PartNumber)
+	assert.Equal(t, int64(6), parts.Parts[0].Size)
+	assert.Equal(t, part1.ETag, parts.Parts[0].Etag)
+	assert.Equal(t, int64(5), parts.Parts[1].Size)
+
+	// the parts must be in ascending order
+	_, err = s.o.CompleteMultipartUpload(s.ctx, &oss.CompleteMultipartUploadInput{
+		Bucket: s.bucket, Key: key, UploadId: created.UploadId,
+		MultipartUpload: &oss.CompletedMultipartUpload{Parts: []*oss.CompletedPart{
+			{PartNumber: 2, ETag: part2.CopyPartResult.ETag}, {PartNumber: 1, ETag: part1.ETag},
+		}},
+	})
+	assert.Error(t, err)
+
+	completed, err := s.o.CompleteMultipartUpload(s.ctx, &oss.CompleteMultipartUploadInput{
+		Bucket: s.bucket, Key: key, UploadId: created.UploadId,
+		MultipartUpload: &oss.CompletedMultipartUpload{Parts: []*oss.CompletedPart{
+			{PartNumber: 1, ETag: part1.ETag}, {PartNumber: 2, ETag: part2.CopyPartResult.ETag},
+		}},
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, completed.ETag)
+	got, err := s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.Equal(t, "hello world", readAll(t, got.DataStream))
+	assert.Equal(t, "layotto", got.Metadata["owner"])
+
+	uploads, err = s.o.ListMultipartUploads(s.ctx, &oss.ListMultipartUploadsInput{Bucket: s.bucket, Prefix: "conformance/multipart/"})
+	require.Nil(t, err)
+	assert.Len(t, uploads.Uploads, 0)
+
+	// abort
+	created, err = s.o.CreateMultipartUpload(s.ctx, &oss.CreateMultipartUploadInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	_, err = s.o.AbortMultipartUpload(s.ctx, &oss.AbortMultipartUploadInput{Bucket: s.bucket, Key: key, UploadId: created.UploadId})
+	require.Nil(t, err)
+	_, err = s.o.UploadPart(s.ctx, &oss.UploadPartInput{
+		Bucket: s.bucket, Key: key, UploadId: created.UploadId, PartNumber: 1, DataStream: strings.NewReader("hello"),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "hello world", s.get(t, key, ""))
+}
+
+func (s *suite) testAppend(t *testing.T) {
+	key := "conformance/append/a.txt"
+	out, err := s.o.AppendObject(s.ctx, &oss.AppendObjectInput{Bucket: s.bucket, Key: key, DataStream: strings.NewReader("hello")})
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), out.AppendPosition)
+
+	out, err = s.o.AppendObject(s.ctx, &oss.AppendObjectInput{Bucket: s.bucket, Key: key, Position: 5, DataStream: strings.NewReader(" world")})
+	require.Nil(t, err)
+	assert.Equal(t, int64(11), out.AppendPosition)
+	assert.Equal(t, "hello world", s.get(t, key, ""))
+
+	_, err = s.o.AppendObject(s.ctx, &oss.AppendObjectInput{Bucket: s.bucket, Key: key, Position: 5, DataStream: strings.NewReader("!")})
+	assert.Error(t, err)
+}
+
+func (s *suite) testVersions(t *testing.T) {
+	key := "conformance/versions/a.txt"
+	v1 := s.put(t, key, "v1").Metadata["Version-Id"]
+	v2 := s.put(t, key, "v2").Metadata["Version-Id"]
+	require.NotEmpty(t, v1)
+	require.NotEqual(t, v1, v2)
+	assert.Equal(t, "v1", s.get(t, key, v1))
+	assert.Equal(t, "v2", s.get(t, key, ""))
+
+	deleted, err := s.o.DeleteObject(s.ctx, &oss.DeleteObjectInput{Bucket: s.bucket, Key: key})
+	require.Nil(t, err)
+	assert.True(t, deleted.DeleteMarker)
+	assert.False(t, s.exist(t, key))
+	assert.Equal(t, "v2", s.get(t, key, v2))
+
+	versions, err := s.o.ListObjectVersions(s.ctx, &oss.ListObjectVersionsInput{Bucket: s.bucket, Prefix: "conformance/versions/"})
+	require.Nil(t, err)
+	require.Len(t, versions.Versions, 2)
+	require.Len(t, versions.DeleteMarkers, 1)
+	assert.True(t, versions.DeleteMarkers[0].IsLatest)
+	assert.Equal(t, v2, versions.Versions[0].VersionId)
+	assert.Equal(t, v1, versions.Versions[1].VersionId)
+
+	// removing the delete marker restores the previous version
+	_, err = s.o.DeleteObject(s.ctx, &oss.DeleteObjectInput{Bucket: s.bucket, Key: key, VersionId: deleted.VersionId})
+	require.Nil(t, err)
+	assert.Equal(t, "v2", s.get(t, key, ""))
+
+	// removing the current version restores the previous version
+	_, err = s.o.DeleteObject(s.ctx, &oss.DeleteObjectInput{Bucket: s.bucket, Key: key, VersionId: v2})
+	require.Nil(t, err)
+	assert.Equal(t, "v1", s.get(t, key, ""))
+	_, err = s.o.GetObject(s.ctx, &oss.GetObjectInput{Bucket: s.bucket, Key: key, VersionId: v2})
+	assert.Error(t, err)
+}
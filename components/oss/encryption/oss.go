@@ -0,0 +1,392 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"mosn.io/layotto/components/cryption"
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/pkg/actuators"
+	"mosn.io/layotto/components/pkg/envelope"
+)
+
+const (
+	componentName = "oss-encryption"
+	// storeConfiguration is the key of the config of the wrapped component in the metadata
+	storeConfiguration = "store"
+)
+
+var (
+	ErrMissingStore = errors.New("store is required for encryption oss")
+	ErrInvalidRange = errors.New("the requested range is not satisfiable")
+
+	once               sync.Once
+	readinessIndicator *actuators.HealthIndicator
+	livenessIndicator  *actuators.HealthIndicator
+)
+
+func init() {
+	readinessIndicator = actuators.NewHealthIndicator()
+	livenessIndicator = actuators.NewHealthIndicator()
+}
+
+// Metadata is the basic configuration of the encryption oss
+type Metadata struct {
+	// KeyId is the key of the cryption service wrapping the data keys, the default key of the service is used if empty
+	KeyId string `json:"keyId"`
+	// ChunkSize is the size of the plaintext chunks encrypted independently, 64KB by default
+	ChunkSize int `json:"chunkSize"`
+}
+
+// EncryptionOss wraps an oss component to encrypt the objects before they leave the sidecar.
+// Each object is encrypted by its own data key, which is wrapped by the cryption service
+// referred to by the "cryption_service" of the component_ref, and kept in the metadata of the object.
+// The objects without the envelope in the metadata, e.g. the ones put before the encryption is enabled, are read as they are.
+// The methods writing the data without the whole stream, like the multipart uploads, the appends and the signed urls, are not supported.
+type EncryptionOss struct {
+	oss.Oss
+	cryption  cryption.CryptionService
+	keys      *envelope.KeyManager
+	chunkSize int
+}
+
+func NewEncryptionOss() oss.Oss {
+	once.Do(func() {
+		indicators := &actuators.ComponentsIndicator{ReadinessIndicator: readinessIndicator, LivenessIndicator: livenessIndicator}
+		actuators.SetComponentsIndicator(componentName, indicators)
+	})
+	return &EncryptionOss{}
+}
+
+func (e *EncryptionOss) SetCryptionService(cs cryption.CryptionService) error {
+	e.cryption = cs
+	return nil
+}
+
+func (e *EncryptionOss) WrappedConfig(config *oss.Config) (*oss.Config, error) {
+	data, ok := config.Metadata[storeConfiguration]
+	if !ok {
+		return nil, ErrMissingStore
+	}
+	store := &oss.Config{}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (e *EncryptionOss) Wrap(store oss.Oss) {
+	e.Oss = store
+}
+
+// Close closes the wrapped component if it can be closed
+func (e *EncryptionOss) Close() error {
+	if closer, ok := e.Oss.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (e *EncryptionOss) Init(ctx context.Context, config *oss.Config) error {
+	if err := e.init(config); err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (e *EncryptionOss) init(config *oss.Config) error {
+	m := &Metadata{}
+	if data, ok := config.Metadata[oss.BasicConfiguration]; ok {
+		if err := json.Unmarshal(data, m); err != nil {
+			return oss.ErrInvalid
+		}
+	}
+	if m.ChunkSize < 0 || m.ChunkSize > envelope.MaxChunkSize {
+		return oss.ErrInvalid
+	}
+	if e.Oss == nil {
+		return ErrMissingStore
+	}
+	if e.cryption == nil {
+		return envelope.ErrMissingCryption
+	}
+	e.keys = envelope.NewKeyManager(e.cryption, m.KeyId)
+	e.chunkSize = m.ChunkSize
+	if e.chunkSize == 0 {
+		e.chunkSize = envelope.DefaultChunkSize
+	}
+	return nil
+}
+
+// PutObject encrypts the data by a new data key, and puts the envelope of the key into the metadata
+func (e *EncryptionOss) PutObject(ctx context.Context, req *oss.PutObjectInput) (*oss.PutObjectOutput, error) {
+	key, env, err := e.keys.NewEnvelope(ctx, e.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	data := req.DataStream
+	if data == nil {
+		data = bytes.NewReader(nil)
+	}
+	r, err := envelope.NewEncryptReader(data, key, env)
+	if err != nil {
+		return nil, err
+	}
+	input := *req
+	input.DataStream = r
+	input.Meta = withEnvelope(req.Meta, env)
+	if req.ContentLength > 0 {
+		input.ContentLength = envelope.CipherSize(req.ContentLength, env.ChunkSize)
+	}
+	return e.Oss.PutObject(ctx, &input)
+}
+
+// GetObject decrypts the object, a range is read by decrypting the chunks holding it.
+// The envelope of a range is read by HeadObject first, since the chunks depend on it
+func (e *EncryptionOss) GetObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
+	if req.Start == 0 && req.End == 0 {
+		return e.getObject(ctx, req)
+	}
+	start, end := req.Start, int64(-1)
+	if req.End > 0 {
+		end = req.End
+	}
+	if start < 0 || (end >= 0 && start > end) {
+		return nil, ErrInvalidRange
+	}
+	head, err := e.Oss.HeadObject(ctx, &oss.HeadObjectInput{Bucket: req.Bucket, Key: req.Key, VersionId: req.VersionId})
+	if err != nil {
+		return nil, err
+	}
+	env, ok, err := envelope.FromMetadata(head.ResultMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return e.Oss.GetObject(ctx, req)
+	}
+	size := int64(-1)
+	if length, err := strconv.ParseInt(head.ResultMetadata["Content-Length"], 10, 64); err == nil {
+		size = envelope.PlainSize(length, env.ChunkSize)
+		if start >= size {
+			return nil, ErrInvalidRange
+		}
+	}
+	key, err := e.keys.Unwrap(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	rg := envelope.NewRange(start, end, env.ChunkSize)
+	input := *req
+	input.Start = rg.CipherStart
+	input.End = 0
+	if rg.CipherEnd >= 0 {
+		input.End = rg.CipherEnd
+	}
+	out, err := e.Oss.GetObject(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		if total, ok := contentRangeTotal(out.ContentRange); ok {
+			size = envelope.PlainSize(total, env.ChunkSize)
+		}
+	}
+	if size >= 0 && start >= size {
+		out.DataStream.Close()
+		return nil, ErrInvalidRange
+	}
+	r, err := envelope.NewDecryptReader(out.DataStream, key, env, rg.FirstChunk, rg.Chunks)
+	if err != nil {
+		out.DataStream.Close()
+		return nil, err
+	}
+	if _, err = io.CopyN(ioutil.Discard, r, rg.Skip); err != nil {
+		out.DataStream.Close()
+		return nil, err
+	}
+	if end >= 0 {
+		r = io.LimitReader(r, end-start+1)
+	}
+	out.DataStream = &readCloser{Reader: r, Closer: out.DataStream}
+	out.Metadata = withoutEnvelope(out.Metadata)
+	out.ContentLength = 0
+	out.ContentRange = ""
+	if size >= 0 {
+		last := end
+		if last < 0 || last >= size {
+			last = size - 1
+		}
+		out.ContentLength = last - start + 1
+		out.ContentRange = fmt.Sprintf("bytes %d-%d/%d", start, last, size)
+	}
+	return out, nil
+}
+
+// getObject decrypts the whole object
+func (e *EncryptionOss) getObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
+	out, err := e.Oss.GetObject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	env, ok, err := envelope.FromMetadata(out.Metadata)
+	if err != nil {
+		out.DataStream.Close()
+		return nil, err
+	}
+	if !ok {
+		return out, nil
+	}
+	key, err := e.keys.Unwrap(ctx, env)
+	if err != nil {
+		out.DataStream.Close()
+		return nil, err
+	}
+	r, err := envelope.NewDecryptReader(out.DataStream, key, env, 0, -1)
+	if err != nil {
+		out.DataStream.Close()
+		return nil, err
+	}
+	out.DataStream = &readCloser{Reader: r, Closer: out.DataStream}
+	out.Metadata = withoutEnvelope(out.Metadata)
+	out.ContentLength = envelope.PlainSize(out.ContentLength, env.ChunkSize)
+	return out, nil
+}
+
+// contentRangeTotal returns the size of the object from a content range like "bytes 0-9/100"
+func contentRangeTotal(contentRange string) (int64, bool) {
+	i := strings.LastIndex(contentRange, "/")
+	if i < 0 {
+		return 0, false
+	}
+	total, err := strconv.ParseInt(contentRange[i+1:], 10, 64)
+	return total, err == nil
+}
+
+func (e *EncryptionOss) HeadObject(ctx context.Context, req *oss.HeadObjectInput) (*oss.HeadObjectOutput, error) {
+	out, err := e.Oss.HeadObject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	env, ok, err := envelope.FromMetadata(out.ResultMetadata)
+	if err != nil || !ok {
+		return out, err
+	}
+	out.ResultMetadata = withoutEnvelope(out.ResultMetadata)
+	if length, ok := out.ResultMetadata["Content-Length"]; ok {
+		if size, err := strconv.ParseInt(length, 10, 64); err == nil {
+			out.ResultMetadata["Content-Length"] = strconv.FormatInt(envelope.PlainSize(size, env.ChunkSize), 10)
+		}
+	}
+	return out, nil
+}
+
+// ListObjects returns the sizes of the plaintext computed with the configured chunk size
+func (e *EncryptionOss) ListObjects(ctx context.Context, req *oss.ListObjectsInput) (*oss.ListObjectsOutput, error) {
+	out, err := e.Oss.ListObjects(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range out.Contents {
+		obj.Size = envelope.PlainSize(obj.Size, e.chunkSize)
+	}
+	return out, nil
+}
+
+// CopyObject keeps the envelope of the source object when the metadata is replaced
+func (e *EncryptionOss) CopyObject(ctx context.Context, req *oss.CopyObjectInput) (*oss.CopyObjectOutput, error) {
+	if req.CopySource == nil || !strings.EqualFold(req.MetadataDirective, "REPLACE") {
+		return e.Oss.CopyObject(ctx, req)
+	}
+	src, err := e.Oss.HeadObject(ctx, &oss.HeadObjectInput{
+		Bucket:    req.CopySource.CopySourceBucket,
+		Key:       req.CopySource.CopySourceKey,
+		VersionId: req.CopySource.CopySourceVersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	env, ok, err := envelope.FromMetadata(src.ResultMetadata)
+	if err != nil {
+		return nil, err
+	}
+	input := *req
+	input.Metadata = withoutEnvelope(req.Metadata)
+	if ok {
+		input.Metadata = withEnvelope(input.Metadata, env)
+	}
+	return e.Oss.CopyObject(ctx, &input)
+}
+
+func (e *EncryptionOss) CreateMultipartUpload(ctx context.Context, req *oss.CreateMultipartUploadInput) (*oss.CreateMultipartUploadOutput, error) {
+	return nil, errors.New("CreateMultipartUpload method not supported on encryption oss")
+}
+
+func (e *EncryptionOss) UploadPart(ctx context.Context, req *oss.UploadPartInput) (*oss.UploadPartOutput, error) {
+	return nil, errors.New("UploadPart method not supported on encryption oss")
+}
+
+func (e *EncryptionOss) UploadPartCopy(ctx context.Context, req *oss.UploadPartCopyInput) (*oss.UploadPartCopyOutput, error) {
+	return nil, errors.New("UploadPartCopy method not supported on encryption oss")
+}
+
+func (e *EncryptionOss) AppendObject(ctx context.Context, req *oss.AppendObjectInput) (*oss.AppendObjectOutput, error) {
+	return nil, errors.New("AppendObject method not supported on encryption oss")
+}
+
+func (e *EncryptionOss) SignURL(ctx context.Context, req *oss.SignURLInput) (*oss.SignURLOutput, error) {
+	return nil, errors.New("SignURL method not supported on encryption oss")
+}
+
+// withEnvelope returns a copy of the metadata with the envelope
+func withEnvelope(meta map[string]string, env *envelope.Envelope) map[string]string {
+	res := withoutEnvelope(meta)
+	for k, v := range env.Metadata() {
+		res[k] = v
+	}
+	return res
+}
+
+// withoutEnvelope returns a copy of the metadata without the envelope
+func withoutEnvelope(meta map[string]string) map[string]string {
+	res := make(map[string]string, len(meta))
+	for k, v := range meta {
+		if !envelope.IsMetadataKey(k) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
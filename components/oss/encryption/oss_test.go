@@ -0,0 +1,113 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file/filetest"
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/oss/conformance"
+	"mosn.io/layotto/components/oss/local"
+	"mosn.io/layotto/components/pkg/envelope"
+)
+
+func newEncryptionOss(t *testing.T, chunkSize int, versioning bool) (oss.Oss, oss.Oss) {
+	store := local.NewInMemoryOss()
+	storeConf, _ := json.Marshal(local.Metadata{Buckets: []string{"test"}, Versioning: versioning})
+	require.Nil(t, store.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: storeConf}}))
+
+	e := NewEncryptionOss().(*EncryptionOss)
+	basic, _ := json.Marshal(Metadata{KeyId: "key1", ChunkSize: chunkSize})
+	config := &oss.Config{Metadata: map[string]json.RawMessage{
+		oss.BasicConfiguration: basic,
+		"store":                json.RawMessage(`{"type":"in-memory.oss","metadata":{"basic_config":{"buckets":["test"]}}}`),
+	}}
+	wrapped, err := e.WrappedConfig(config)
+	require.Nil(t, err)
+	assert.Equal(t, "in-memory.oss", wrapped.Type)
+	e.Wrap(store)
+	assert.Equal(t, envelope.ErrMissingCryption, e.Init(context.TODO(), config))
+	require.Nil(t, e.SetCryptionService(&filetest.Cryption{}))
+	require.Nil(t, e.Init(context.TODO(), config))
+	return e, store
+}
+
+func TestEncryptionOssConformance(t *testing.T) {
+	for _, chunkSize := range []int{0, 4} {
+		for _, versioning := range []bool{false, true} {
+			o, _ := newEncryptionOss(t, chunkSize, versioning)
+			conformance.Run(t, o, conformance.Config{Bucket: "test", Versioning: versioning, NoMultipart: true, NoAppend: true})
+		}
+	}
+}
+
+func TestEncryptionOss(t *testing.T) {
+	ctx := context.TODO()
+	o, store := newEncryptionOss(t, 4, false)
+	content := "0123456789abcdefghij"
+	_, err := o.PutObject(ctx, &oss.PutObjectInput{Bucket: "test", Key: "a.txt", DataStream: strings.NewReader(content), ContentLength: 20})
+	require.Nil(t, err)
+
+	// the stored object is encrypted and has the envelope in the metadata
+	stored, err := store.GetObject(ctx, &oss.GetObjectInput{Bucket: "test", Key: "a.txt"})
+	require.Nil(t, err)
+	data, _ := ioutil.ReadAll(stored.DataStream)
+	stored.DataStream.Close()
+	assert.Equal(t, envelope.CipherSize(20, 4), int64(len(data)))
+	assert.NotContains(t, string(data), "0123")
+	assert.NotEmpty(t, stored.Metadata[envelope.MetaWrappedKey])
+
+	out, err := o.GetObject(ctx, &oss.GetObjectInput{Bucket: "test", Key: "a.txt", Start: 5, End: 13})
+	require.Nil(t, err)
+	data, _ = ioutil.ReadAll(out.DataStream)
+	assert.Equal(t, "56789abcd", string(data))
+	assert.Equal(t, int64(9), out.ContentLength)
+	assert.Equal(t, "bytes 5-13/20", out.ContentRange)
+	assert.Empty(t, out.Metadata[envelope.MetaWrappedKey])
+
+	// the objects are read with the chunk size they are encrypted with
+	other, _ := newEncryptionOss(t, 7, false)
+	other.(*EncryptionOss).Oss = store
+	out, err = other.GetObject(ctx, &oss.GetObjectInput{Bucket: "test", Key: "a.txt", Start: 18})
+	require.Nil(t, err)
+	data, _ = ioutil.ReadAll(out.DataStream)
+	assert.Equal(t, "ij", string(data))
+
+	_, err = o.GetObject(ctx, &oss.GetObjectInput{Bucket: "test", Key: "a.txt", Start: 20})
+	assert.Equal(t, ErrInvalidRange, err)
+
+	// the plain objects are read as they are
+	_, err = store.PutObject(ctx, &oss.PutObjectInput{Bucket: "test", Key: "plain.txt", DataStream: strings.NewReader("plain data")})
+	require.Nil(t, err)
+	out, err = o.GetObject(ctx, &oss.GetObjectInput{Bucket: "test", Key: "plain.txt", Start: 6})
+	require.Nil(t, err)
+	data, _ = ioutil.ReadAll(out.DataStream)
+	assert.Equal(t, "data", string(data))
+
+	_, err = o.AppendObject(ctx, &oss.AppendObjectInput{Bucket: "test", Key: "b.txt", DataStream: strings.NewReader("b")})
+	assert.Error(t, err)
+	_, err = o.CreateMultipartUpload(ctx, &oss.CreateMultipartUploadInput{Bucket: "test", Key: "b.txt"})
+	assert.Error(t, err)
+}
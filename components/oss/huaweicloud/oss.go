@@ -47,6 +47,8 @@ func init() {
 }
 
 type HuaweicloudOSS struct {
+	// mu protects client, which can be replaced by ApplyConfig
+	mu       sync.RWMutex
 	client   *obs.ObsClient
 	metadata utils.OssMetadata
 }
@@ -60,32 +62,57 @@ func NewHuaweicloudOSS() oss.Oss {
 }
 
 func (h *HuaweicloudOSS) Init(ctx context.Context, config *oss.Config) error {
-	connectTimeout := oss.DefaultConnectTimeout
-	jsonRawMessage := config.Metadata[oss.BasicConfiguration]
-	err := json.Unmarshal(jsonRawMessage, &h.metadata)
+	client, m, err := h.createClient(config.Metadata)
 	if err != nil {
 		readinessIndicator.ReportError(err.Error())
 		livenessIndicator.ReportError(err.Error())
-		return oss.ErrInvalid
-	}
-	if t, ok := config.Metadata[connectTimeoutSec]; ok {
-		if v, err := strconv.Atoi(string(t)); err != nil {
-			connectTimeout = v
-		}
+		return err
 	}
+	h.metadata = m
+	h.client = client
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
 
-	client, err := obs.New(h.metadata.AccessKeyID, h.metadata.AccessKeySecret, h.metadata.Endpoint, obs.WithConnectTimeout(connectTimeout))
+// ApplyConfig replaces the client with one built from the new metadata, e.g. to rotate the access key.
+// The current client is kept if the new metadata is invalid.
+func (h *HuaweicloudOSS) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	client, m, err := h.createClient(oss.DynamicMetadata(metadata))
 	if err != nil {
-		readinessIndicator.ReportError(err.Error())
-		livenessIndicator.ReportError(err.Error())
 		return err
 	}
+	h.mu.Lock()
+	old := h.client
+	h.metadata = m
 	h.client = client
+	h.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
 	return nil
 }
 
+func (h *HuaweicloudOSS) createClient(metadata map[string]json.RawMessage) (*obs.ObsClient, utils.OssMetadata, error) {
+	connectTimeout := oss.DefaultConnectTimeout
+	m := utils.OssMetadata{}
+	if err := json.Unmarshal(metadata[oss.BasicConfiguration], &m); err != nil {
+		return nil, m, oss.ErrInvalid
+	}
+	if m.Endpoint == "" || m.AccessKeyID == "" || m.AccessKeySecret == "" {
+		return nil, m, oss.ErrInvalid
+	}
+	if t, ok := metadata[connectTimeoutSec]; ok {
+		if v, err := strconv.Atoi(string(t)); err == nil {
+			connectTimeout = v
+		}
+	}
+	client, err := obs.New(m.AccessKeyID, m.AccessKeySecret, m.Endpoint, obs.WithConnectTimeout(connectTimeout))
+	return client, m, err
+}
+
 func (h *HuaweicloudOSS) GetObject(ctx context.Context, input *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
 	client, err := h.getClient()
 	if err != nil {
@@ -720,6 +747,8 @@ func (h *HuaweicloudOSS) ListParts(ctx context.Context, input *oss.ListPartsInpu
 }
 
 func (h *HuaweicloudOSS) getClient() (*obs.ObsClient, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
 	if h.client == nil {
 		return nil, utils.ErrNotInitClient
 	}
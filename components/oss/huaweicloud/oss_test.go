@@ -535,3 +535,21 @@ func putObject(t *testing.T, key string) {
 	_, err := h.PutObject(context.TODO(), input)
 	Nil(t, err)
 }
+
+func TestApplyConfigHuaweicloudOBS(t *testing.T) {
+	h := &HuaweicloudOSS{}
+	err := h.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(config)}})
+	assert.Nil(t, err)
+	old, _ := h.getClient()
+
+	err = h.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: "hello"})
+	assert.Equal(t, oss.ErrInvalid, err)
+	cli, _ := h.getClient()
+	assert.Equal(t, old, cli)
+
+	err = h.ApplyConfig(context.TODO(), map[string]string{oss.BasicConfiguration: `{"endpoint": "your endpoint", "accessKeyID": "new accessKeyID", "accessKeySecret": "new accessKeySecret"}`})
+	assert.Nil(t, err)
+	cli, _ = h.getClient()
+	assert.NotEqual(t, old, cli)
+	assert.Equal(t, "new accessKeyID", h.metadata.AccessKeyID)
+}
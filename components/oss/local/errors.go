@@ -0,0 +1,36 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import "errors"
+
+var (
+	ErrInvalidBucketName        = errors.New("invalid bucket name")
+	ErrInvalidKey               = errors.New("invalid object key")
+	ErrNoSuchBucket             = errors.New("the specified bucket does not exist")
+	ErrNoSuchKey                = errors.New("the specified key does not exist")
+	ErrNoSuchVersion            = errors.New("the specified version does not exist")
+	ErrNoSuchUpload             = errors.New("the specified multipart upload does not exist")
+	ErrInvalidPart              = errors.New("one or more of the specified parts could not be found or the etag does not match")
+	ErrInvalidPartOrder         = errors.New("the list of parts was not in ascending order")
+	ErrInvalidRange             = errors.New("the requested range is not satisfiable")
+	ErrInvalidAcl               = errors.New("invalid canned acl")
+	ErrPositionNotEqualToLength = errors.New("the append position is not equal to the length of the object")
+	ErrPreconditionFailed       = errors.New("at least one of the preconditions does not hold")
+	ErrNotModified              = errors.New("the object is not modified")
+	ErrMissingCopySource        = errors.New("must specific copy_source")
+)
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// sysDir is the directory under the root keeping the sidecar files, it can't be used as a bucket
+	sysDir      = ".oss"
+	metaSuffix  = ".json"
+	uploadFile  = "upload.json"
+	partPrefix  = "part-"
+	defaultAcl  = "private"
+	storageStd  = "STANDARD"
+	dirPerm     = 0755
+	filePerm    = 0644
+	maxListKeys = 1000
+)
+
+var cannedAcls = map[string]bool{
+	"private":                   true,
+	"public-read":               true,
+	"public-read-write":         true,
+	"authenticated-read":        true,
+	"bucket-owner-read":         true,
+	"bucket-owner-full-control": true,
+}
+
+// objectVersion is a version of an object or a delete marker
+type objectVersion struct {
+	VersionId          string            `json:"version_id,omitempty"`
+	DeleteMarker       bool              `json:"delete_marker,omitempty"`
+	ETag               string            `json:"etag,omitempty"`
+	Size               int64             `json:"size,omitempty"`
+	LastModified       time.Time         `json:"last_modified"`
+	ACL                string            `json:"acl,omitempty"`
+	CacheControl       string            `json:"cache_control,omitempty"`
+	ContentDisposition string            `json:"content_disposition,omitempty"`
+	ContentEncoding    string            `json:"content_encoding,omitempty"`
+	Expires            int64             `json:"expires,omitempty"`
+	StorageClass       string            `json:"storage_class,omitempty"`
+	Meta               map[string]string `json:"meta,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+// objectMeta is the sidecar metadata of an object, the last version is the current one.
+// Without versioning there is at most one version.
+type objectMeta struct {
+	Versions []*objectVersion `json:"versions"`
+}
+
+// current returns the current version, it's nil if the object has no version
+func (m *objectMeta) current() *objectVersion {
+	if m == nil || len(m.Versions) == 0 {
+		return nil
+	}
+	return m.Versions[len(m.Versions)-1]
+}
+
+// find returns the index of the version, the current version is returned if versionId is empty
+func (m *objectMeta) find(versionId string) int {
+	if m == nil || len(m.Versions) == 0 {
+		return -1
+	}
+	if versionId == "" {
+		return len(m.Versions) - 1
+	}
+	for i, v := range m.Versions {
+		if v.VersionId == versionId {
+			return i
+		}
+	}
+	return -1
+}
+
+// multipartUpload is the sidecar metadata of a multipart upload
+type multipartUpload struct {
+	UploadId  string         `json:"upload_id"`
+	Key       string         `json:"key"`
+	Initiated time.Time      `json:"initiated"`
+	Object    *objectVersion `json:"object"`
+}
+
+// validBucket checks the bucket name, the names starting with '.' are reserved
+func validBucket(bucket string) error {
+	if bucket == "" || strings.HasPrefix(bucket, ".") || strings.ContainsAny(bucket, `/\`) {
+		return ErrInvalidBucketName
+	}
+	return nil
+}
+
+// validKey checks the key is a relative slash-separated path without empty, '.' or '..' elements
+func validKey(key string) error {
+	if key == "" {
+		return ErrInvalidKey
+	}
+	for _, elem := range strings.Split(key, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+func (l *LocalOss) bucketPath(bucket string) string {
+	return path.Join(l.root, bucket)
+}
+
+func (l *LocalOss) dataPath(bucket, key string) string {
+	return path.Join(l.root, bucket, key)
+}
+
+func (l *LocalOss) metaDir(bucket string) string {
+	return path.Join(l.root, sysDir, "meta", bucket)
+}
+
+func (l *LocalOss) metaPath(bucket, key string) string {
+	return path.Join(l.metaDir(bucket), key+metaSuffix)
+}
+
+// versionPath is where the data of a noncurrent version is kept
+func (l *LocalOss) versionPath(bucket, versionId string) string {
+	return path.Join(l.root, sysDir, "versions", bucket, versionId)
+}
+
+func (l *LocalOss) uploadsDir(bucket string) string {
+	return path.Join(l.root, sysDir, "uploads", bucket)
+}
+
+func (l *LocalOss) uploadDir(bucket, uploadId string) string {
+	return path.Join(l.uploadsDir(bucket), uploadId)
+}
+
+func (l *LocalOss) tmpDir() string {
+	return path.Join(l.root, sysDir, "tmp")
+}
+
+// versionDataPath returns the path of the data of the i-th version
+func (l *LocalOss) versionDataPath(bucket, key string, m *objectMeta, i int) string {
+	if i == len(m.Versions)-1 {
+		return l.dataPath(bucket, key)
+	}
+	return l.versionPath(bucket, m.Versions[i].VersionId)
+}
+
+// loadMeta reads the sidecar metadata of the object, it returns nil if the object doesn't exist
+func (l *LocalOss) loadMeta(bucket, key string) (*objectMeta, error) {
+	data, err := afero.ReadFile(l.fs, l.metaPath(bucket, key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	m := &objectMeta{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// saveMeta writes the sidecar metadata of the object, the file is removed if there is no version left
+func (l *LocalOss) saveMeta(bucket, key string, m *objectMeta) error {
+	p := l.metaPath(bucket, key)
+	if m == nil || len(m.Versions) == 0 {
+		return l.removeFile(p, l.metaDir(bucket))
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return l.writeFile(p, data)
+}
+
+// writeFile writes the file through a temporary file, so that the readers never see a partial file
+func (l *LocalOss) writeFile(name string, data []byte) error {
+	if err := l.fs.MkdirAll(l.tmpDir(), dirPerm); err != nil {
+		return err
+	}
+	f, err := afero.TempFile(l.fs, l.tmpDir(), "meta-")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		l.fs.Remove(f.Name())
+		return err
+	}
+	return l.rename(f.Name(), name)
+}
+
+// rename moves the file and creates the parent directories of the new path
+func (l *LocalOss) rename(oldpath, newpath string) error {
+	if err := l.fs.MkdirAll(path.Dir(newpath), dirPerm); err != nil {
+		return err
+	}
+	return l.fs.Rename(oldpath, newpath)
+}
+
+// removeFile removes the file and its empty parent directories up to the stop directory
+func (l *LocalOss) removeFile(name, stop string) error {
+	if err := l.fs.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for dir := path.Dir(name); strings.HasPrefix(dir, stop+"/"); dir = path.Dir(dir) {
+		if empty, err := afero.IsEmpty(l.fs, dir); err != nil || !empty {
+			break
+		}
+		if err := l.fs.Remove(dir); err != nil {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+
+	"mosn.io/layotto/components/oss"
+)
+
+func partName(partNumber int32) string {
+	return fmt.Sprintf("%s%05d", partPrefix, partNumber)
+}
+
+// loadUpload reads the sidecar metadata of the multipart upload
+func (l *LocalOss) loadUpload(bucket, key, uploadId string) (*multipartUpload, error) {
+	if err := l.checkObject(bucket, key); err != nil {
+		return nil, err
+	}
+	if uploadId == "" || strings.ContainsAny(uploadId, `/\.`) {
+		return nil, ErrNoSuchUpload
+	}
+	data, err := afero.ReadFile(l.fs, path.Join(l.uploadDir(bucket, uploadId), uploadFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoSuchUpload
+		}
+		return nil, err
+	}
+	upload := &multipartUpload{}
+	if err := json.Unmarshal(data, upload); err != nil {
+		return nil, err
+	}
+	if upload.Key != key {
+		return nil, ErrNoSuchUpload
+	}
+	return upload, nil
+}
+
+func (l *LocalOss) CreateMultipartUpload(ctx context.Context, req *oss.CreateMultipartUploadInput) (*oss.CreateMultipartUploadOutput, error) {
+	if err := l.checkObject(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	if req.ACL != "" && !cannedAcls[req.ACL] {
+		return nil, ErrInvalidAcl
+	}
+	upload := &multipartUpload{
+		UploadId:  strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Key:       req.Key,
+		Initiated: time.Now(),
+		Object: &objectVersion{
+			ACL:                req.ACL,
+			CacheControl:       req.CacheControl,
+			ContentDisposition: req.ContentDisposition,
+			ContentEncoding:    req.ContentEncoding,
+			Expires:            req.Expires,
+			StorageClass:       req.StorageClass,
+			Meta:               copyMap(req.MetaData),
+			Tags:               copyMap(req.Tagging),
+		},
+	}
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.writeFile(path.Join(l.uploadDir(req.Bucket, upload.UploadId), uploadFile), data); err != nil {
+		return nil, err
+	}
+	return &oss.CreateMultipartUploadOutput{Bucket: req.Bucket, Key: req.Key, UploadId: upload.UploadId}, nil
+}
+
+// stagePart stages the data as the part of the upload, the part with the same number is replaced
+func (l *LocalOss) stagePart(bucket, uploadId string, partNumber int32, r io.Reader) (string, error) {
+	if partNumber < 1 || partNumber > 10000 {
+		return "", ErrInvalidPart
+	}
+	staged, _, etag, err := l.stage(r)
+	if err != nil {
+		return "", err
+	}
+	if err := l.rename(staged, path.Join(l.uploadDir(bucket, uploadId), partName(partNumber))); err != nil {
+		l.fs.Remove(staged)
+		return "", err
+	}
+	return etag, nil
+}
+
+func (l *LocalOss) UploadPart(ctx context.Context, req *oss.UploadPartInput) (*oss.UploadPartOutput, error) {
+	if _, err := l.loadUpload(req.Bucket, req.Key, req.UploadId); err != nil {
+		return nil, err
+	}
+	etag, err := l.stagePart(req.Bucket, req.UploadId, req.PartNumber, dataStream(req.DataStream))
+	if err != nil {
+		return nil, err
+	}
+	return &oss.UploadPartOutput{ETag: etag}, nil
+}
+
+// UploadPartCopy copies the range of the source object as a part, a zero part size means the rest of the object
+func (l *LocalOss) UploadPartCopy(ctx context.Context, req *oss.UploadPartCopyInput) (*oss.UploadPartCopyOutput, error) {
+	if req.CopySource == nil {
+		return nil, ErrMissingCopySource
+	}
+	if _, err := l.loadUpload(req.Bucket, req.Key, req.UploadId); err != nil {
+		return nil, err
+	}
+	src := req.CopySource
+	f, v, err := l.openVersion(src.CopySourceBucket, src.CopySourceKey, src.CopySourceVersionId)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if req.StartPosition < 0 || req.StartPosition > v.Size || req.PartSize < 0 {
+		return nil, ErrInvalidRange
+	}
+	if _, err := f.Seek(req.StartPosition, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var r io.Reader = f
+	if req.PartSize > 0 {
+		r = io.LimitReader(f, req.PartSize)
+	}
+	etag, err := l.stagePart(req.Bucket, req.UploadId, req.PartNumber, r)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.UploadPartCopyOutput{
+		CopyPartResult:      &oss.CopyPartResult{ETag: etag, LastModified: time.Now().Unix()},
+		CopySourceVersionId: v.VersionId,
+	}, nil
+}
+
+// CompleteMultipartUpload concatenates the parts in the ascending order of the part numbers.
+// The etag of the object is the md5 of the md5s of the parts followed by the number of the parts, like s3 does
+func (l *LocalOss) CompleteMultipartUpload(ctx context.Context, req *oss.CompleteMultipartUploadInput) (*oss.CompleteMultipartUploadOutput, error) {
+	upload, err := l.loadUpload(req.Bucket, req.Key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if req.MultipartUpload == nil || len(req.MultipartUpload.Parts) == 0 {
+		return nil, ErrInvalidPart
+	}
+	dir := l.uploadDir(req.Bucket, req.UploadId)
+	var readers []io.Reader
+	var sums []byte
+	for i, part := range req.MultipartUpload.Parts {
+		if i > 0 && part.PartNumber <= req.MultipartUpload.Parts[i-1].PartNumber {
+			return nil, ErrInvalidPartOrder
+		}
+		name := path.Join(dir, partName(part.PartNumber))
+		_, etag, err := l.checksum(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, ErrInvalidPart
+			}
+			return nil, err
+		}
+		if part.ETag != "" && strings.Trim(part.ETag, `"`) != etag {
+			return nil, ErrInvalidPart
+		}
+		sum, _ := hex.DecodeString(etag)
+		sums = append(sums, sum...)
+		f, err := l.fs.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+
+	v := upload.Object
+	if err := l.put(req.Bucket, req.Key, io.MultiReader(readers...), v); err != nil {
+		return nil, err
+	}
+	sum := md5.Sum(sums)
+	v.ETag = hex.EncodeToString(sum[:]) + "-" + strconv.Itoa(len(req.MultipartUpload.Parts))
+	if _, err := l.updateVersion(req.Bucket, req.Key, v.VersionId, func(cur *objectVersion) error {
+		cur.ETag = v.ETag
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := l.fs.RemoveAll(dir); err != nil {
+		l.logger.Errorf("failed to remove the multipart upload %s: %v", req.UploadId, err)
+	}
+	return &oss.CompleteMultipartUploadOutput{
+		Bucket:    req.Bucket,
+		Key:       req.Key,
+		ETag:      v.ETag,
+		VersionId: v.VersionId,
+		Metadata:  versionMetadata(v),
+	}, nil
+}
+
+func (l *LocalOss) AbortMultipartUpload(ctx context.Context, req *oss.AbortMultipartUploadInput) (*oss.AbortMultipartUploadOutput, error) {
+	if _, err := l.loadUpload(req.Bucket, req.Key, req.UploadId); err != nil {
+		return nil, err
+	}
+	if err := l.fs.RemoveAll(l.uploadDir(req.Bucket, req.UploadId)); err != nil {
+		return nil, err
+	}
+	return &oss.AbortMultipartUploadOutput{}, nil
+}
+
+func (l *LocalOss) ListMultipartUploads(ctx context.Context, req *oss.ListMultipartUploadsInput) (*oss.ListMultipartUploadsOutput, error) {
+	if err := l.checkBucket(req.Bucket); err != nil {
+		return nil, err
+	}
+	infos, err := afero.ReadDir(l.fs, l.uploadsDir(req.Bucket))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var uploads []*multipartUpload
+	for _, info := range infos {
+		data, err := afero.ReadFile(l.fs, path.Join(l.uploadsDir(req.Bucket), info.Name(), uploadFile))
+		if err != nil {
+			// the upload is completed or aborted meanwhile
+			continue
+		}
+		upload := &multipartUpload{}
+		if err := json.Unmarshal(data, upload); err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(upload.Key, req.Prefix) {
+			uploads = append(uploads, upload)
+		}
+	}
+	// the uploads are sorted by the key and then by the initiated time
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].Key != uploads[j].Key {
+			return uploads[i].Key < uploads[j].Key
+		}
+		return uploads[i].Initiated.Before(uploads[j].Initiated)
+	})
+
+	maxUploads := int(req.MaxUploads)
+	if maxUploads <= 0 || maxUploads > maxListKeys {
+		maxUploads = maxListKeys
+	}
+	out := &oss.ListMultipartUploadsOutput{
+		Bucket:         req.Bucket,
+		CommonPrefixes: []string{},
+		Delimiter:      req.Delimiter,
+		EncodingType:   req.EncodingType,
+		KeyMarker:      req.KeyMarker,
+		MaxUploads:     int32(maxUploads),
+		Prefix:         req.Prefix,
+		UploadIDMarker: req.UploadIdMarker,
+		Uploads:        []*oss.MultipartUpload{},
+	}
+	// skip the uploads until the one after the markers
+	skipping := req.KeyMarker != ""
+	for _, upload := range uploads {
+		if skipping {
+			if upload.Key < req.KeyMarker || (upload.Key == req.KeyMarker && req.UploadIdMarker == "") {
+				continue
+			}
+			if upload.Key == req.KeyMarker && upload.UploadId != req.UploadIdMarker {
+				continue
+			}
+			skipping = false
+			if upload.Key == req.KeyMarker {
+				continue
+			}
+		}
+		if len(out.Uploads) == maxUploads {
+			out.IsTruncated = true
+			break
+		}
+		out.Uploads = append(out.Uploads, &oss.MultipartUpload{
+			Initiated:    upload.Initiated.Unix(),
+			Key:          upload.Key,
+			StorageClass: upload.Object.StorageClass,
+			UploadId:     upload.UploadId,
+		})
+	}
+	if out.IsTruncated {
+		last := out.Uploads[len(out.Uploads)-1]
+		out.NextKeyMarker, out.NextUploadIDMarker = last.Key, last.UploadId
+	}
+	return out, nil
+}
+
+func (l *LocalOss) ListParts(ctx context.Context, req *oss.ListPartsInput) (*oss.ListPartsOutput, error) {
+	if _, err := l.loadUpload(req.Bucket, req.Key, req.UploadId); err != nil {
+		return nil, err
+	}
+	dir := l.uploadDir(req.Bucket, req.UploadId)
+	infos, err := afero.ReadDir(l.fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	maxParts := req.MaxParts
+	if maxParts <= 0 || maxParts > maxListKeys {
+		maxParts = maxListKeys
+	}
+	out := &oss.ListPartsOutput{Bucket: req.Bucket, Key: req.Key, UploadId: req.UploadId, MaxParts: maxParts}
+	// the names of the parts are sorted by the part numbers
+	for _, info := range infos {
+		if !strings.HasPrefix(info.Name(), partPrefix) {
+			continue
+		}
+		number, err := strconv.ParseInt(strings.TrimPrefix(info.Name(), partPrefix), 10, 32)
+		if err != nil || number <= req.PartNumberMarker {
+			continue
+		}
+		if int64(len(out.Parts)) == maxParts {
+			out.IsTruncated = true
+			out.NextPartNumberMarker = strconv.FormatInt(out.Parts[len(out.Parts)-1].PartNumber, 10)
+			break
+		}
+		_, etag, err := l.checksum(path.Join(dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		out.Parts = append(out.Parts, &oss.Part{
+			Etag:         etag,
+			LastModified: info.ModTime().Unix(),
+			PartNumber:   number,
+			Size:         info.Size(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,919 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+
+	"mosn.io/layotto/kit/logger"
+
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/pkg/actuators"
+)
+
+const (
+	localComponentName    = "oss-local"
+	inMemoryComponentName = "oss-in-memory"
+)
+
+type healthIndicators struct {
+	readiness *actuators.HealthIndicator
+	liveness  *actuators.HealthIndicator
+}
+
+var (
+	indicatorsMu sync.Mutex
+	indicators   = map[string]*healthIndicators{}
+)
+
+// getIndicators returns the health indicators of the component, they are registered at the first call
+func getIndicators(name string) *healthIndicators {
+	indicatorsMu.Lock()
+	defer indicatorsMu.Unlock()
+	h, ok := indicators[name]
+	if !ok {
+		h = &healthIndicators{readiness: actuators.NewHealthIndicator(), liveness: actuators.NewHealthIndicator()}
+		actuators.SetComponentsIndicator(name, &actuators.ComponentsIndicator{ReadinessIndicator: h.readiness, LivenessIndicator: h.liveness})
+		indicators[name] = h
+	}
+	return h
+}
+
+// Metadata is the basic configuration of the local oss
+type Metadata struct {
+	// RootDir is the directory keeping the buckets, it's ignored by the in-memory oss
+	RootDir string `json:"root_dir"`
+	// Buckets are created on Init if they don't exist
+	Buckets []string `json:"buckets"`
+	// Versioning keeps the noncurrent versions of the objects
+	Versioning bool `json:"versioning"`
+}
+
+// LocalOss implements oss.Oss on top of a filesystem.
+// The buckets are the directories under the root and the objects are the files in them,
+// the tagging, acl and versions of the objects are kept in the sidecar files under the ".oss" directory,
+// where the parts of the multipart uploads are staged too.
+// As a result, a key can't be both an object and the parent directory of other objects.
+type LocalOss struct {
+	// mu serializes the changes of the objects
+	mu         sync.Mutex
+	fs         afero.Fs
+	root       string
+	versioning bool
+	name       string
+	indicators *healthIndicators
+	logger     logger.Logger
+}
+
+// NewLocalOss returns an oss.Oss storing the objects in a local directory
+func NewLocalOss() oss.Oss {
+	return newLocalOss(afero.NewOsFs(), localComponentName)
+}
+
+// NewInMemoryOss returns an oss.Oss storing the objects in memory, which is useful in tests
+func NewInMemoryOss() oss.Oss {
+	return newLocalOss(afero.NewMemMapFs(), inMemoryComponentName)
+}
+
+func newLocalOss(fs afero.Fs, name string) *LocalOss {
+	l := &LocalOss{
+		fs:         fs,
+		name:       name,
+		indicators: getIndicators(name),
+		logger:     logger.NewLayottoLogger("oss/" + name),
+	}
+	logger.RegisterComponentLoggerListener("oss/"+name, l)
+	return l
+}
+
+func (l *LocalOss) OnLogLevelChanged(level logger.LogLevel) {
+	l.logger.SetLogLevel(level)
+}
+
+func (l *LocalOss) Init(ctx context.Context, config *oss.Config) error {
+	if err := l.init(config); err != nil {
+		l.indicators.readiness.ReportError(err.Error())
+		l.indicators.liveness.ReportError(err.Error())
+		return err
+	}
+	l.indicators.readiness.SetStarted()
+	l.indicators.liveness.SetStarted()
+	return nil
+}
+
+func (l *LocalOss) init(config *oss.Config) error {
+	m := &Metadata{}
+	if data, ok := config.Metadata[oss.BasicConfiguration]; ok {
+		if err := json.Unmarshal(data, m); err != nil {
+			return oss.ErrInvalid
+		}
+	}
+	if l.name == inMemoryComponentName {
+		l.root = "/"
+	} else {
+		if m.RootDir == "" {
+			return oss.ErrInvalid
+		}
+		root, err := filepath.Abs(m.RootDir)
+		if err != nil {
+			return err
+		}
+		l.root = filepath.ToSlash(root)
+	}
+	l.versioning = m.Versioning
+	for _, bucket := range m.Buckets {
+		if err := validBucket(bucket); err != nil {
+			return err
+		}
+		if err := l.fs.MkdirAll(l.bucketPath(bucket), dirPerm); err != nil {
+			return err
+		}
+	}
+	return l.fs.MkdirAll(l.tmpDir(), dirPerm)
+}
+
+// checkBucket checks the bucket exists
+func (l *LocalOss) checkBucket(bucket string) error {
+	if err := validBucket(bucket); err != nil {
+		return err
+	}
+	if ok, _ := afero.DirExists(l.fs, l.bucketPath(bucket)); !ok {
+		return ErrNoSuchBucket
+	}
+	return nil
+}
+
+// checkObject checks the bucket exists and the key is valid
+func (l *LocalOss) checkObject(bucket, key string) error {
+	if err := l.checkBucket(bucket); err != nil {
+		return err
+	}
+	return validKey(key)
+}
+
+// stage writes the data to a temporary file, it returns the name, the size and the md5 of the file
+func (l *LocalOss) stage(r io.Reader) (string, int64, string, error) {
+	f, err := afero.TempFile(l.fs, l.tmpDir(), "data-")
+	if err != nil {
+		return "", 0, "", err
+	}
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		l.fs.Remove(f.Name())
+		return "", 0, "", err
+	}
+	return f.Name(), n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func newVersionId() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+// commit makes the staged file the current version of the object, it must be called with l.mu held
+func (l *LocalOss) commit(bucket, key, staged string, v *objectVersion) error {
+	m, err := l.loadMeta(bucket, key)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		m = &objectMeta{}
+	}
+	if l.versioning {
+		v.VersionId = newVersionId()
+		// keep the data of the current version
+		if cur := m.current(); cur != nil && !cur.DeleteMarker {
+			if err := l.rename(l.dataPath(bucket, key), l.versionPath(bucket, cur.VersionId)); err != nil {
+				return err
+			}
+		}
+	} else {
+		m.Versions = nil
+	}
+	if err := l.rename(staged, l.dataPath(bucket, key)); err != nil {
+		return err
+	}
+	m.Versions = append(m.Versions, v)
+	return l.saveMeta(bucket, key, m)
+}
+
+// put stores the data as the current version of the object
+func (l *LocalOss) put(bucket, key string, r io.Reader, v *objectVersion) error {
+	staged, size, etag, err := l.stage(r)
+	if err != nil {
+		return err
+	}
+	v.Size, v.ETag, v.LastModified = size, etag, time.Now()
+	if v.ACL == "" {
+		v.ACL = defaultAcl
+	}
+	if v.StorageClass == "" {
+		v.StorageClass = storageStd
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.commit(bucket, key, staged, v); err != nil {
+		l.fs.Remove(staged)
+		return err
+	}
+	return nil
+}
+
+// getVersion returns the metadata and the index of the version, the delete markers are treated as missing objects
+func (l *LocalOss) getVersion(bucket, key, versionId string) (*objectMeta, int, error) {
+	if err := l.checkObject(bucket, key); err != nil {
+		return nil, 0, err
+	}
+	m, err := l.loadMeta(bucket, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	i := m.find(versionId)
+	if i < 0 {
+		if versionId != "" && m != nil {
+			return nil, 0, ErrNoSuchVersion
+		}
+		return nil, 0, ErrNoSuchKey
+	}
+	if m.Versions[i].DeleteMarker {
+		return nil, 0, ErrNoSuchKey
+	}
+	return m, i, nil
+}
+
+// openVersion opens the data of the version
+func (l *LocalOss) openVersion(bucket, key, versionId string) (afero.File, *objectVersion, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, i, err := l.getVersion(bucket, key, versionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := l.fs.Open(l.versionDataPath(bucket, key, m, i))
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, m.Versions[i], nil
+}
+
+// updateVersion changes the attributes of the version
+func (l *LocalOss) updateVersion(bucket, key, versionId string, update func(v *objectVersion) error) (*objectVersion, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, i, err := l.getVersion(bucket, key, versionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(m.Versions[i]); err != nil {
+		return nil, err
+	}
+	return m.Versions[i], l.saveMeta(bucket, key, m)
+}
+
+// checkPreconditions checks the conditional headers of GetObject
+func checkPreconditions(req *oss.GetObjectInput, v *objectVersion) error {
+	modified := v.LastModified.Unix()
+	if req.IfMatch != "" && strings.Trim(req.IfMatch, `"`) != v.ETag {
+		return ErrPreconditionFailed
+	}
+	if req.IfUnmodifiedSince > 0 && modified > req.IfUnmodifiedSince {
+		return ErrPreconditionFailed
+	}
+	if req.IfNoneMatch != "" && strings.Trim(req.IfNoneMatch, `"`) == v.ETag {
+		return ErrNotModified
+	}
+	if req.IfModifiedSince > 0 && modified <= req.IfModifiedSince {
+		return ErrNotModified
+	}
+	return nil
+}
+
+type rangeReader struct {
+	io.Reader
+	io.Closer
+}
+
+func (l *LocalOss) GetObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
+	f, v, err := l.openVersion(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPreconditions(req, v); err != nil {
+		f.Close()
+		return nil, err
+	}
+	out := &oss.GetObjectOutput{
+		DataStream:         f,
+		CacheControl:       v.CacheControl,
+		ContentDisposition: v.ContentDisposition,
+		ContentEncoding:    v.ContentEncoding,
+		ContentLength:      v.Size,
+		ETag:               v.ETag,
+		LastModified:       v.LastModified.Unix(),
+		VersionId:          v.VersionId,
+		TagCount:           int64(len(v.Tags)),
+		StorageClass:       v.StorageClass,
+		Metadata:           copyMap(v.Meta),
+	}
+	if v.Expires > 0 {
+		out.Expires = time.Unix(v.Expires, 0).UTC().Format(http.TimeFormat)
+	}
+	// the range is inclusive, a zero end means the end of the object
+	if req.Start > 0 || req.End > 0 {
+		end := req.End
+		if end == 0 || end >= v.Size {
+			end = v.Size - 1
+		}
+		if req.Start < 0 || req.Start > end {
+			f.Close()
+			return nil, ErrInvalidRange
+		}
+		if _, err := f.Seek(req.Start, io.SeekStart); err != nil {
+			f.Close()
+			return nil, err
+		}
+		out.DataStream = rangeReader{Reader: io.LimitReader(f, end-req.Start+1), Closer: f}
+		out.ContentLength = end - req.Start + 1
+		out.ContentRange = fmt.Sprintf("bytes %d-%d/%d", req.Start, end, v.Size)
+	}
+	return out, nil
+}
+
+func (l *LocalOss) PutObject(ctx context.Context, req *oss.PutObjectInput) (*oss.PutObjectOutput, error) {
+	if err := l.checkObject(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	if req.ACL != "" && !cannedAcls[req.ACL] {
+		return nil, ErrInvalidAcl
+	}
+	v := &objectVersion{
+		ACL:                req.ACL,
+		CacheControl:       req.CacheControl,
+		ContentDisposition: req.ContentDisposition,
+		ContentEncoding:    req.ContentEncoding,
+		Expires:            req.Expires,
+		StorageClass:       req.StorageClass,
+		Meta:               copyMap(req.Meta),
+		Tags:               copyMap(req.Tagging),
+	}
+	if err := l.put(req.Bucket, req.Key, dataStream(req.DataStream), v); err != nil {
+		return nil, err
+	}
+	return &oss.PutObjectOutput{ETag: v.ETag, Metadata: versionMetadata(v)}, nil
+}
+
+func (l *LocalOss) DeleteObject(ctx context.Context, req *oss.DeleteObjectInput) (*oss.DeleteObjectOutput, error) {
+	if err := l.checkObject(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	deleted, err := l.delete(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.DeleteObjectOutput{DeleteMarker: deleted.DeleteMarker, VersionId: deleted.VersionId}, nil
+}
+
+// delete removes the version of the object, a delete marker is added if versioning is enabled and no version is specified.
+// It must be called with l.mu held
+func (l *LocalOss) delete(bucket, key, versionId string) (*objectVersion, error) {
+	m, err := l.loadMeta(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	dataPath := l.dataPath(bucket, key)
+	if versionId == "" {
+		if !l.versioning {
+			// deleting a missing object succeeds
+			if err := l.removeFile(dataPath, l.bucketPath(bucket)); err != nil {
+				return nil, err
+			}
+			return &objectVersion{}, l.saveMeta(bucket, key, nil)
+		}
+		if m == nil {
+			m = &objectMeta{}
+		}
+		if cur := m.current(); cur != nil && !cur.DeleteMarker {
+			if err := l.rename(dataPath, l.versionPath(bucket, cur.VersionId)); err != nil {
+				return nil, err
+			}
+			l.removeFile(dataPath, l.bucketPath(bucket))
+		}
+		marker := &objectVersion{VersionId: newVersionId(), DeleteMarker: true, LastModified: time.Now()}
+		m.Versions = append(m.Versions, marker)
+		return marker, l.saveMeta(bucket, key, m)
+	}
+
+	i := m.find(versionId)
+	if i < 0 {
+		return nil, ErrNoSuchVersion
+	}
+	v := m.Versions[i]
+	isCurrent := i == len(m.Versions)-1
+	if !v.DeleteMarker {
+		stop := path.Join(l.root, sysDir)
+		if isCurrent {
+			stop = l.bucketPath(bucket)
+		}
+		if err := l.removeFile(l.versionDataPath(bucket, key, m, i), stop); err != nil {
+			return nil, err
+		}
+	}
+	m.Versions = append(m.Versions[:i], m.Versions[i+1:]...)
+	// the previous version becomes the current one
+	if cur := m.current(); isCurrent && cur != nil && !cur.DeleteMarker {
+		if err := l.rename(l.versionPath(bucket, cur.VersionId), dataPath); err != nil {
+			return nil, err
+		}
+	}
+	return v, l.saveMeta(bucket, key, m)
+}
+
+func (l *LocalOss) PutObjectTagging(ctx context.Context, req *oss.PutObjectTaggingInput) (*oss.PutObjectTaggingOutput, error) {
+	_, err := l.updateVersion(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.Tags = copyMap(req.Tags)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.PutObjectTaggingOutput{}, nil
+}
+
+func (l *LocalOss) DeleteObjectTagging(ctx context.Context, req *oss.DeleteObjectTaggingInput) (*oss.DeleteObjectTaggingOutput, error) {
+	v, err := l.updateVersion(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.Tags = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.DeleteObjectTaggingOutput{VersionId: v.VersionId}, nil
+}
+
+func (l *LocalOss) GetObjectTagging(ctx context.Context, req *oss.GetObjectTaggingInput) (*oss.GetObjectTaggingOutput, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, i, err := l.getVersion(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	v := m.Versions[i]
+	tags := copyMap(v.Tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	return &oss.GetObjectTaggingOutput{Tags: tags, VersionId: v.VersionId}, nil
+}
+
+func (l *LocalOss) CopyObject(ctx context.Context, req *oss.CopyObjectInput) (*oss.CopyObjectOutput, error) {
+	if req.CopySource == nil {
+		return nil, ErrMissingCopySource
+	}
+	if err := l.checkObject(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	src := req.CopySource
+	f, srcVersion, err := l.openVersion(src.CopySourceBucket, src.CopySourceKey, src.CopySourceVersionId)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	v := &objectVersion{
+		ACL:                srcVersion.ACL,
+		CacheControl:       srcVersion.CacheControl,
+		ContentDisposition: srcVersion.ContentDisposition,
+		ContentEncoding:    srcVersion.ContentEncoding,
+		Expires:            srcVersion.Expires,
+		StorageClass:       srcVersion.StorageClass,
+		Meta:               copyMap(srcVersion.Meta),
+		Tags:               copyMap(srcVersion.Tags),
+	}
+	if strings.EqualFold(req.MetadataDirective, "REPLACE") {
+		v.Meta = copyMap(req.Metadata)
+	}
+	if req.Tagging != nil {
+		v.Tags = copyMap(req.Tagging)
+	}
+	if req.Expires > 0 {
+		v.Expires = req.Expires
+	}
+	if err := l.put(req.Bucket, req.Key, f, v); err != nil {
+		return nil, err
+	}
+	return &oss.CopyObjectOutput{
+		CopyObjectResult: &oss.CopyObjectResult{ETag: v.ETag, LastModified: v.LastModified.Unix()},
+		Metadata:         versionMetadata(v),
+	}, nil
+}
+
+func (l *LocalOss) DeleteObjects(ctx context.Context, req *oss.DeleteObjectsInput) (*oss.DeleteObjectsOutput, error) {
+	if err := l.checkBucket(req.Bucket); err != nil {
+		return nil, err
+	}
+	out := &oss.DeleteObjectsOutput{}
+	if req.Delete == nil {
+		return out, nil
+	}
+	for _, obj := range req.Delete.Objects {
+		res, err := l.DeleteObject(ctx, &oss.DeleteObjectInput{Bucket: req.Bucket, Key: obj.Key, VersionId: obj.VersionId})
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete %s: %w", obj.Key, err)
+		}
+		deleted := &oss.DeletedObject{Key: obj.Key, VersionId: obj.VersionId, DeleteMarker: res.DeleteMarker}
+		if res.DeleteMarker {
+			deleted.DeleteMarkerVersionId = res.VersionId
+		}
+		out.Deleted = append(out.Deleted, deleted)
+	}
+	return out, nil
+}
+
+// keys returns the sorted keys of the objects whose sidecar metadata exists
+func (l *LocalOss) keys(bucket string) ([]string, error) {
+	dir := l.metaDir(bucket)
+	var keys []string
+	err := afero.Walk(l.fs, dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(p, metaSuffix) {
+			return nil
+		}
+		keys = append(keys, strings.TrimSuffix(strings.TrimPrefix(p, dir+"/"), metaSuffix))
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
+
+// listKeys pages the sorted keys, the keys containing the delimiter after the prefix are rolled up into the common prefixes
+func listKeys(keys []string, prefix, delimiter, marker string, maxKeys int) (contents []string, prefixes []string, truncated bool) {
+	if maxKeys <= 0 || maxKeys > maxListKeys {
+		maxKeys = maxListKeys
+	}
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+		entry := key
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				entry = key[:len(prefix)+i+len(delimiter)]
+				if seen[entry] || entry <= marker {
+					continue
+				}
+			}
+		}
+		if len(contents)+len(prefixes) == maxKeys {
+			return contents, prefixes, true
+		}
+		if entry != key {
+			seen[entry] = true
+			prefixes = append(prefixes, entry)
+		} else {
+			contents = append(contents, key)
+		}
+	}
+	return contents, prefixes, false
+}
+
+func (l *LocalOss) ListObjects(ctx context.Context, req *oss.ListObjectsInput) (*oss.ListObjectsOutput, error) {
+	if err := l.checkBucket(req.Bucket); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	all, err := l.keys(req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	// the objects whose current version is a delete marker are not listed
+	metas := make(map[string]*objectVersion, len(all))
+	keys := all[:0]
+	for _, key := range all {
+		m, err := l.loadMeta(req.Bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		if cur := m.current(); cur != nil && !cur.DeleteMarker {
+			metas[key] = cur
+			keys = append(keys, key)
+		}
+	}
+	contents, prefixes, truncated := listKeys(keys, req.Prefix, req.Delimiter, req.Marker, int(req.MaxKeys))
+	out := &oss.ListObjectsOutput{
+		CommonPrefixes: prefixes,
+		Delimiter:      req.Delimiter,
+		EncodingType:   req.EncodingType,
+		IsTruncated:    truncated,
+		Marker:         req.Marker,
+		MaxKeys:        req.MaxKeys,
+		Name:           req.Bucket,
+		Prefix:         req.Prefix,
+	}
+	for _, key := range contents {
+		v := metas[key]
+		out.Contents = append(out.Contents, &oss.Object{
+			ETag:         v.ETag,
+			Key:          key,
+			LastModified: v.LastModified.Unix(),
+			Size:         v.Size,
+			StorageClass: v.StorageClass,
+		})
+	}
+	if truncated {
+		out.NextMarker = lastEntry(contents, prefixes)
+	}
+	return out, nil
+}
+
+// lastEntry returns the greatest one of the listed keys and common prefixes
+func lastEntry(contents, prefixes []string) string {
+	var last string
+	if len(contents) > 0 {
+		last = contents[len(contents)-1]
+	}
+	if len(prefixes) > 0 && prefixes[len(prefixes)-1] > last {
+		last = prefixes[len(prefixes)-1]
+	}
+	return last
+}
+
+func (l *LocalOss) GetObjectCannedAcl(ctx context.Context, req *oss.GetObjectCannedAclInput) (*oss.GetObjectCannedAclOutput, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, i, err := l.getVersion(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.GetObjectCannedAclOutput{CannedAcl: m.Versions[i].ACL}, nil
+}
+
+func (l *LocalOss) PutObjectCannedAcl(ctx context.Context, req *oss.PutObjectCannedAclInput) (*oss.PutObjectCannedAclOutput, error) {
+	if !cannedAcls[req.Acl] {
+		return nil, ErrInvalidAcl
+	}
+	_, err := l.updateVersion(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.ACL = req.Acl
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.PutObjectCannedAclOutput{}, nil
+}
+
+// RestoreObject succeeds for any existing object, since the objects are never archived
+func (l *LocalOss) RestoreObject(ctx context.Context, req *oss.RestoreObjectInput) (*oss.RestoreObjectOutput, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, _, err := l.getVersion(req.Bucket, req.Key, req.VersionId); err != nil {
+		return nil, err
+	}
+	return &oss.RestoreObjectOutput{}, nil
+}
+
+func (l *LocalOss) ListObjectVersions(ctx context.Context, req *oss.ListObjectVersionsInput) (*oss.ListObjectVersionsOutput, error) {
+	if err := l.checkBucket(req.Bucket); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	keys, err := l.keys(req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	contents, prefixes, truncated := listKeys(keys, req.Prefix, req.Delimiter, req.KeyMarker, int(req.MaxKeys))
+	out := &oss.ListObjectVersionsOutput{
+		CommonPrefixes:  prefixes,
+		Delimiter:       req.Delimiter,
+		EncodingType:    req.EncodingType,
+		IsTruncated:     truncated,
+		KeyMarker:       req.KeyMarker,
+		MaxKeys:         req.MaxKeys,
+		Name:            req.Bucket,
+		Prefix:          req.Prefix,
+		VersionIdMarker: req.VersionIdMarker,
+	}
+	for _, key := range contents {
+		m, err := l.loadMeta(req.Bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		// the latest version goes first
+		for i := len(m.Versions) - 1; i >= 0; i-- {
+			v := m.Versions[i]
+			isLatest := i == len(m.Versions)-1
+			if v.DeleteMarker {
+				out.DeleteMarkers = append(out.DeleteMarkers, &oss.DeleteMarkerEntry{
+					IsLatest:     isLatest,
+					Key:          key,
+					LastModified: v.LastModified.Unix(),
+					VersionId:    v.VersionId,
+				})
+				continue
+			}
+			out.Versions = append(out.Versions, &oss.ObjectVersion{
+				ETag:         v.ETag,
+				IsLatest:     isLatest,
+				Key:          key,
+				LastModified: v.LastModified.Unix(),
+				Size:         v.Size,
+				StorageClass: v.StorageClass,
+				VersionId:    v.VersionId,
+			})
+		}
+	}
+	if truncated {
+		out.NextKeyMarker = lastEntry(contents, prefixes)
+	}
+	return out, nil
+}
+
+func (l *LocalOss) HeadObject(ctx context.Context, req *oss.HeadObjectInput) (*oss.HeadObjectOutput, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, i, err := l.getVersion(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	v := m.Versions[i]
+	metadata := copyMap(v.Meta)
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	for k, val := range versionMetadata(v) {
+		metadata[k] = val
+	}
+	metadata["Content-Length"] = strconv.FormatInt(v.Size, 10)
+	metadata["Last-Modified"] = v.LastModified.UTC().Format(http.TimeFormat)
+	if req.WithDetails {
+		metadata["Storage-Class"] = v.StorageClass
+		metadata["Acl"] = v.ACL
+		metadata["Tag-Count"] = strconv.Itoa(len(v.Tags))
+	}
+	return &oss.HeadObjectOutput{ResultMetadata: metadata}, nil
+}
+
+func (l *LocalOss) IsObjectExist(ctx context.Context, req *oss.IsObjectExistInput) (*oss.IsObjectExistOutput, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _, err := l.getVersion(req.Bucket, req.Key, "")
+	if errors.Is(err, ErrNoSuchKey) {
+		return &oss.IsObjectExistOutput{FileExist: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &oss.IsObjectExistOutput{FileExist: true}, nil
+}
+
+func (l *LocalOss) SignURL(ctx context.Context, req *oss.SignURLInput) (*oss.SignURLOutput, error) {
+	return nil, errors.New("SignURL method not supported on local oss")
+}
+
+func (l *LocalOss) UpdateDownloadBandwidthRateLimit(ctx context.Context, req *oss.UpdateBandwidthRateLimitInput) error {
+	return errors.New("UpdateDownloadBandwidthRateLimit method not supported now")
+}
+
+func (l *LocalOss) UpdateUploadBandwidthRateLimit(ctx context.Context, req *oss.UpdateBandwidthRateLimitInput) error {
+	return errors.New("UpdateUploadBandwidthRateLimit method not supported now")
+}
+
+// AppendObject appends the data to the current version of the object, which is created if the position is 0
+func (l *LocalOss) AppendObject(ctx context.Context, req *oss.AppendObjectInput) (*oss.AppendObjectOutput, error) {
+	if err := l.checkObject(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	m, err := l.loadMeta(req.Bucket, req.Key)
+	if err != nil {
+		l.mu.Unlock()
+		return nil, err
+	}
+	cur := m.current()
+	if cur == nil || cur.DeleteMarker {
+		l.mu.Unlock()
+		if req.Position != 0 {
+			return nil, ErrPositionNotEqualToLength
+		}
+		v := &objectVersion{
+			ACL:                req.ACL,
+			CacheControl:       req.CacheControl,
+			ContentDisposition: req.ContentDisposition,
+			ContentEncoding:    req.ContentEncoding,
+			Expires:            req.Expires,
+			StorageClass:       req.StorageClass,
+			Tags:               copyMap(req.Tags),
+		}
+		if err := l.put(req.Bucket, req.Key, dataStream(req.DataStream), v); err != nil {
+			return nil, err
+		}
+		return &oss.AppendObjectOutput{AppendPosition: v.Size}, nil
+	}
+	defer l.mu.Unlock()
+	if req.Position != cur.Size {
+		return nil, ErrPositionNotEqualToLength
+	}
+	dataPath := l.dataPath(req.Bucket, req.Key)
+	f, err := l.fs.OpenFile(dataPath, os.O_WRONLY|os.O_APPEND, filePerm)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(f, dataStream(req.DataStream))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	size, etag, err := l.checksum(dataPath)
+	if err != nil {
+		return nil, err
+	}
+	cur.Size, cur.ETag, cur.LastModified = size, etag, time.Now()
+	return &oss.AppendObjectOutput{AppendPosition: size}, l.saveMeta(req.Bucket, req.Key, m)
+}
+
+// checksum returns the size and the md5 of the file
+func (l *LocalOss) checksum(name string) (int64, string, error) {
+	f, err := l.fs.Open(name)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := md5.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// versionMetadata returns the etag and the version id of the version
+func versionMetadata(v *objectVersion) map[string]string {
+	metadata := map[string]string{"ETag": v.ETag}
+	if v.VersionId != "" {
+		metadata["Version-Id"] = v.VersionId
+	}
+	return metadata
+}
+
+func dataStream(r io.Reader) io.Reader {
+	if r == nil {
+		return strings.NewReader("")
+	}
+	return r
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
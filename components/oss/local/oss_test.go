@@ -0,0 +1,128 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/oss/conformance"
+)
+
+func newConfig(metadata string) *oss.Config {
+	return &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: json.RawMessage(metadata)}}
+}
+
+func TestLocalOssConformance(t *testing.T) {
+	for _, versioning := range []bool{false, true} {
+		o := NewLocalOss()
+		dir := t.TempDir()
+		conf, _ := json.Marshal(Metadata{RootDir: dir, Buckets: []string{"test"}, Versioning: versioning})
+		require.Nil(t, o.Init(context.TODO(), newConfig(string(conf))))
+		conformance.Run(t, o, conformance.Config{Bucket: "test", Versioning: versioning})
+	}
+}
+
+func TestInMemoryOssConformance(t *testing.T) {
+	for _, versioning := range []bool{false, true} {
+		o := NewInMemoryOss()
+		conf, _ := json.Marshal(Metadata{Buckets: []string{"test"}, Versioning: versioning})
+		require.Nil(t, o.Init(context.TODO(), newConfig(string(conf))))
+		conformance.Run(t, o, conformance.Config{Bucket: "test", Versioning: versioning})
+	}
+}
+
+func TestInit(t *testing.T) {
+	assert.Error(t, NewLocalOss().Init(context.TODO(), newConfig(`{}`)))
+	assert.Error(t, NewLocalOss().Init(context.TODO(), newConfig(`[]`)))
+	assert.Error(t, NewInMemoryOss().Init(context.TODO(), newConfig(`{"buckets":[".oss"]}`)))
+	assert.Nil(t, NewInMemoryOss().Init(context.TODO(), &oss.Config{}))
+}
+
+func TestLocalOssLayout(t *testing.T) {
+	o := NewLocalOss()
+	dir := t.TempDir()
+	require.Nil(t, o.Init(context.TODO(), newConfig(`{"root_dir":"`+dir+`","buckets":["test"]}`)))
+
+	_, err := o.PutObject(context.TODO(), &oss.PutObjectInput{
+		Bucket:     "test",
+		Key:        "a/b.txt",
+		DataStream: strings.NewReader("hello"),
+		Tagging:    map[string]string{"env": "test"},
+	})
+	require.Nil(t, err)
+
+	// the buckets are directories and the objects are files
+	data, err := os.ReadFile(filepath.Join(dir, "test", "a", "b.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	// the tagging is kept in the sidecar file
+	data, err = os.ReadFile(filepath.Join(dir, sysDir, "meta", "test", "a", "b.txt"+metaSuffix))
+	require.Nil(t, err)
+	assert.Contains(t, string(data), `"env":"test"`)
+
+	// the empty directories are removed with the objects
+	_, err = o.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: "test", Key: "a/b.txt"})
+	require.Nil(t, err)
+	_, err = os.Stat(filepath.Join(dir, "test", "a"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "test"))
+	assert.Nil(t, err)
+
+	// the objects survive a restart
+	_, err = o.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: "test", Key: "c.txt", DataStream: strings.NewReader("hello")})
+	require.Nil(t, err)
+	o = NewLocalOss()
+	require.Nil(t, o.Init(context.TODO(), newConfig(`{"root_dir":"`+dir+`"}`)))
+	out, err := o.IsObjectExist(context.TODO(), &oss.IsObjectExistInput{Bucket: "test", Key: "c.txt"})
+	require.Nil(t, err)
+	assert.True(t, out.FileExist)
+}
+
+func TestInvalidNames(t *testing.T) {
+	o := NewInMemoryOss()
+	require.Nil(t, o.Init(context.TODO(), newConfig(`{"buckets":["test"]}`)))
+	for _, key := range []string{"", "/a", "a/", "a//b", "../a", "a/./b"} {
+		_, err := o.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: "test", Key: key, DataStream: strings.NewReader("hello")})
+		assert.Equal(t, ErrInvalidKey, err, key)
+	}
+	_, err := o.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: ".oss", Key: "a", DataStream: strings.NewReader("hello")})
+	assert.Equal(t, ErrInvalidBucketName, err)
+	_, err = o.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: "missing", Key: "a", DataStream: strings.NewReader("hello")})
+	assert.Equal(t, ErrNoSuchBucket, err)
+}
+
+func Test_listKeys(t *testing.T) {
+	keys := []string{"a", "b/1", "b/2", "c"}
+	contents, prefixes, truncated := listKeys(keys, "", "/", "", 2)
+	assert.Equal(t, []string{"a"}, contents)
+	assert.Equal(t, []string{"b/"}, prefixes)
+	assert.True(t, truncated)
+
+	contents, prefixes, truncated = listKeys(keys, "", "/", "b/", 2)
+	assert.Equal(t, []string{"c"}, contents)
+	assert.Empty(t, prefixes)
+	assert.False(t, truncated)
+}
@@ -57,6 +57,16 @@ type Oss interface {
 	ListParts(context.Context, *ListPartsInput) (*ListPartsOutput, error)
 }
 
+// Wrapper is an oss component delegating to another one, like the encryption of the objects.
+// See file.Wrapper for how the runtime creates the wrapped component from the config of the wrapper
+type Wrapper interface {
+	Oss
+	// WrappedConfig returns the config of the wrapped component from the config of the wrapper
+	WrappedConfig(*Config) (*Config, error)
+	// Wrap sets the wrapped component, it is called before Init
+	Wrap(Oss)
+}
+
 type GetObjectInput struct {
 	Bucket                     string `json:"bucket,omitempty"`
 	ExpectedBucketOwner        string `json:"expected_bucket_owner,omitempty"`
@@ -31,6 +31,15 @@ var (
 	ErrInvalid = errors.New("invalid argument")
 )
 
+// DynamicMetadata converts the metadata passed to common.DynamicComponent.ApplyConfig into the metadata of Config
+func DynamicMetadata(metadata map[string]string) map[string]json.RawMessage {
+	res := make(map[string]json.RawMessage, len(metadata))
+	for k, v := range metadata {
+		res[k] = json.RawMessage(v)
+	}
+	return res
+}
+
 // Config wraps configuration for a oss implementation
 type Config struct {
 	ref.Config
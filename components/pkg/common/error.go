@@ -24,6 +24,7 @@ const (
 	UnavailebleCode
 	InternalCode
 	InvalidArgsCode
+	PermissionDeniedCode
 )
 
 type CommonError interface {
@@ -70,6 +71,8 @@ func ToGrpcError(err error) error {
 			code = codes.Internal
 		case InvalidArgsCode:
 			code = codes.InvalidArgument
+		case PermissionDeniedCode:
+			code = codes.PermissionDenied
 		default:
 			code = codes.Unknown
 		}
@@ -18,6 +18,7 @@ package common
 
 import (
 	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/cryption"
 
 	"github.com/dapr/components-contrib/secretstores"
 )
@@ -26,3 +27,8 @@ type SetComponent interface {
 	SetConfigStore(cs configstores.Store) (err error)
 	SetSecretStore(ss secretstores.SecretStore) (err error)
 }
+
+// SetCryptionService is implemented by the components referring to a cryption service
+type SetCryptionService interface {
+	SetCryptionService(cs cryption.CryptionService) (err error)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package envelope implements the envelope encryption of the data streams.
+// The data is encrypted with AES-256-GCM by a random data key in chunks of a fixed size,
+// and the data key is wrapped by a cryption.CryptionService.
+// The chunks can be decrypted independently, so a byte range is read without decrypting the whole stream.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+
+	"mosn.io/layotto/components/cryption"
+)
+
+const (
+	// DefaultChunkSize is the size of the plaintext chunks
+	DefaultChunkSize = 64 << 10
+	// MaxChunkSize bounds the chunk size read from an envelope, the buffers of a chunk are allocated up front
+	MaxChunkSize = 16 << 20
+	keySize      = 32
+	nonceSize    = 12
+	tagSize      = 16
+)
+
+var (
+	ErrInvalidEnvelope = errors.New("invalid envelope")
+	ErrMissingCryption = errors.New("cryption service is required for envelope encryption")
+)
+
+// Envelope is what is needed besides the data key to decrypt a stream
+type Envelope struct {
+	// WrappedKey is the data key encrypted by the cryption service
+	WrappedKey []byte
+	// Nonce is the base nonce of the chunks
+	Nonce     []byte
+	ChunkSize int
+}
+
+// KeyManager gets the data keys wrapped by a cryption service
+type KeyManager struct {
+	service cryption.CryptionService
+	// keyID is the key of the cryption service wrapping the data keys, the default key of the service is used if empty
+	keyID string
+}
+
+func NewKeyManager(service cryption.CryptionService, keyID string) *KeyManager {
+	return &KeyManager{service: service, keyID: keyID}
+}
+
+// NewEnvelope generates a data key and returns it with its envelope
+func (k *KeyManager) NewEnvelope(ctx context.Context, chunkSize int) ([]byte, *Envelope, error) {
+	if k.service == nil {
+		return nil, nil, ErrMissingCryption
+	}
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize > MaxChunkSize {
+		return nil, nil, ErrInvalidEnvelope
+	}
+	key := make([]byte, keySize)
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	resp, err := k.service.Encrypt(ctx, &cryption.EncryptRequest{PlainText: key, KeyId: k.keyID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, &Envelope{WrappedKey: resp.CipherText, Nonce: nonce, ChunkSize: chunkSize}, nil
+}
+
+// Unwrap decrypts the data key of the envelope
+func (k *KeyManager) Unwrap(ctx context.Context, env *Envelope) ([]byte, error) {
+	if k.service == nil {
+		return nil, ErrMissingCryption
+	}
+	resp, err := k.service.Decrypt(ctx, &cryption.DecryptRequest{CipherText: env.WrappedKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.PlainText) != keySize {
+		return nil, ErrInvalidEnvelope
+	}
+	return resp.PlainText, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// CipherSize returns the size of the encrypted stream of a plaintext of the size
+func CipherSize(size int64, chunkSize int) int64 {
+	chunks := (size + int64(chunkSize) - 1) / int64(chunkSize)
+	if chunks == 0 {
+		// an empty stream is still sealed as one chunk
+		chunks = 1
+	}
+	return size + chunks*tagSize
+}
+
+// PlainSize returns the size of the plaintext of an encrypted stream of the size
+func PlainSize(size int64, chunkSize int) int64 {
+	block := int64(chunkSize + tagSize)
+	plain := size / block * int64(chunkSize)
+	if rem := size % block; rem > tagSize {
+		plain += rem - tagSize
+	}
+	return plain
+}
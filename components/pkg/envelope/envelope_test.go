@@ -0,0 +1,145 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/file/filetest"
+)
+
+func encrypt(t *testing.T, key []byte, env *Envelope, data []byte) []byte {
+	r, err := NewEncryptReader(bytes.NewReader(data), key, env)
+	require.Nil(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.Nil(t, err)
+	return out
+}
+
+func decrypt(key []byte, env *Envelope, data []byte, first, count int64) ([]byte, error) {
+	r, err := NewDecryptReader(bytes.NewReader(data), key, env, first, count)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	fake := &filetest.Cryption{}
+	km := NewKeyManager(fake, "key1")
+	key, env, err := km.NewEnvelope(context.TODO(), 16)
+	require.Nil(t, err)
+	assert.Equal(t, "key1", fake.KeyID())
+	unwrapped, err := km.Unwrap(context.TODO(), env)
+	require.Nil(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	for _, size := range []int{0, 1, 15, 16, 17, 32, 53} {
+		data := make([]byte, size)
+		rand.Read(data)
+		sealed := encrypt(t, key, env, data)
+		assert.Equal(t, CipherSize(int64(size), 16), int64(len(sealed)))
+		assert.Equal(t, int64(size), PlainSize(int64(len(sealed)), 16))
+		opened, err := decrypt(key, env, sealed, 0, -1)
+		assert.Nil(t, err)
+		assert.Equal(t, data, append([]byte{}, opened...), "size %d", size)
+	}
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 3)
+	sealed := encrypt(t, key, env, data)
+	// truncated at a chunk boundary
+	_, err = decrypt(key, env, sealed[:2*(16+tagSize)], 0, -1)
+	assert.Equal(t, ErrInvalidEnvelope, err)
+	_, err = decrypt(key, env, nil, 0, -1)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	// tampered
+	tampered := append([]byte{}, sealed...)
+	tampered[20] ^= 1
+	_, err = decrypt(key, env, tampered, 0, -1)
+	assert.Equal(t, ErrInvalidEnvelope, err)
+
+	_, _, err = NewKeyManager(nil, "").NewEnvelope(context.TODO(), 0)
+	assert.Equal(t, ErrMissingCryption, err)
+}
+
+func TestRange(t *testing.T) {
+	key := make([]byte, keySize)
+	env := &Envelope{Nonce: make([]byte, nonceSize), ChunkSize: 16}
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJ")
+	sealed := encrypt(t, key, env, data)
+
+	read := func(start, end int64) string {
+		r := NewRange(start, end, 16)
+		cipherEnd := int64(len(sealed))
+		if r.CipherEnd >= 0 && r.CipherEnd+1 < cipherEnd {
+			cipherEnd = r.CipherEnd + 1
+		}
+		opened, err := decrypt(key, env, sealed[r.CipherStart:cipherEnd], r.FirstChunk, r.Chunks)
+		require.Nil(t, err)
+		opened = opened[r.Skip:]
+		if end >= 0 && int64(len(opened)) > end-start+1 {
+			opened = opened[:end-start+1]
+		}
+		return string(opened)
+	}
+	assert.Equal(t, "2345", read(2, 5))
+	assert.Equal(t, "ghijklmnopqrstuvwx", read(16, 33))
+	assert.Equal(t, "fghijklmnopqrstuvwxyzAB", read(15, 37))
+	assert.Equal(t, "EFGHIJ", read(40, -1))
+	assert.Equal(t, "EFGHIJ", read(40, 100))
+	assert.Equal(t, string(data), read(0, -1))
+}
+
+func TestEnvelopeSerialization(t *testing.T) {
+	env := &Envelope{WrappedKey: []byte("wrapped"), Nonce: bytes.Repeat([]byte{1}, nonceSize), ChunkSize: 1024}
+
+	got, ok, err := FromMetadata(env.Metadata())
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, env, got)
+	_, ok, err = FromMetadata(map[string]string{"owner": "layotto"})
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	_, ok, err = FromMetadata(map[string]string{"Layotto-Envelope-Key": "d3JhcHBlZA=="})
+	assert.True(t, ok)
+	assert.Equal(t, ErrInvalidEnvelope, err)
+
+	header := env.Header()
+	assert.Equal(t, env.HeaderSize(), int64(len(header)))
+	got, err = ReadHeader(io.MultiReader(bytes.NewReader(header), bytes.NewReader([]byte("data"))))
+	assert.Nil(t, err)
+	assert.Equal(t, env, got)
+	_, err = ReadHeader(bytes.NewReader([]byte("plain data without header")))
+	assert.Equal(t, ErrInvalidEnvelope, err)
+
+	// a chunk size over the limit is rejected before any chunk is allocated
+	huge := &Envelope{WrappedKey: env.WrappedKey, Nonce: env.Nonce, ChunkSize: MaxChunkSize + 1}
+	_, _, err = FromMetadata(huge.Metadata())
+	assert.Equal(t, ErrInvalidEnvelope, err)
+	_, err = ReadHeader(bytes.NewReader(huge.Header()))
+	assert.Equal(t, ErrInvalidEnvelope, err)
+	_, err = NewDecryptReader(bytes.NewReader(nil), make([]byte, keySize), huge, 0, -1)
+	assert.Equal(t, ErrInvalidEnvelope, err)
+}
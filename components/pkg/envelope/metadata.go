@@ -0,0 +1,122 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// the keys of the envelope in the metadata of an object
+const (
+	MetaWrappedKey = "layotto-envelope-key"
+	MetaNonce      = "layotto-envelope-nonce"
+	MetaChunkSize  = "layotto-envelope-chunk-size"
+)
+
+// headerMagic starts the header of the envelope written before an encrypted stream
+var headerMagic = []byte("LENV\x01")
+
+// Metadata returns the envelope as the metadata of an object
+func (e *Envelope) Metadata() map[string]string {
+	return map[string]string{
+		MetaWrappedKey: base64.StdEncoding.EncodeToString(e.WrappedKey),
+		MetaNonce:      base64.StdEncoding.EncodeToString(e.Nonce),
+		MetaChunkSize:  strconv.Itoa(e.ChunkSize),
+	}
+}
+
+// IsMetadataKey returns whether the metadata key belongs to the envelope,
+// the object stores may change the case of the keys
+func IsMetadataKey(key string) bool {
+	key = strings.ToLower(key)
+	return key == MetaWrappedKey || key == MetaNonce || key == MetaChunkSize
+}
+
+// FromMetadata reads the envelope from the metadata of an object, it returns false if the object is not encrypted
+func FromMetadata(meta map[string]string) (*Envelope, bool, error) {
+	values := make(map[string]string, 3)
+	for k, v := range meta {
+		if IsMetadataKey(k) {
+			values[strings.ToLower(k)] = v
+		}
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(values[MetaWrappedKey])
+	if err != nil || len(key) == 0 {
+		return nil, true, ErrInvalidEnvelope
+	}
+	nonce, err := base64.StdEncoding.DecodeString(values[MetaNonce])
+	if err != nil || len(nonce) != nonceSize {
+		return nil, true, ErrInvalidEnvelope
+	}
+	chunkSize, err := strconv.Atoi(values[MetaChunkSize])
+	if err != nil || !validChunkSize(chunkSize) {
+		return nil, true, ErrInvalidEnvelope
+	}
+	return &Envelope{WrappedKey: key, Nonce: nonce, ChunkSize: chunkSize}, true, nil
+}
+
+// Header returns the envelope as a header written before the encrypted stream,
+// for the stores not keeping the metadata of the files
+func (e *Envelope) Header() []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, e.HeaderSize()))
+	buf.Write(headerMagic)
+	binary.Write(buf, binary.BigEndian, uint32(e.ChunkSize))
+	buf.Write(e.Nonce)
+	binary.Write(buf, binary.BigEndian, uint16(len(e.WrappedKey)))
+	buf.Write(e.WrappedKey)
+	return buf.Bytes()
+}
+
+// HeaderSize returns the size of the header of the envelope
+func (e *Envelope) HeaderSize() int64 {
+	return int64(len(headerMagic) + 4 + nonceSize + 2 + len(e.WrappedKey))
+}
+
+// ReadHeader reads the header of the envelope from the start of an encrypted stream
+func ReadHeader(r io.Reader) (*Envelope, error) {
+	fixed := make([]byte, len(headerMagic)+4+nonceSize+2)
+	if _, err := io.ReadFull(r, fixed); err != nil {
+		return nil, ErrInvalidEnvelope
+	}
+	if !bytes.Equal(fixed[:len(headerMagic)], headerMagic) {
+		return nil, ErrInvalidEnvelope
+	}
+	fixed = fixed[len(headerMagic):]
+	chunkSize := binary.BigEndian.Uint32(fixed)
+	if chunkSize > MaxChunkSize {
+		return nil, ErrInvalidEnvelope
+	}
+	e := &Envelope{ChunkSize: int(chunkSize)}
+	e.Nonce = append([]byte(nil), fixed[4:4+nonceSize]...)
+	e.WrappedKey = make([]byte, binary.BigEndian.Uint16(fixed[4+nonceSize:]))
+	if _, err := io.ReadFull(r, e.WrappedKey); err != nil || !validChunkSize(e.ChunkSize) {
+		return nil, ErrInvalidEnvelope
+	}
+	return e, nil
+}
+
+func validChunkSize(size int) bool {
+	return size > 0 && size <= MaxChunkSize
+}
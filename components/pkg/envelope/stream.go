@@ -0,0 +1,220 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package envelope
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+)
+
+// the additional data of a chunk tells whether it is the last one, so that a truncated stream is detected
+var (
+	aadChunk     = []byte{0}
+	aadLastChunk = []byte{1}
+)
+
+func chunkAAD(last bool) []byte {
+	if last {
+		return aadLastChunk
+	}
+	return aadChunk
+}
+
+// chunkNonce xors the index of the chunk into the base nonce
+func chunkNonce(base []byte, index uint64) []byte {
+	nonce := make([]byte, len(base))
+	copy(nonce, base)
+	tail := nonce[len(nonce)-8:]
+	binary.BigEndian.PutUint64(tail, binary.BigEndian.Uint64(tail)^index)
+	return nonce
+}
+
+// chunkReader reads the chunks of a stream ahead by one byte, to know whether a chunk is the last one
+type chunkReader struct {
+	src  io.Reader
+	buf  []byte
+	n    int
+	err  error
+	size int
+}
+
+func newChunkReader(src io.Reader, size int) *chunkReader {
+	return &chunkReader{src: src, buf: make([]byte, size+1), size: size}
+}
+
+// next returns the next chunk and whether it is the last one, the chunk is valid until the next call
+func (c *chunkReader) next() ([]byte, bool, error) {
+	if c.n > c.size {
+		// shift the byte read ahead by the previous chunk
+		c.buf[0] = c.buf[c.size]
+		c.n = 1
+	}
+	for c.n <= c.size && c.err == nil {
+		var n int
+		n, c.err = c.src.Read(c.buf[c.n:])
+		c.n += n
+	}
+	if c.err != nil && c.err != io.EOF {
+		return nil, false, c.err
+	}
+	if c.n <= c.size {
+		chunk := c.buf[:c.n]
+		c.n = 0
+		return chunk, true, nil
+	}
+	return c.buf[:c.size], false, nil
+}
+
+type encryptReader struct {
+	chunks *chunkReader
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	out    []byte
+	sealed []byte
+	done   bool
+}
+
+// NewEncryptReader returns a reader encrypting src with the data key of the envelope
+func NewEncryptReader(src io.Reader, key []byte, env *Envelope) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		src = eofReader{}
+	}
+	return &encryptReader{
+		chunks: newChunkReader(src, env.ChunkSize),
+		aead:   aead,
+		nonce:  env.Nonce,
+		sealed: make([]byte, 0, env.ChunkSize+tagSize),
+	}, nil
+}
+
+func (e *encryptReader) Read(p []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		chunk, last, err := e.chunks.next()
+		if err != nil {
+			return 0, err
+		}
+		e.out = e.aead.Seal(e.sealed[:0], chunkNonce(e.nonce, e.index), chunk, chunkAAD(last))
+		e.index++
+		e.done = last
+	}
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+type decryptReader struct {
+	chunks *chunkReader
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	// end is the index after the last chunk to decrypt, 0 for all the chunks
+	end    uint64
+	out    []byte
+	opened []byte
+	done   bool
+}
+
+// NewDecryptReader returns a reader decrypting src with the data key of the envelope.
+// src starts at the chunk first, and count chunks are decrypted, or all of them if count is negative
+func NewDecryptReader(src io.Reader, key []byte, env *Envelope, first, count int64) (io.Reader, error) {
+	if !validChunkSize(env.ChunkSize) || len(env.Nonce) != nonceSize {
+		return nil, ErrInvalidEnvelope
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	d := &decryptReader{
+		chunks: newChunkReader(src, env.ChunkSize+tagSize),
+		aead:   aead,
+		nonce:  env.Nonce,
+		index:  uint64(first),
+		opened: make([]byte, 0, env.ChunkSize),
+	}
+	if count >= 0 {
+		d.end = uint64(first + count)
+	}
+	return d, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		chunk, last, err := d.chunks.next()
+		if err != nil {
+			return 0, err
+		}
+		// an empty chunk means the stream is truncated, even the empty plaintext has a tag
+		if len(chunk) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		d.out, err = d.aead.Open(d.opened[:0], chunkNonce(d.nonce, d.index), chunk, chunkAAD(last))
+		if err != nil {
+			return 0, ErrInvalidEnvelope
+		}
+		d.index++
+		d.done = last || d.index == d.end
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+// Range maps a byte range of the plaintext to the encrypted stream
+type Range struct {
+	// FirstChunk and Chunks are the chunks holding the range, Chunks is -1 when the range is open ended
+	FirstChunk int64
+	Chunks     int64
+	// CipherStart and CipherEnd are the inclusive range of the encrypted stream, CipherEnd is -1 when the range is open ended.
+	// It has one byte more than the chunks to tell whether the last of them is the last chunk of the stream
+	CipherStart int64
+	CipherEnd   int64
+	// Skip is the count of the plaintext bytes before the range in the first chunk
+	Skip int64
+}
+
+// NewRange maps the inclusive plaintext range from start to end, a negative end means the end of the stream
+func NewRange(start, end int64, chunkSize int) Range {
+	size := int64(chunkSize)
+	block := size + tagSize
+	r := Range{FirstChunk: start / size, Chunks: -1, CipherEnd: -1}
+	r.CipherStart = r.FirstChunk * block
+	r.Skip = start - r.FirstChunk*size
+	if end >= 0 {
+		r.Chunks = end/size - r.FirstChunk + 1
+		// the inclusive end of the chunks is one byte before this
+		r.CipherEnd = (r.FirstChunk + r.Chunks) * block
+	}
+	return r
+}
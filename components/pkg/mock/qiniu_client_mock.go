@@ -89,6 +89,20 @@ func (m *MockBucketManager) EXPECT() *MockBucketManagerMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockBucketManager) Copy(srcBucket, srcKey, destBucket, destKey string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", srcBucket, srcKey, destBucket, destKey, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockBucketManagerMockRecorder) Copy(srcBucket, srcKey, destBucket, destKey, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockBucketManager)(nil).Copy), srcBucket, srcKey, destBucket, destKey, force)
+}
+
 // Delete mocks base method.
 func (m *MockBucketManager) Delete(bucket, key string) error {
 	m.ctrl.T.Helper()
@@ -121,6 +135,20 @@ func (mr *MockBucketManagerMockRecorder) ListFiles(bucket, prefix, delimiter, ma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockBucketManager)(nil).ListFiles), bucket, prefix, delimiter, marker, limit)
 }
 
+// Move mocks base method.
+func (m *MockBucketManager) Move(srcBucket, srcKey, destBucket, destKey string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", srcBucket, srcKey, destBucket, destKey, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockBucketManagerMockRecorder) Move(srcBucket, srcKey, destBucket, destKey, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockBucketManager)(nil).Move), srcBucket, srcKey, destBucket, destKey, force)
+}
+
 // Stat mocks base method.
 func (m *MockBucketManager) Stat(bucket, key string) (storage.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -36,4 +36,6 @@ type SecretRefConfig struct {
 type ComponentRefConfig struct {
 	SecretStore string `json:"secret_store"`
 	ConfigStore string `json:"config_store"`
+	// CryptionService is the name of the cryption service injected into the components encrypting their data
+	CryptionService string `json:"cryption_service"`
 }
@@ -18,6 +18,7 @@ package callback
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"mosn.io/pkg/log"
 
@@ -69,17 +70,19 @@ func NewCallback() rpc.Callback {
 type callback struct {
 	beforeInvoke []func(*rpc.RPCRequest) (*rpc.RPCRequest, error)
 	afterInvoke  []func(*rpc.RPCResponse) (*rpc.RPCResponse, error)
+	// err is the first error of the funcs failed to be added, it is reported by Validate
+	err error
 }
 
 // AddBeforeInvoke is add beforeInvoke into callback.beforeInvoke
 func (c *callback) AddBeforeInvoke(conf rpc.CallbackFunc) {
 	f, ok := beforeInvokeRegistry[conf.Name]
 	if !ok {
-		log.DefaultLogger.Errorf("[runtime][rpc]can't find before filter %s", conf.Name)
+		c.fail(fmt.Errorf("can't find before filter %s", conf.Name))
 		return
 	}
 	if err := f.Init(conf.Config); err != nil {
-		log.DefaultLogger.Errorf("[runtime][rpc]init before filter err %s", err.Error())
+		c.fail(fmt.Errorf("init before filter %s err: %v", conf.Name, err))
 		return
 	}
 	c.beforeInvoke = append(c.beforeInvoke, f.Create())
@@ -89,16 +92,28 @@ func (c *callback) AddBeforeInvoke(conf rpc.CallbackFunc) {
 func (c *callback) AddAfterInvoke(conf rpc.CallbackFunc) {
 	f, ok := afterInvokeRegistry[conf.Name]
 	if !ok {
-		log.DefaultLogger.Errorf("[runtime][rpc]can't find after filter %s", conf.Name)
+		c.fail(fmt.Errorf("can't find after filter %s", conf.Name))
 		return
 	}
 	if err := f.Init(conf.Config); err != nil {
-		log.DefaultLogger.Errorf("[runtime][rpc]init after filter err %s", err.Error())
+		c.fail(fmt.Errorf("init after filter %s err: %v", conf.Name, err))
 		return
 	}
 	c.afterInvoke = append(c.afterInvoke, f.Create())
 }
 
+func (c *callback) fail(err error) {
+	log.DefaultLogger.Errorf("[runtime][rpc]%s", err.Error())
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// Validate implements rpc.CallbackValidator
+func (c *callback) Validate() error {
+	return c.err
+}
+
 // BeforeInvoke is used to invoke beforeInvoke callbacks
 func (c *callback) BeforeInvoke(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
 	var err error
@@ -76,4 +76,18 @@ func TestCallback(t *testing.T) {
 	resp := &rpc.RPCResponse{}
 	cb.AfterInvoke(resp)
 	assert.Equal(t, "after", string(resp.Data))
+	assert.Nil(t, cb.(rpc.CallbackValidator).Validate())
+}
+
+func TestCallbackError(t *testing.T) {
+	for _, add := range []func(cb rpc.Callback){
+		func(cb rpc.Callback) { cb.AddBeforeInvoke(rpc.CallbackFunc{Name: "unknown"}) },
+		func(cb rpc.Callback) { cb.AddAfterInvoke(rpc.CallbackFunc{Name: "unknown"}) },
+		// the config of hmac_sign misses the secret
+		func(cb rpc.Callback) { cb.AddBeforeInvoke(rpc.CallbackFunc{Name: "hmac_sign", Config: []byte(`{}`)}) },
+	} {
+		cb := NewCallback()
+		add(cb)
+		assert.Error(t, cb.(rpc.CallbackValidator).Validate())
+	}
 }
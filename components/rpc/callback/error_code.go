@@ -0,0 +1,147 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+var errorCodes = map[string]int{
+	"timeout":           common.TimeoutCode,
+	"unavailable":       common.UnavailebleCode,
+	"internal":          common.InternalCode,
+	"invalid_argument":  common.InvalidArgsCode,
+	"permission_denied": common.PermissionDeniedCode,
+}
+
+func init() {
+	RegisterAfterInvoke(&errorCodeFactory{})
+}
+
+// errorCodeConfig is the config of mapping the error codes of the services to RPCResponse.Error.
+// The code is read from the header, or the json field of the response data.
+type errorCodeConfig struct {
+	Header string `json:"header"`
+	// Field is the path of the code in the response data separated by dots, e.g. "result.code"
+	Field string `json:"field"`
+	// MessageField is the path of the error message in the response data
+	MessageField string   `json:"message_field"`
+	SuccessCodes []string `json:"success_codes"`
+	// Mapping maps the codes to one of timeout, unavailable, internal, invalid_argument and permission_denied, internal by default
+	Mapping map[string]string `json:"mapping"`
+}
+
+// errorCodeFactory is AfterFactory implement, it sets RPCResponse.Error if the code is not a success code
+type errorCodeFactory struct {
+	config errorCodeConfig
+}
+
+func (f *errorCodeFactory) Name() string {
+	return "error_code_mapping"
+}
+
+func (f *errorCodeFactory) Init(data json.RawMessage) error {
+	f.config = errorCodeConfig{}
+	if err := json.Unmarshal(data, &f.config); err != nil {
+		return err
+	}
+	if f.config.Header == "" && f.config.Field == "" {
+		return errors.New("missing header or field of error_code_mapping")
+	}
+	if len(f.config.SuccessCodes) == 0 {
+		return errors.New("missing success_codes of error_code_mapping")
+	}
+	for code, name := range f.config.Mapping {
+		if _, ok := errorCodes[name]; !ok {
+			return fmt.Errorf("unknown error code %s of %s", name, code)
+		}
+	}
+	return nil
+}
+
+func (f *errorCodeFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	config := f.config
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		var body map[string]interface{}
+		if config.Field != "" || config.MessageField != "" {
+			decoder := json.NewDecoder(bytes.NewReader(response.Data))
+			decoder.UseNumber()
+			// the data not in json is considered successful
+			if err := decoder.Decode(&body); err != nil {
+				body = nil
+			}
+		}
+		code := ""
+		if config.Header != "" {
+			code = response.Header.Get(config.Header)
+		}
+		if code == "" && config.Field != "" {
+			code = lookupField(body, config.Field)
+		}
+		if code == "" {
+			return response, nil
+		}
+		for _, success := range config.SuccessCodes {
+			if code == success {
+				return response, nil
+			}
+		}
+		errorCode, ok := errorCodes[config.Mapping[code]]
+		if !ok {
+			errorCode = common.InternalCode
+		}
+		msg := lookupField(body, config.MessageField)
+		if msg == "" {
+			msg = "service responses error code " + code
+		}
+		response.Success = false
+		response.Error = common.Error(errorCode, msg)
+		return response, nil
+	}
+}
+
+// lookupField returns the field at the path as a string
+func lookupField(body map[string]interface{}, path string) string {
+	if body == nil || path == "" {
+		return ""
+	}
+	var node interface{} = body
+	for _, field := range strings.Split(path, ".") {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		if node, ok = obj[field]; !ok {
+			return ""
+		}
+	}
+	switch v := node.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(node)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func TestErrorCodeMapping(t *testing.T) {
+	f := &errorCodeFactory{}
+	assert.Error(t, f.Init([]byte(`{}`)))
+	assert.Error(t, f.Init([]byte(`{"field":"code"}`)))
+	assert.Error(t, f.Init([]byte(`{"field":"code","success_codes":["0"],"mapping":{"1":"unknown"}}`)))
+	assert.Nil(t, f.Init([]byte(`{
+		"header":"x-error-code",
+		"field":"result.code",
+		"message_field":"result.msg",
+		"success_codes":["0"],
+		"mapping":{"400":"invalid_argument","503":"unavailable"}
+	}`)))
+	mapping := f.Create()
+
+	resp, err := mapping(&rpc.RPCResponse{Data: []byte(`{"result":{"code":0}}`)})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Error)
+
+	resp, err = mapping(&rpc.RPCResponse{Data: []byte(`{"result":{"code":400,"msg":"bad name"}}`)})
+	assert.Nil(t, err)
+	assert.False(t, resp.Success)
+	e := resp.Error.(common.CommonError)
+	assert.Equal(t, common.InvalidArgsCode, e.Code())
+	assert.Equal(t, "bad name", e.Msg())
+
+	resp, err = mapping(&rpc.RPCResponse{Header: rpc.RPCHeader{"x-error-code": {"500"}}, Data: []byte("not json")})
+	assert.Nil(t, err)
+	e = resp.Error.(common.CommonError)
+	assert.Equal(t, common.InternalCode, e.Code())
+	assert.Equal(t, "service responses error code 500", e.Msg())
+
+	// no code
+	resp, err = mapping(&rpc.RPCResponse{Data: []byte("not json")})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Error)
+}
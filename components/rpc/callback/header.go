@@ -0,0 +1,130 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"encoding/json"
+	"strings"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func init() {
+	RegisterBeforeInvoke(&headerBeforeFactory{})
+	RegisterAfterInvoke(&headerAfterFactory{})
+}
+
+// headerConfig is the rules of changing the headers, which are applied in the order of rename, remove and add
+type headerConfig struct {
+	// Rename renames the headers from the keys to the values
+	Rename map[string]string `json:"rename"`
+	Remove []string          `json:"remove"`
+	// Add sets the headers, the existing values are replaced
+	Add map[string]string `json:"add"`
+}
+
+// apply changes the header according to the rules
+func (c *headerConfig) apply(header rpc.RPCHeader) rpc.RPCHeader {
+	if header == nil {
+		header = rpc.RPCHeader{}
+	}
+	for from, to := range c.Rename {
+		if v, ok := lookupHeader(header, from); ok {
+			deleteHeader(header, from)
+			header[to] = v
+		}
+	}
+	for _, k := range c.Remove {
+		deleteHeader(header, k)
+	}
+	for k, v := range c.Add {
+		deleteHeader(header, k)
+		header[k] = []string{v}
+	}
+	return header
+}
+
+// lookupHeader gets the header case-insensitively
+func lookupHeader(header rpc.RPCHeader, key string) ([]string, bool) {
+	if v, ok := header[key]; ok {
+		return v, true
+	}
+	for k, v := range header {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// deleteHeader deletes the header case-insensitively
+func deleteHeader(header rpc.RPCHeader, key string) {
+	for k := range header {
+		if strings.EqualFold(k, key) {
+			delete(header, k)
+		}
+	}
+}
+
+// headerBeforeFactory is BeforeFactory implement, it changes the headers of the requests
+type headerBeforeFactory struct {
+	config headerConfig
+}
+
+func (h *headerBeforeFactory) Name() string {
+	return "header_mapping"
+}
+
+func (h *headerBeforeFactory) Init(data json.RawMessage) error {
+	h.config = headerConfig{}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &h.config)
+}
+
+func (h *headerBeforeFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	config := h.config
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		request.Header = config.apply(request.Header)
+		return request, nil
+	}
+}
+
+// headerAfterFactory is AfterFactory implement, it changes the headers of the responses
+type headerAfterFactory struct {
+	config headerConfig
+}
+
+func (h *headerAfterFactory) Name() string {
+	return "header_mapping"
+}
+
+func (h *headerAfterFactory) Init(data json.RawMessage) error {
+	h.config = headerConfig{}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &h.config)
+}
+
+func (h *headerAfterFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	config := h.config
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		response.Header = config.apply(response.Header)
+		return response, nil
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func TestHeaderMapping(t *testing.T) {
+	cb := NewCallback()
+	cb.AddBeforeInvoke(rpc.CallbackFunc{
+		Name:   "header_mapping",
+		Config: []byte(`{"rename":{"X-User":"x-caller"},"remove":["cookie"],"add":{"x-source":"layotto"}}`),
+	})
+	// chained with another config of the same factory
+	cb.AddBeforeInvoke(rpc.CallbackFunc{
+		Name:   "header_mapping",
+		Config: []byte(`{"add":{"x-chain":"2"}}`),
+	})
+	req, err := cb.BeforeInvoke(&rpc.RPCRequest{Header: rpc.RPCHeader{
+		"x-user": {"alice"},
+		"Cookie": {"a=b"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, rpc.RPCHeader{
+		"x-caller": {"alice"},
+		"x-source": {"layotto"},
+		"x-chain":  {"2"},
+	}, req.Header)
+
+	cb.AddAfterInvoke(rpc.CallbackFunc{
+		Name:   "header_mapping",
+		Config: []byte(`{"remove":["content-length"]}`),
+	})
+	resp, err := cb.AfterInvoke(&rpc.RPCResponse{Header: rpc.RPCHeader{"Content-Length": {"1"}, "a": {"b"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, rpc.RPCHeader{"a": {"b"}}, resp.Header)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	hessian "github.com/apache/dubbo-go-hessian2"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/internal/hessianjson"
+)
+
+const (
+	jsonContentType    = "application/json"
+	hessianContentType = "application/x-hessian"
+)
+
+func init() {
+	RegisterBeforeInvoke(&jsonToHessianFactory{})
+	RegisterAfterInvoke(&hessianToJSONFactory{})
+}
+
+// hessianConfig is the config of converting the payloads between json and hessian2
+type hessianConfig struct {
+	// Multiple converts a json array to a sequence of hessian values and vice versa, e.g. the arguments of dubbo
+	Multiple bool `json:"multiple"`
+}
+
+func (c *hessianConfig) init(data json.RawMessage) error {
+	*c = hessianConfig{}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, c)
+}
+
+// jsonToHessianFactory is BeforeFactory implement, it converts the json request data to hessian2
+type jsonToHessianFactory struct {
+	config hessianConfig
+}
+
+func (f *jsonToHessianFactory) Name() string {
+	return "json_to_hessian"
+}
+
+func (f *jsonToHessianFactory) Init(data json.RawMessage) error {
+	return f.config.init(data)
+}
+
+func (f *jsonToHessianFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	config := f.config
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		data, err := jsonToHessian(request.Data, config.Multiple)
+		if err != nil {
+			return nil, err
+		}
+		request.Data = data
+		request.ContentType = hessianContentType
+		return request, nil
+	}
+}
+
+// hessianToJSONFactory is AfterFactory implement, it converts the hessian2 response data to json
+type hessianToJSONFactory struct {
+	config hessianConfig
+}
+
+func (f *hessianToJSONFactory) Name() string {
+	return "hessian_to_json"
+}
+
+func (f *hessianToJSONFactory) Init(data json.RawMessage) error {
+	return f.config.init(data)
+}
+
+func (f *hessianToJSONFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	config := f.config
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		data, err := hessianToJSON(response.Data, config.Multiple)
+		if err != nil {
+			return nil, err
+		}
+		response.Data = data
+		response.ContentType = jsonContentType
+		return response, nil
+	}
+}
+
+func jsonToHessian(data []byte, multiple bool) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	values := []interface{}{v}
+	if multiple {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("json array is required to convert to multiple hessian values")
+		}
+		values = arr
+	}
+	encoder := hessian.NewEncoder()
+	for _, value := range values {
+		if err := encoder.Encode(hessianjson.FromJSONValue(value)); err != nil {
+			return nil, err
+		}
+	}
+	return encoder.Buffer(), nil
+}
+
+func hessianToJSON(data []byte, multiple bool) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	decoder := hessian.NewDecoder(data)
+	values := make([]interface{}, 0)
+	for {
+		v, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, hessianjson.ToJSONValue(v))
+		if !multiple {
+			break
+		}
+	}
+	if multiple {
+		return json.Marshal(values)
+	}
+	if len(values) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return json.Marshal(values[0])
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"testing"
+
+	hessian "github.com/apache/dubbo-go-hessian2"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func TestHessianConversion(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		before := &jsonToHessianFactory{}
+		assert.Nil(t, before.Init(nil))
+		req, err := before.Create()(&rpc.RPCRequest{Data: []byte(`{"name":"layotto","age":3,"score":1.5,"tags":["a"]}`)})
+		assert.Nil(t, err)
+		assert.Equal(t, hessianContentType, req.ContentType)
+
+		after := &hessianToJSONFactory{}
+		assert.Nil(t, after.Init(nil))
+		resp, err := after.Create()(&rpc.RPCResponse{Data: req.Data})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"name":"layotto","age":3,"score":1.5,"tags":["a"]}`, string(resp.Data))
+		assert.Equal(t, jsonContentType, resp.ContentType)
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		before := &jsonToHessianFactory{}
+		assert.Nil(t, before.Init([]byte(`{"multiple":true}`)))
+		req, err := before.Create()(&rpc.RPCRequest{Data: []byte(`["hello",1]`)})
+		assert.Nil(t, err)
+
+		decoder := hessian.NewDecoder(req.Data)
+		v, err := decoder.Decode()
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", v)
+		v, err = decoder.Decode()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), v)
+
+		after := &hessianToJSONFactory{}
+		assert.Nil(t, after.Init([]byte(`{"multiple":true}`)))
+		resp, err := after.Create()(&rpc.RPCResponse{Data: req.Data})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `["hello",1]`, string(resp.Data))
+
+		_, err = before.Create()(&rpc.RPCRequest{Data: []byte(`{}`)})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	defaultJWTHeader       = "authorization"
+	defaultJWTExpiresInSec = 300
+	defaultSignatureHeader = "x-signature"
+	defaultTimestampHeader = "x-timestamp"
+)
+
+// now is replaced in the tests
+var now = time.Now
+
+func init() {
+	RegisterBeforeInvoke(&jwtFactory{})
+	RegisterBeforeInvoke(&hmacFactory{})
+}
+
+// jwtConfig is the config of signing a HS256 JWT for the requests.
+// The secret can be injected from a secret store with secret_ref, e.g. inject_as "before_invoke.0.config.secret".
+type jwtConfig struct {
+	Secret       string                 `json:"secret"`
+	Issuer       string                 `json:"issuer"`
+	ExpiresInSec int64                  `json:"expires_in_sec"`
+	Claims       map[string]interface{} `json:"claims"`
+	// Header is the header carrying "Bearer {token}", authorization by default
+	Header string `json:"header"`
+}
+
+// jwtFactory is BeforeFactory implement, it puts a JWT into the requests
+type jwtFactory struct {
+	config jwtConfig
+}
+
+func (j *jwtFactory) Name() string {
+	return "jwt_auth"
+}
+
+func (j *jwtFactory) Init(data json.RawMessage) error {
+	j.config = jwtConfig{}
+	if err := json.Unmarshal(data, &j.config); err != nil {
+		return err
+	}
+	if j.config.Secret == "" {
+		return errors.New("missing secret of jwt_auth")
+	}
+	if j.config.Header == "" {
+		j.config.Header = defaultJWTHeader
+	}
+	if j.config.ExpiresInSec <= 0 {
+		j.config.ExpiresInSec = defaultJWTExpiresInSec
+	}
+	return nil
+}
+
+func (j *jwtFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	config := j.config
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		token, err := signJWT(&config, request)
+		if err != nil {
+			return nil, err
+		}
+		if request.Header == nil {
+			request.Header = rpc.RPCHeader{}
+		}
+		request.Header[config.Header] = []string{"Bearer " + token}
+		return request, nil
+	}
+}
+
+// signJWT signs a token whose subject is the service id
+func signJWT(config *jwtConfig, request *rpc.RPCRequest) (string, error) {
+	iat := now().Unix()
+	claims := make(map[string]interface{}, len(config.Claims)+4)
+	for k, v := range config.Claims {
+		claims[k] = v
+	}
+	claims["sub"] = request.Id
+	claims["iat"] = iat
+	claims["exp"] = iat + config.ExpiresInSec
+	if config.Issuer != "" {
+		claims["iss"] = config.Issuer
+	}
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(config.Secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// hmacConfig is the config of signing the requests with HMAC-SHA256.
+// The signature is hex(hmac(secret, "{id}\n{method}\n{timestamp}\n{data}")).
+// The callbacks run before the channel encodes the payload, so {data} is the data sent by the app.
+// For the protocols re-encoding the payload, e.g. the json arguments of dubbo encoded to hessian2,
+// the server can't verify the signature over the bytes it receives, the signature has to be verified
+// over the decoded arguments or the payload has to be sent as is, i.e. without the json content type.
+type hmacConfig struct {
+	Secret          string `json:"secret"`
+	SignatureHeader string `json:"signature_header"`
+	TimestampHeader string `json:"timestamp_header"`
+}
+
+// hmacFactory is BeforeFactory implement, it puts the signature and the timestamp into the requests
+type hmacFactory struct {
+	config hmacConfig
+}
+
+func (h *hmacFactory) Name() string {
+	return "hmac_sign"
+}
+
+func (h *hmacFactory) Init(data json.RawMessage) error {
+	h.config = hmacConfig{}
+	if err := json.Unmarshal(data, &h.config); err != nil {
+		return err
+	}
+	if h.config.Secret == "" {
+		return errors.New("missing secret of hmac_sign")
+	}
+	if h.config.SignatureHeader == "" {
+		h.config.SignatureHeader = defaultSignatureHeader
+	}
+	if h.config.TimestampHeader == "" {
+		h.config.TimestampHeader = defaultTimestampHeader
+	}
+	return nil
+}
+
+func (h *hmacFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	config := h.config
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		timestamp := strconv.FormatInt(now().UnixMilli(), 10)
+		if request.Header == nil {
+			request.Header = rpc.RPCHeader{}
+		}
+		request.Header[config.TimestampHeader] = []string{timestamp}
+		request.Header[config.SignatureHeader] = []string{hmacSign(config.Secret, request, timestamp)}
+		return request, nil
+	}
+}
+
+func hmacSign(secret string, request *rpc.RPCRequest, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(request.Id + "\n" + request.Method + "\n" + timestamp + "\n"))
+	mac.Write(request.Data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func TestJWTAuth(t *testing.T) {
+	now = func() time.Time { return time.Unix(1000, 0) }
+	defer func() { now = time.Now }()
+
+	f := &jwtFactory{}
+	assert.Error(t, f.Init([]byte(`{}`)))
+	assert.Nil(t, f.Init([]byte(`{"secret":"secret","issuer":"layotto","claims":{"scope":"rpc"}}`)))
+	req, err := f.Create()(&rpc.RPCRequest{Id: "hello", Header: rpc.RPCHeader{}})
+	assert.Nil(t, err)
+
+	token := strings.TrimPrefix(req.Header.Get("authorization"), "Bearer ")
+	parts := strings.Split(token, ".")
+	assert.Equal(t, 3, len(parts))
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.Nil(t, err)
+	claims := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal(payload, &claims))
+	assert.Equal(t, map[string]interface{}{
+		"sub": "hello", "iss": "layotto", "scope": "rpc", "iat": float64(1000), "exp": float64(1300),
+	}, claims)
+}
+
+func TestHMACSign(t *testing.T) {
+	now = func() time.Time { return time.UnixMilli(1000) }
+	defer func() { now = time.Now }()
+
+	f := &hmacFactory{}
+	assert.Error(t, f.Init([]byte(`{}`)))
+	assert.Nil(t, f.Init([]byte(`{"secret":"secret"}`)))
+	req, err := f.Create()(&rpc.RPCRequest{Id: "hello", Method: "say", Data: []byte("data")})
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", req.Header.Get("x-timestamp"))
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("hello\nsay\n1000\ndata"))
+	assert.Equal(t, hmacSign("secret", req, "1000"), req.Header.Get("x-signature"))
+	assert.Equal(t, 64, len(req.Header.Get("x-signature")))
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package hessianjson converts the values between json and hessian2
+package hessianjson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FromJSONValue converts the json numbers decoded with UseNumber to int64 or float64, which hessian2 supports
+func FromJSONValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	case []interface{}:
+		for i := range value {
+			value[i] = FromJSONValue(value[i])
+		}
+	case map[string]interface{}:
+		for k := range value {
+			value[k] = FromJSONValue(value[k])
+		}
+	}
+	return v
+}
+
+// ToJSONValue converts the maps decoded by hessian2 to the ones json supports
+func ToJSONValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		res := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			res[fmt.Sprint(k)] = ToJSONValue(item)
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			res[k] = ToJSONValue(item)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(value))
+		for i, item := range value {
+			res[i] = ToJSONValue(item)
+		}
+		return res
+	}
+	return v
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"fmt"
+	"path"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	aclAllow = "allow"
+	aclDeny  = "deny"
+	// anyCaller in the callers of a policy matches all the callers
+	anyCaller = "*"
+)
+
+// aclConfig is the egress policy of the service invocation, it limits the services and methods the apps calling this runtime may invoke.
+// The caller app id is set by the runtime: it is the identity of the client certificate if the grpc server of the runtime
+// authenticates the apps by mutual TLS, otherwise the app id of the runtime.
+// A request is checked against the policies in order, the first matched policy decides whether it is allowed,
+// and the default action is applied if none matches.
+type aclConfig struct {
+	// DefaultAction is "allow" or "deny", it defaults to "deny"
+	DefaultAction string      `json:"default_action"`
+	Policies      []aclPolicy `json:"policies"`
+}
+
+// aclPolicy matches a request whose caller app id is one of Callers, and whose target service and method match the patterns.
+// Callers are the app ids of the callers, or "*" for all of them.
+// Service and Methods are patterns in the syntax of path.Match, the empty ones match all the services or methods.
+type aclPolicy struct {
+	Callers []string `json:"callers"`
+	Service string   `json:"service"`
+	Methods []string `json:"methods"`
+	// Action is "allow" or "deny", it defaults to "allow"
+	Action string `json:"action"`
+}
+
+// match checks whether the request of the caller matches the policy
+func (p *aclPolicy) match(caller string, req *rpc.RPCRequest) bool {
+	if !p.matchCaller(caller) {
+		return false
+	}
+	if p.Service != "" {
+		if ok, _ := path.Match(p.Service, req.Id); !ok {
+			return false
+		}
+	}
+	if len(p.Methods) == 0 {
+		return true
+	}
+	for _, m := range p.Methods {
+		if ok, _ := path.Match(m, req.Method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *aclPolicy) matchCaller(caller string) bool {
+	for _, c := range p.Callers {
+		if c == anyCaller || c == caller {
+			return true
+		}
+	}
+	return false
+}
+
+// accessControl enforces the egress policy, a nil accessControl allows all the requests
+type accessControl struct {
+	policies []aclPolicy
+	allow    bool
+}
+
+func newAccessControl(config *aclConfig) (*accessControl, error) {
+	if config == nil {
+		return nil, nil
+	}
+	a := &accessControl{}
+	switch config.DefaultAction {
+	case "", aclDeny:
+	case aclAllow:
+		a.allow = true
+	default:
+		return nil, fmt.Errorf("invalid access control default action %s", config.DefaultAction)
+	}
+	for _, p := range config.Policies {
+		switch p.Action {
+		case "":
+			p.Action = aclAllow
+		case aclAllow, aclDeny:
+		default:
+			return nil, fmt.Errorf("invalid access control action %s", p.Action)
+		}
+		if len(p.Callers) == 0 {
+			return nil, fmt.Errorf("access control policy of %s has no callers", p.Service)
+		}
+		if err := validPattern(p.Service); err != nil {
+			return nil, err
+		}
+		for _, m := range p.Methods {
+			if err := validPattern(m); err != nil {
+				return nil, err
+			}
+		}
+		a.policies = append(a.policies, p)
+	}
+	return a, nil
+}
+
+// check returns a PermissionDenied error if the caller of the request is not allowed to invoke the target service and method.
+// The caller is identified by the rpc.CallerAppId header, which is set by the runtime rather than the application,
+// see grpc.CallerAppId of the runtime.
+func (a *accessControl) check(req *rpc.RPCRequest) error {
+	if a == nil {
+		return nil
+	}
+	caller := req.Header.Get(rpc.CallerAppId)
+	allow := a.allow
+	for i := range a.policies {
+		if a.policies[i].match(caller, req) {
+			allow = a.policies[i].Action == aclAllow
+			break
+		}
+	}
+	if !allow {
+		return common.Errorf(common.PermissionDeniedCode, "caller %s is not allowed to invoke %s %s", caller, req.Id, req.Method)
+	}
+	return nil
+}
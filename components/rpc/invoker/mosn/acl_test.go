@@ -0,0 +1,88 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_mosnInvoker_AccessControl(t *testing.T) {
+	registNamedChannels()
+	invoker := NewMosnInvoker()
+	conf := rpc.RpcConfig{
+		Config: []byte(`{
+			"channel": [{"name":"bolt","protocol":"fake_bolt"}],
+			"access_control": {
+				"policies": [
+					{"callers":["order"],"service":"com.alipay.PayService","methods":["refund*"],"action":"deny"},
+					{"callers":["order","cart"],"service":"com.alipay.PayService"},
+					{"callers":["*"],"service":"com.alipay.PublicService","methods":["get*"]}
+				]
+			}
+		}`),
+	}
+	assert.Nil(t, invoker.Init(conf))
+
+	invoke := func(caller, id, method string) error {
+		header := rpc.RPCHeader{}
+		if caller != "" {
+			header[rpc.CallerAppId] = []string{caller}
+		}
+		_, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: id, Method: method, Header: header})
+		return err
+	}
+	assert.Nil(t, invoke("order", "com.alipay.PayService", "pay"))
+	assert.Nil(t, invoke("cart", "com.alipay.PayService", "refund"))
+	assert.Nil(t, invoke("user", "com.alipay.PublicService", "getName"))
+	assert.Nil(t, invoke("", "com.alipay.PublicService", "getName"))
+
+	err := invoke("order", "com.alipay.PayService", "refundAll")
+	assert.Equal(t, common.PermissionDeniedCode, err.(common.CommonError).Code())
+	// default deny
+	assert.Error(t, invoke("user", "com.alipay.PayService", "pay"))
+	assert.Error(t, invoke("user", "com.alipay.PublicService", "setName"))
+	assert.Error(t, invoke("", "com.alipay.OtherService", "get"))
+}
+
+func Test_newAccessControl(t *testing.T) {
+	acl, err := newAccessControl(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, acl.check(&rpc.RPCRequest{Id: "foo"}))
+
+	acl, err = newAccessControl(&aclConfig{
+		DefaultAction: aclAllow,
+		Policies:      []aclPolicy{{Callers: []string{"*"}, Service: "secret", Action: aclDeny}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, acl.check(&rpc.RPCRequest{Id: "foo"}))
+	assert.Error(t, acl.check(&rpc.RPCRequest{Id: "secret"}))
+
+	_, err = newAccessControl(&aclConfig{DefaultAction: "reject"})
+	assert.Error(t, err)
+	_, err = newAccessControl(&aclConfig{Policies: []aclPolicy{{Callers: []string{"*"}, Action: "reject"}}})
+	assert.Error(t, err)
+	_, err = newAccessControl(&aclConfig{Policies: []aclPolicy{{Service: "foo"}}})
+	assert.Error(t, err)
+	_, err = newAccessControl(&aclConfig{Policies: []aclPolicy{{Callers: []string{"*"}, Methods: []string{"["}}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerOpenTimeoutMs    = 30000
+	defaultBreakerHalfOpenRequests = 1
+	// breakerIdleTimeout evicts the breakers of the targets which are not requested any more
+	breakerIdleTimeout = 10 * time.Minute
+)
+
+type breakerState string
+
+const (
+	breakerClosed   breakerState = "closed"
+	breakerOpen     breakerState = "open"
+	breakerHalfOpen breakerState = "half_open"
+)
+
+// breakerConfig is the circuit breaker config, the breaker opens after FailureThreshold consecutive failures,
+// and lets HalfOpenRequests probing requests through after OpenTimeoutMs.
+type breakerConfig struct {
+	FailureThreshold int `json:"failure_threshold"`
+	OpenTimeoutMs    int `json:"open_timeout_ms"`
+	HalfOpenRequests int `json:"half_open_requests"`
+}
+
+// breaker is the circuit breaker of a target
+type breaker struct {
+	mu       sync.Mutex
+	config   breakerConfig
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  int
+	lastUsed time.Time
+	now      func() time.Time
+}
+
+// allow checks whether a request can be sent to the target
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastUsed = b.now()
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < time.Duration(b.config.OpenTimeoutMs)*time.Millisecond {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = 0
+		fallthrough
+	case breakerHalfOpen:
+		if b.probing >= b.config.HalfOpenRequests {
+			return false
+		}
+		b.probing++
+	}
+	return true
+}
+
+// done records the result of a request allowed by the breaker
+func (b *breaker) done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastUsed = b.now()
+	if success {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.config.FailureThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+func (b *breaker) getState() breakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// idle checks whether the breaker is unused longer than the idle timeout, and would let requests through.
+// Dropping such a breaker loses nothing, since a new one is created for the next request.
+func (b *breaker) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.Sub(b.lastUsed) <= breakerIdleTimeout {
+		return false
+	}
+	return b.state != breakerOpen || now.Sub(b.openedAt) >= time.Duration(b.config.OpenTimeoutMs)*time.Millisecond
+}
+
+// breakerGroup holds the circuit breakers of the targets. A nil breakerGroup allows all requests.
+// The breakers unused longer than breakerIdleTimeout are evicted.
+type breakerGroup struct {
+	mu        sync.Mutex
+	config    breakerConfig
+	breakers  map[string]*breaker
+	lastEvict time.Time
+	now       func() time.Time
+}
+
+func newBreakerGroup(config *breakerConfig) *breakerGroup {
+	if config == nil {
+		return nil
+	}
+	c := *config
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaultBreakerFailureThreshold
+	}
+	if c.OpenTimeoutMs <= 0 {
+		c.OpenTimeoutMs = defaultBreakerOpenTimeoutMs
+	}
+	if c.HalfOpenRequests <= 0 {
+		c.HalfOpenRequests = defaultBreakerHalfOpenRequests
+	}
+	return &breakerGroup{config: c, breakers: make(map[string]*breaker), now: time.Now}
+}
+
+// get returns the breaker of the target, it returns nil if the group is nil
+func (g *breakerGroup) get(target string) *breaker {
+	if g == nil {
+		return nil
+	}
+	now := g.now()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.evictIdle(now)
+	b, ok := g.breakers[target]
+	if !ok {
+		b = &breaker{config: g.config, state: breakerClosed, lastUsed: now, now: g.now}
+		g.breakers[target] = b
+	}
+	return b
+}
+
+// evictIdle drops the idle breakers, it must be called with g.mu held.
+// They are checked at most twice in the idle timeout
+func (g *breakerGroup) evictIdle(now time.Time) {
+	if now.Sub(g.lastEvict) < breakerIdleTimeout/2 {
+		return
+	}
+	g.lastEvict = now
+	for target, b := range g.breakers {
+		if b.idle(now) {
+			delete(g.breakers, target)
+		}
+	}
+}
+
+// states returns the states of all the breakers, keyed by the targets
+func (g *breakerGroup) states() map[string]breakerState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	res := make(map[string]breakerState, len(g.breakers))
+	for target, b := range g.breakers {
+		res[target] = b.getState()
+	}
+	return res
+}
+
+// targetOf returns the target of the request, which is the target address if specified or the service id
+func targetOf(req *rpc.RPCRequest) string {
+	if addr := req.Header.Get(rpc.TargetAddress); addr != "" {
+		return addr
+	}
+	return req.Id
+}
+
+// available checks whether the breaker of the target lets requests through without creating one.
+// It returns true if the group is nil.
+func (g *breakerGroup) available(target string) bool {
+	if g == nil {
+		return true
+	}
+	g.mu.Lock()
+	b, ok := g.breakers[target]
+	g.mu.Unlock()
+	if !ok {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state != breakerOpen || b.now().Sub(b.openedAt) >= time.Duration(b.config.OpenTimeoutMs)*time.Millisecond
+}
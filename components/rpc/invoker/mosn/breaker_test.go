@@ -0,0 +1,114 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mosn
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/actuators"
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_breaker(t *testing.T) {
+	now := time.Now()
+	g := newBreakerGroup(&breakerConfig{FailureThreshold: 2, OpenTimeoutMs: 1000})
+	b := g.get("target")
+	b.now = func() time.Time { return now }
+
+	assert.True(t, b.allow())
+	b.done(false)
+	assert.True(t, b.allow())
+	b.done(false)
+	assert.Equal(t, breakerOpen, b.getState())
+	assert.False(t, b.allow())
+
+	// half open, only one probing request is allowed
+	now = now.Add(time.Second)
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+	assert.Equal(t, breakerHalfOpen, b.getState())
+	b.done(false)
+	assert.Equal(t, breakerOpen, b.getState())
+
+	now = now.Add(time.Second)
+	assert.True(t, b.allow())
+	b.done(true)
+	assert.Equal(t, breakerClosed, b.getState())
+	assert.True(t, b.allow())
+}
+
+func Test_mosnInvoker_CircuitBreaker(t *testing.T) {
+	ch := &flakyChannel{failures: 100}
+	invoker := newFlakyInvoker(t, ch, `{"channel":[{"protocol":"flaky"}],"circuit_breaker":{"failure_threshold":2}}`)
+	req := &rpc.RPCRequest{Id: "breaker_test_service", Method: "sayHello", Header: rpc.RPCHeader{}}
+	for i := 0; i < 3; i++ {
+		_, err := invoker.Invoke(context.Background(), req)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 2, ch.calls)
+
+	i := actuators.GetIndicatorWithName(componentName)
+	assert.NotNil(t, i)
+	_, details := i.ReadinessIndicator.Report()
+	assert.Equal(t, breakerOpen, details[breakersKey].(map[string]breakerState)["breaker_test_service"])
+	status, _ := i.LivenessIndicator.Report()
+	assert.Equal(t, actuators.UP, status)
+
+	// the breakers of the closed invoker are not reported
+	assert.Nil(t, invoker.(io.Closer).Close())
+	_, details = i.ReadinessIndicator.Report()
+	assert.NotContains(t, details[breakersKey], "breaker_test_service")
+}
+
+func Test_breakerIndicator(t *testing.T) {
+	i := &breakerIndicator{}
+	status, _ := i.Report()
+	assert.Equal(t, actuators.UP, status)
+
+	// the open breakers are reported in the details only
+	g := newBreakerGroup(&breakerConfig{FailureThreshold: 1})
+	i.register(g)
+	g.get("a").done(false)
+	status, details := i.Report()
+	assert.Equal(t, actuators.UP, status)
+	assert.Equal(t, map[string]breakerState{"a": breakerOpen}, details[breakersKey])
+
+	i.unregister(g)
+	_, details = i.Report()
+	assert.Empty(t, details[breakersKey])
+}
+
+func Test_breakerGroup_evictIdle(t *testing.T) {
+	now := time.Now()
+	g := newBreakerGroup(&breakerConfig{FailureThreshold: 1, OpenTimeoutMs: int(time.Hour / time.Millisecond)})
+	g.now = func() time.Time { return now }
+	g.get("closed").done(true)
+	g.get("open").done(false)
+
+	// the open breaker is kept until it would let requests through
+	now = now.Add(breakerIdleTimeout + time.Second)
+	g.get("new")
+	assert.Equal(t, map[string]breakerState{"open": breakerOpen, "new": breakerClosed}, g.states())
+
+	now = now.Add(time.Hour)
+	g.get("new").done(true)
+	assert.Equal(t, map[string]breakerState{"new": breakerClosed}, g.states())
+}
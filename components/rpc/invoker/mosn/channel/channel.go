@@ -17,6 +17,7 @@
 package channel
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -46,10 +47,22 @@ var (
 
 // ChannelConfig is Channel config
 type ChannelConfig struct {
+	// Name is used by the routing rules to refer to the channel, it defaults to Protocol
+	Name     string                 `json:"name"`
 	Protocol string                 `json:"protocol"`
 	Listener string                 `json:"listener"`
 	Size     int                    `json:"size"`
 	Ext      map[string]interface{} `json:"ext"`
+	// IdleTimeoutMs closes the connections which stay idle in the pool longer than it, 0 means no limit
+	IdleTimeoutMs int `json:"idle_timeout_ms"`
+	// MaxLifetimeMs closes the connections which live longer than it once they are idle, 0 means no limit
+	MaxLifetimeMs int `json:"max_lifetime_ms"`
+	// MinIdle is the number of the connections dialed in advance and kept in the pool
+	MinIdle int `json:"min_idle"`
+	// TLS enables mutual TLS on the direct connections of the channel, i.e. the ones to a listener address like
+	// "127.0.0.1:12220" and to the target addresses. The pipes to a mosn listener are not secured by it,
+	// the mosn listener is local and the TLS with the upstream is configured in mosn instead
+	TLS *TLSConfig `json:"tls"`
 }
 
 // GetChannel creates a rpc.Channel according to config.Protocol
@@ -61,6 +74,14 @@ func GetChannel(config ChannelConfig) (rpc.Channel, error) {
 	return c(config)
 }
 
+// streamContext returns the context of a stream, the stream is not limited by a timeout unless RPCRequest.Timeout is set
+func streamContext(req *rpc.RPCRequest) (context.Context, context.CancelFunc) {
+	if req.Timeout > 0 {
+		return context.WithTimeout(req.Ctx, time.Duration(req.Timeout)*time.Millisecond)
+	}
+	return context.WithCancel(req.Ctx)
+}
+
 // RegistChannel is set protocol
 func RegistChannel(proto string, f func(config ChannelConfig) (rpc.Channel, error)) {
 	registry[proto] = f
@@ -24,6 +24,7 @@ import (
 	"net"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"mosn.io/pkg/buffer"
 	"mosn.io/pkg/log"
@@ -35,10 +36,13 @@ import (
 const (
 	defaultBufSize = 16 * 1024
 	maxBufSize     = 512 * 1024
+
+	defaultMaintainInterval = 30 * time.Second
 )
 
 var (
 	connpoolTimeout = errors.New("connection pool timeout")
+	connpoolClosed  = errors.New("connection pool closed")
 )
 
 // wrapConn is wrap connect
@@ -49,6 +53,12 @@ type wrapConn struct {
 	closed     int32
 	cancelCtx  context.Context
 	cancelFunc context.CancelFunc
+
+	createdAt time.Time
+	// lastUsed and warm are guarded by connPool.mu
+	lastUsed time.Time
+	// warm is true if the conn is dialed by the warmup and has not been handed out yet
+	warm bool
 }
 
 // isClose is checked wrapConn close or not
@@ -88,10 +98,51 @@ func newConnPool(
 		cleanupFunc: cleanupFunc,
 		sema:        make(chan struct{}, maxActive),
 		free:        list.New(),
+		done:        make(chan struct{}),
 	}
 	return p
 }
 
+// poolOptions tunes the lifecycle of the connections in the pool
+type poolOptions struct {
+	// idleTimeout closes the connections which stay idle longer than it
+	idleTimeout time.Duration
+	// maxLifetime closes the connections which are created earlier than it
+	maxLifetime time.Duration
+	// minIdle is the number of the idle connections dialed in advance
+	minIdle int
+}
+
+func newPoolOptions(config ChannelConfig) poolOptions {
+	return poolOptions{
+		idleTimeout: time.Duration(config.IdleTimeoutMs) * time.Millisecond,
+		maxLifetime: time.Duration(config.MaxLifetimeMs) * time.Millisecond,
+		minIdle:     config.MinIdle,
+	}
+}
+
+// maintainInterval returns how often the idle connections are checked
+func (o poolOptions) maintainInterval() time.Duration {
+	interval := defaultMaintainInterval
+	for _, d := range []time.Duration{o.idleTimeout, o.maxLifetime} {
+		if d > 0 && d/2 < interval {
+			interval = d / 2
+		}
+	}
+	return interval
+}
+
+// poolMetrics counts the events of a pool
+type poolMetrics struct {
+	waits             uint64
+	waitTimeouts      uint64
+	waitNanos         int64
+	dials             uint64
+	dialErrors        uint64
+	evictions         uint64
+	heartbeatFailures uint64
+}
+
 // connPool is connected pool
 type connPool struct {
 	maxActive   int
@@ -100,9 +151,57 @@ type connPool struct {
 	onDataFunc  func(*wrapConn) error
 	cleanupFunc func(*wrapConn, error)
 
-	sema chan struct{}
-	mu   sync.Mutex
-	free *list.List
+	sema    chan struct{}
+	mu      sync.Mutex
+	free    *list.List
+	closed  bool
+	done    chan struct{}
+	opts    poolOptions
+	metrics poolMetrics
+}
+
+// configure applies the options and starts a goroutine to evict the expired connections and keep minIdle connections
+func (p *connPool) configure(opts poolOptions) *connPool {
+	p.opts = opts
+	if opts.idleTimeout <= 0 && opts.maxLifetime <= 0 && opts.minIdle <= 0 {
+		return p
+	}
+	utils.GoWithRecover(func() {
+		ticker := time.NewTicker(opts.maintainInterval())
+		defer ticker.Stop()
+		for {
+			p.maintain()
+			select {
+			case <-ticker.C:
+			case <-p.done:
+				return
+			}
+		}
+	}, nil)
+	return p
+}
+
+// close stops the maintenance, closes the idle conns and unregisters the pool.
+// The conns in use are closed when they are put back
+func (p *connPool) close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	idle := make([]*wrapConn, 0, p.free.Len())
+	for ele := p.free.Front(); ele != nil; ele = ele.Next() {
+		idle = append(idle, ele.Value.(*wrapConn))
+	}
+	p.free.Init()
+	p.mu.Unlock()
+
+	for _, wc := range idle {
+		wc.close()
+	}
+	unregisterPool(p)
 }
 
 // Get is get wrapConn by context.Context
@@ -110,28 +209,70 @@ func (p *connPool) Get(ctx context.Context) (*wrapConn, bool, error) {
 	if err := p.waitTurn(ctx); err != nil {
 		return nil, false, err
 	}
-
 	p.mu.Lock()
+	closed := p.closed
+	p.mu.Unlock()
+	if closed {
+		p.freeTurn()
+		return nil, false, common.Error(common.UnavailebleCode, connpoolClosed.Error())
+	}
+
 	// get free conn
-	if ele := p.free.Front(); ele != nil {
+	if wc, isNew := p.popFree(); wc != nil {
+		return wc, isNew, nil
+	}
+
+	// create new conn
+	wc, err := p.newConn()
+	if err != nil {
+		p.freeTurn()
+		return nil, false, err
+	}
+	return wc, true, nil
+}
+
+// popFree returns a usable free conn, the expired conns are closed.
+// The conns dialed by the warmup are reported as new conns
+func (p *connPool) popFree() (*wrapConn, bool) {
+	now := time.Now()
+	var expired []*wrapConn
+	defer func() {
+		for _, wc := range expired {
+			wc.close()
+		}
+	}()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for ele := p.free.Front(); ele != nil; ele = p.free.Front() {
 		p.free.Remove(ele)
-		p.mu.Unlock()
 		wc := ele.Value.(*wrapConn)
-		if !wc.isClose() {
-			return wc, false, nil
+		if wc.isClose() {
+			continue
 		}
-	} else {
-		p.mu.Unlock()
+		if p.expired(wc, now) {
+			atomic.AddUint64(&p.metrics.evictions, 1)
+			expired = append(expired, wc)
+			continue
+		}
+		isNew := wc.warm
+		wc.warm = false
+		return wc, isNew
 	}
+	return nil, false
+}
 
-	// create new conn
+// newConn dials a conn and starts its readloop
+func (p *connPool) newConn() (*wrapConn, error) {
+	atomic.AddUint64(&p.metrics.dials, 1)
 	c, err := p.dialFunc()
 	if err != nil {
-		p.freeTurn()
-		return nil, false, err
+		atomic.AddUint64(&p.metrics.dialErrors, 1)
+		return nil, err
 	}
 	cancelCtx, cancel := context.WithCancel(context.Background())
-	wc := &wrapConn{Conn: c, cancelFunc: cancel, cancelCtx: cancelCtx}
+	now := time.Now()
+	wc := &wrapConn{Conn: c, cancelFunc: cancel, cancelCtx: cancelCtx, createdAt: now, lastUsed: now}
 	if p.stateFunc != nil {
 		wc.state = p.stateFunc()
 	}
@@ -141,7 +282,15 @@ func (p *connPool) Get(ctx context.Context) (*wrapConn, bool, error) {
 			p.readloop(wc)
 		}, nil)
 	}
-	return wc, true, nil
+	return wc, nil
+}
+
+// expired checks whether the idle conn exceeds the idle timeout or the max lifetime, it must be called with p.mu held
+func (p *connPool) expired(wc *wrapConn, now time.Time) bool {
+	if p.opts.idleTimeout > 0 && now.Sub(wc.lastUsed) > p.opts.idleTimeout {
+		return true
+	}
+	return p.opts.maxLifetime > 0 && now.Sub(wc.createdAt) > p.opts.maxLifetime
 }
 
 // Put when connected less than maxActive
@@ -152,8 +301,17 @@ func (p *connPool) Put(c *wrapConn, close bool) {
 		return
 	}
 
+	now := time.Now()
 	p.mu.Lock()
-	if p.free.Len() < p.maxActive {
+	if p.closed {
+		p.mu.Unlock()
+		c.close()
+	} else if p.opts.maxLifetime > 0 && now.Sub(c.createdAt) > p.opts.maxLifetime {
+		p.mu.Unlock()
+		atomic.AddUint64(&p.metrics.evictions, 1)
+		c.close()
+	} else if p.free.Len() < p.maxActive {
+		c.lastUsed = now
 		p.free.PushBack(c)
 		p.mu.Unlock()
 	} else {
@@ -163,6 +321,54 @@ func (p *connPool) Put(c *wrapConn, close bool) {
 	p.freeTurn()
 }
 
+// maintain closes the expired idle conns and dials conns until there are minIdle idle conns
+func (p *connPool) maintain() {
+	now := time.Now()
+	var expired []*wrapConn
+	p.mu.Lock()
+	for ele := p.free.Front(); ele != nil; {
+		next := ele.Next()
+		wc := ele.Value.(*wrapConn)
+		if wc.isClose() {
+			p.free.Remove(ele)
+		} else if p.expired(wc, now) {
+			p.free.Remove(ele)
+			expired = append(expired, wc)
+		}
+		ele = next
+	}
+	lack := p.opts.minIdle - p.free.Len()
+	if max := p.maxActive - p.free.Len() - len(p.sema); lack > max {
+		lack = max
+	}
+	if p.closed {
+		lack = 0
+	}
+	p.mu.Unlock()
+
+	atomic.AddUint64(&p.metrics.evictions, uint64(len(expired)))
+	for _, wc := range expired {
+		wc.close()
+	}
+
+	for i := 0; i < lack; i++ {
+		wc, err := p.newConn()
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime][rpc]connpool warmup err: %s", err.Error())
+			return
+		}
+		wc.warm = true
+		p.mu.Lock()
+		if p.closed || p.free.Len() >= p.maxActive {
+			p.mu.Unlock()
+			wc.close()
+			return
+		}
+		p.free.PushBack(wc)
+		p.mu.Unlock()
+	}
+}
+
 // readloop is loop to read connected then exec onDataFunc
 func (p *connPool) readloop(c *wrapConn) {
 	var err error
@@ -208,8 +414,21 @@ func (p *connPool) readloop(c *wrapConn) {
 }
 
 func (p *connPool) waitTurn(ctx context.Context) error {
+	select {
+	case p.sema <- struct{}{}:
+		return nil
+	default:
+	}
+
+	// all the conns are in use, wait for a free one
+	atomic.AddUint64(&p.metrics.waits, 1)
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&p.metrics.waitNanos, int64(time.Since(start)))
+	}()
 	select {
 	case <-ctx.Done():
+		atomic.AddUint64(&p.metrics.waitTimeouts, 1)
 		return common.Error(common.TimeoutCode, connpoolTimeout.Error())
 	case p.sema <- struct{}{}:
 		return nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"net"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -175,3 +176,171 @@ func TestPoolConcurrent(t *testing.T) {
 	assert.True(t, p.free.Len() <= active)
 	t.Log(p.free.Len())
 }
+
+func newTestPool(active int, dials *int32) *connPool {
+	return newConnPool(
+		active,
+		func() (net.Conn, error) {
+			atomic.AddInt32(dials, 1)
+			p, _ := net.Pipe()
+			return &fakeTcpConn{c: p}, nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func TestPoolIdleTimeout(t *testing.T) {
+	var dials int32
+	p := newTestPool(2, &dials)
+	p.opts = poolOptions{idleTimeout: 20 * time.Millisecond}
+
+	c1, _, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	p.Put(c1, false)
+
+	c2, isNew, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, c1, c2)
+	p.Put(c2, false)
+
+	time.Sleep(30 * time.Millisecond)
+	c3, isNew, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	assert.NotEqual(t, c1, c3)
+	assert.True(t, c1.isClose())
+	assert.Equal(t, int32(2), dials)
+	assert.Equal(t, uint64(1), p.stats().Evictions)
+}
+
+func TestPoolMaxLifetime(t *testing.T) {
+	var dials int32
+	p := newTestPool(2, &dials)
+	p.opts = poolOptions{maxLifetime: 20 * time.Millisecond}
+
+	c1, _, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	time.Sleep(30 * time.Millisecond)
+	p.Put(c1, false)
+	assert.True(t, c1.isClose())
+	assert.Equal(t, 0, p.free.Len())
+	assert.Equal(t, uint64(1), p.stats().Evictions)
+}
+
+func TestPoolMinIdle(t *testing.T) {
+	var dials int32
+	p := newTestPool(3, &dials).configure(poolOptions{minIdle: 2, idleTimeout: time.Hour})
+
+	assert.Eventually(t, func() bool {
+		return p.stats().Idle == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// the warm conns are reported as new conns when they are handed out for the first time
+	c1, isNew, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	c2, isNew, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	p.Put(c1, false)
+	c1, isNew, err = p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.False(t, isNew)
+
+	// the warmup never exceeds the max active
+	c3, isNew, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	p.maintain()
+	assert.Equal(t, 0, p.free.Len())
+	p.Put(c1, false)
+	p.Put(c2, false)
+	p.Put(c3, false)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&dials))
+}
+
+func TestPoolStats(t *testing.T) {
+	var dials int32
+	p := newTestPool(1, &dials)
+	registerPool("test", "127.0.0.1:8080", p)
+
+	c, _, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = p.Get(ctx)
+	assert.Error(t, err)
+
+	var stats PoolStats
+	for _, s := range GetPoolStats() {
+		if s.Channel == "test" {
+			stats = s
+		}
+	}
+	assert.Equal(t, "127.0.0.1:8080", stats.Target)
+	assert.Equal(t, 1, stats.MaxActive)
+	assert.Equal(t, 1, stats.Active)
+	assert.Equal(t, 0, stats.Idle)
+	assert.Equal(t, uint64(1), stats.Waits)
+	assert.Equal(t, uint64(1), stats.WaitTimeouts)
+	assert.True(t, stats.WaitDurationMs >= 10)
+	assert.Equal(t, uint64(1), stats.Dials)
+
+	p.Put(c, false)
+	stats = p.stats()
+	assert.Equal(t, 0, stats.Active)
+	assert.Equal(t, 1, stats.Idle)
+}
+
+func TestPoolClose(t *testing.T) {
+	var dials int32
+	p := newTestPool(2, &dials).configure(poolOptions{minIdle: 1})
+	registerPool("closed", "", p)
+	c1, _, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	c2, _, err := p.Get(context.TODO())
+	assert.Nil(t, err)
+	p.Put(c1, false)
+
+	p.close()
+	assert.True(t, c1.isClose())
+	assert.Equal(t, 0, p.free.Len())
+	// the conn in use is closed when it is put back
+	assert.False(t, c2.isClose())
+	p.Put(c2, false)
+	assert.True(t, c2.isClose())
+
+	_, _, err = p.Get(context.TODO())
+	assert.Error(t, err)
+	for _, s := range GetPoolStats() {
+		assert.NotEqual(t, "closed", s.Channel)
+	}
+	// closing twice is harmless
+	p.close()
+}
+
+func TestPoolRegistry(t *testing.T) {
+	var dials int32
+	p1 := newTestPool(1, &dials)
+	p2 := newTestPool(1, &dials)
+	registerPool("same", "", p1)
+	registerPool("same", "", p2)
+	count := func() int {
+		n := 0
+		for _, s := range GetPoolStats() {
+			if s.Channel == "same" {
+				n++
+			}
+		}
+		return n
+	}
+	// the pools of the channels with the same name don't replace each other
+	assert.Equal(t, 2, count())
+	p1.close()
+	assert.Equal(t, 1, count())
+	p2.close()
+	assert.Equal(t, 0, count())
+}
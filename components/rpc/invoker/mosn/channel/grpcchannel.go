@@ -0,0 +1,376 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package channel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	grpcContentType = "application/grpc"
+	// authorityKey in ChannelConfig.Ext overrides the :authority of the requests
+	authorityKey = "authority"
+	// defaultTargetIdleTimeout closes the unused connections to the target addresses if idle_timeout_ms is not set
+	defaultTargetIdleTimeout = 5 * time.Minute
+)
+
+// init is regist grpc and triple channel.
+// The triple protocol of dubbo3 is compatible with grpc, so they share the same implementation.
+func init() {
+	RegistChannel("grpc", newGrpcChannel)
+	RegistChannel("triple", newGrpcChannel)
+}
+
+// rawCodec passes the serialized messages through, so the request data is sent as it is.
+// It is named proto to keep the content-type of the requests as application/grpc+proto.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	switch data := v.(type) {
+	case []byte:
+		return data, nil
+	case *[]byte:
+		return *data, nil
+	}
+	return nil, fmt.Errorf("unsupported message type %T", v)
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	res, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unsupported message type %T", v)
+	}
+	*res = append((*res)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// grpcChannel is Channel implement, it invokes grpc services with RPCRequest.Data as the serialized message
+type grpcChannel struct {
+	conns []*grpc.ClientConn
+	next  uint32
+	opts  []grpc.DialOption
+
+	// targetConns are the connections to the target addresses specified by the requests,
+	// they are closed once they stay unused longer than idleTimeout
+	mu          sync.Mutex
+	targetConns map[string]*targetConn
+	idleTimeout time.Duration
+	lastEvict   time.Time
+	closed      bool
+}
+
+// targetConn is the connection to a target address
+type targetConn struct {
+	conn     *grpc.ClientConn
+	active   int
+	lastUsed time.Time
+}
+
+// newGrpcChannel is used to create rpc.Channel according to ChannelConfig.
+// The listener can be an address like "127.0.0.1:9090" or the name of a mosn listener.
+func newGrpcChannel(config ChannelConfig) (rpc.Channel, error) {
+	tlsConfig, err := newTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	g := &grpcChannel{
+		opts: []grpc.DialOption{
+			grpc.WithTransportCredentials(creds),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(rawCodec{})),
+		},
+		targetConns: make(map[string]*targetConn),
+		idleTimeout: time.Duration(config.IdleTimeoutMs) * time.Millisecond,
+	}
+	if g.idleTimeout <= 0 {
+		g.idleTimeout = defaultTargetIdleTimeout
+	}
+	if authority, ok := config.Ext[authorityKey].(string); ok && authority != "" {
+		g.opts = append(g.opts, grpc.WithAuthority(authority))
+	}
+	target := config.Listener
+	opts := g.opts
+	if _, _, err := net.SplitHostPort(config.Listener); err != nil {
+		// connect to the mosn listener through a pipe, which is not secured by TLS.
+		// The credentials are the first option, the others are kept
+		listener := config.Listener
+		target = "passthrough:///" + listener
+		opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, g.opts[1:]...)
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			local, remote := net.Pipe()
+			if err := acceptFunc(&fakeTcpConn{c: remote}, listener); err != nil {
+				local.Close()
+				return nil, err
+			}
+			return &fakeTcpConn{c: local}, nil
+		}))
+	}
+	size := config.Size
+	if size <= 0 {
+		size = 1
+	}
+	for i := 0; i < size; i++ {
+		conn, err := grpc.Dial(target, opts...)
+		if err != nil {
+			for _, c := range g.conns {
+				c.Close()
+			}
+			return nil, err
+		}
+		g.conns = append(g.conns, conn)
+	}
+	return g, nil
+}
+
+// Do is used to handle RPCRequest and return RPCResponse
+func (g *grpcChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	timeout := time.Duration(req.Timeout) * time.Millisecond
+	ctx, cancel := context.WithTimeout(req.Ctx, timeout)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, grpcMetadata(req.Header))
+
+	conn, release, err := g.getConn(req)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	var header, trailer metadata.MD
+	var data []byte
+	err = conn.Invoke(ctx, grpcMethod(req), req.Data, &data, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	rpcResp := &rpc.RPCResponse{
+		ContentType: grpcContentType,
+		Data:        data,
+		Header:      map[string][]string{},
+	}
+	for k, v := range header {
+		rpcResp.Header[k] = v
+	}
+	for k, v := range trailer {
+		rpcResp.Header[k] = append(rpcResp.Header[k], v...)
+	}
+	return rpcResp, nil
+}
+
+// DoStream is used to start a stream with the grpc service, the stream is bidirectional
+// so that it can call client streaming, server streaming and bidirectional streaming methods.
+func (g *grpcChannel) DoStream(req *rpc.RPCRequest) (rpc.RPCStream, error) {
+	ctx, cancel := streamContext(req)
+	ctx = metadata.NewOutgoingContext(ctx, grpcMetadata(req.Header))
+
+	conn, release, err := g.getConn(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	desc := &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}
+	stream, err := conn.NewStream(ctx, desc, grpcMethod(req))
+	if err != nil {
+		cancel()
+		release()
+		return nil, grpcError(err)
+	}
+	s := &grpcStream{stream: stream, cancel: cancel, release: release}
+	if len(req.Data) > 0 {
+		if err := s.Send(req.Data); err != nil {
+			s.end()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// grpcStream is RPCStream implement
+type grpcStream struct {
+	stream  grpc.ClientStream
+	cancel  context.CancelFunc
+	release func()
+	once    sync.Once
+}
+
+// end cancels the stream and releases the connection once
+func (s *grpcStream) end() {
+	s.once.Do(func() {
+		s.cancel()
+		s.release()
+	})
+}
+
+func (s *grpcStream) Header() (rpc.RPCHeader, error) {
+	md, err := s.stream.Header()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rpc.RPCHeader(md), nil
+}
+
+func (s *grpcStream) Send(data []byte) error {
+	if err := s.stream.SendMsg(data); err != nil {
+		if err == io.EOF {
+			// the stream is ended by the server, the real error is returned by RecvMsg
+			return err
+		}
+		return grpcError(err)
+	}
+	return nil
+}
+
+func (s *grpcStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+func (s *grpcStream) Recv() ([]byte, error) {
+	var data []byte
+	if err := s.stream.RecvMsg(&data); err != nil {
+		if err == io.EOF {
+			s.end()
+			return nil, err
+		}
+		return nil, grpcError(err)
+	}
+	return data, nil
+}
+
+func (s *grpcStream) Trailer() rpc.RPCHeader {
+	return rpc.RPCHeader(s.stream.Trailer())
+}
+
+func (s *grpcStream) Close() error {
+	s.end()
+	return nil
+}
+
+// getConn returns the connection to the target address if the request specifies it.
+// The returned function must be called when the connection is no longer used by the request
+func (g *grpcChannel) getConn(req *rpc.RPCRequest) (*grpc.ClientConn, func(), error) {
+	addr := req.Header.Get(rpc.TargetAddress)
+	if addr == "" {
+		return g.conns[atomic.AddUint32(&g.next, 1)%uint32(len(g.conns))], func() {}, nil
+	}
+	now := time.Now()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return nil, nil, common.Error(common.UnavailebleCode, "grpc channel is closed")
+	}
+	g.evictIdle(now)
+	tc, ok := g.targetConns[addr]
+	if !ok {
+		conn, err := grpc.Dial(addr, g.opts...)
+		if err != nil {
+			return nil, nil, common.Error(common.UnavailebleCode, err.Error())
+		}
+		tc = &targetConn{conn: conn}
+		g.targetConns[addr] = tc
+	}
+	tc.active++
+	return tc.conn, func() {
+		g.mu.Lock()
+		tc.active--
+		tc.lastUsed = time.Now()
+		g.mu.Unlock()
+	}, nil
+}
+
+// evictIdle closes the target connections unused longer than the idle timeout, it must be called with g.mu held.
+// They are checked at most twice in the idle timeout
+func (g *grpcChannel) evictIdle(now time.Time) {
+	if now.Sub(g.lastEvict) < g.idleTimeout/2 {
+		return
+	}
+	g.lastEvict = now
+	for addr, tc := range g.targetConns {
+		if tc.active == 0 && now.Sub(tc.lastUsed) > g.idleTimeout {
+			tc.conn.Close()
+			delete(g.targetConns, addr)
+		}
+	}
+}
+
+// Close closes all the connections of the channel, it is called when the invoker is closed
+func (g *grpcChannel) Close() error {
+	for _, conn := range g.conns {
+		conn.Close()
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+	for addr, tc := range g.targetConns {
+		tc.conn.Close()
+		delete(g.targetConns, addr)
+	}
+	return nil
+}
+
+// grpcMethod returns the full method name, the method is called on the service named by the request id
+// if it is not a full method name, e.g. "/helloworld.Greeter/SayHello"
+func grpcMethod(req *rpc.RPCRequest) string {
+	if strings.HasPrefix(req.Method, "/") {
+		return req.Method
+	}
+	return "/" + req.Id + "/" + req.Method
+}
+
+// grpcMetadata maps RPCHeader to the grpc metadata, the pseudo headers and the layotto headers are dropped
+func grpcMetadata(header rpc.RPCHeader) metadata.MD {
+	md := metadata.MD{}
+	for k, v := range header {
+		k = strings.ToLower(k)
+		if strings.HasPrefix(k, ":") || strings.HasPrefix(k, "rpc_") {
+			continue
+		}
+		md[k] = append(md[k], v...)
+	}
+	return md
+}
+
+// grpcError converts the errors about the connection to CommonError, the others are kept as grpc status
+func grpcError(err error) error {
+	s, _ := status.FromError(err)
+	switch s.Code() {
+	case codes.Unavailable:
+		return common.Error(common.UnavailebleCode, s.Message())
+	case codes.DeadlineExceeded:
+		return common.Error(common.TimeoutCode, s.Message())
+	}
+	return err
+}
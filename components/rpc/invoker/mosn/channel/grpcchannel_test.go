@@ -0,0 +1,181 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package channel
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+// startEchoGrpcServer starts a grpc server echoing the messages and the metadata of all the methods
+func startEchoGrpcServer(t *testing.T) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	srv := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			if method == "/echo.Echo/Fail" {
+				return status.Error(codes.NotFound, "not found")
+			}
+			var data []byte
+			if err := stream.RecvMsg(&data); err != nil {
+				return err
+			}
+			md, _ := metadata.FromIncomingContext(stream.Context())
+			header := metadata.Pairs("method", method)
+			for _, k := range []string{"user", "rpc_request_timeout"} {
+				if v := md.Get(k); len(v) > 0 {
+					header.Set(k, v...)
+				}
+			}
+			if err := stream.SetHeader(header); err != nil {
+				return err
+			}
+			return stream.SendMsg(data)
+		}),
+	)
+	go srv.Serve(lis)
+	return lis.Addr().String(), srv.Stop
+}
+
+func TestGrpcChannel(t *testing.T) {
+	addr, stop := startEchoGrpcServer(t)
+	defer stop()
+
+	ch, err := GetChannel(ChannelConfig{Protocol: "triple", Listener: addr, Size: 2})
+	assert.Nil(t, err)
+
+	t.Run("full method name", func(t *testing.T) {
+		req := &rpc.RPCRequest{
+			Ctx:     context.Background(),
+			Method:  "/echo.Echo/Say",
+			Timeout: 1000,
+			Data:    []byte("hello"),
+			Header:  rpc.RPCHeader{"user": {"layotto"}, rpc.RequestTimeoutMs: {"1000"}, ":authority": {"layotto"}},
+		}
+		resp, err := ch.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", string(resp.Data))
+		assert.Equal(t, grpcContentType, resp.ContentType)
+		assert.Equal(t, "layotto", resp.Header.Get("user"))
+		assert.Equal(t, "", resp.Header.Get(rpc.RequestTimeoutMs))
+	})
+
+	t.Run("service id", func(t *testing.T) {
+		req := &rpc.RPCRequest{Ctx: context.Background(), Id: "echo.Echo", Method: "Say", Timeout: 1000, Header: rpc.RPCHeader{}}
+		resp, err := ch.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, "/echo.Echo/Say", resp.Header.Get("method"))
+	})
+
+	t.Run("grpc status", func(t *testing.T) {
+		req := &rpc.RPCRequest{Ctx: context.Background(), Method: "/echo.Echo/Fail", Timeout: 1000, Header: rpc.RPCHeader{}}
+		_, err := ch.Do(req)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGrpcChannelUnavailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	ch, err := GetChannel(ChannelConfig{Protocol: "grpc", Listener: addr})
+	assert.Nil(t, err)
+	_, err = ch.Do(&rpc.RPCRequest{Ctx: context.Background(), Method: "/echo.Echo/Say", Timeout: 1000, Header: rpc.RPCHeader{}})
+	e, ok := err.(common.CommonError)
+	assert.True(t, ok)
+	assert.Contains(t, []int{common.UnavailebleCode, common.TimeoutCode}, e.Code())
+}
+
+func TestGrpcChannelStream(t *testing.T) {
+	addr, stop := startEchoGrpcServer(t)
+	defer stop()
+
+	ch, err := GetChannel(ChannelConfig{Protocol: "grpc", Listener: addr})
+	assert.Nil(t, err)
+	stream, err := ch.(rpc.StreamChannel).DoStream(&rpc.RPCRequest{
+		Ctx:    context.Background(),
+		Method: "/echo.Echo/Say",
+		Data:   []byte("hello"),
+		Header: rpc.RPCHeader{"user": {"layotto"}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, stream.CloseSend())
+
+	header, err := stream.Header()
+	assert.Nil(t, err)
+	assert.Equal(t, "layotto", header.Get("user"))
+	data, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	assert.NotNil(t, stream.Trailer())
+	assert.Nil(t, stream.Close())
+}
+
+func TestGrpcChannelTargetConns(t *testing.T) {
+	addr, stop := startEchoGrpcServer(t)
+	defer stop()
+
+	ch, err := GetChannel(ChannelConfig{Protocol: "grpc", Listener: addr, IdleTimeoutMs: 100})
+	assert.Nil(t, err)
+	g := ch.(*grpcChannel)
+	req := func() *rpc.RPCRequest {
+		return &rpc.RPCRequest{Ctx: context.Background(), Method: "/echo.Echo/Say", Timeout: 1000, Header: rpc.RPCHeader{rpc.TargetAddress: {addr}}}
+	}
+	_, err = ch.Do(req())
+	assert.Nil(t, err)
+	assert.Len(t, g.targetConns, 1)
+
+	// a connection used by a stream is kept
+	stream, err := g.DoStream(req())
+	assert.Nil(t, err)
+	time.Sleep(200 * time.Millisecond)
+	_, err = ch.Do(req())
+	assert.Nil(t, err)
+	assert.Len(t, g.targetConns, 1)
+	assert.Nil(t, stream.Close())
+
+	// the idle connection is closed
+	time.Sleep(200 * time.Millisecond)
+	g.mu.Lock()
+	g.evictIdle(time.Now())
+	g.mu.Unlock()
+	assert.Len(t, g.targetConns, 0)
+
+	_, err = ch.Do(req())
+	assert.Nil(t, err)
+	assert.Nil(t, g.Close())
+	assert.Len(t, g.targetConns, 0)
+	_, err = ch.Do(req())
+	assert.NotNil(t, err)
+}
@@ -19,11 +19,16 @@ package channel
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
+	"io"
 	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"mosn.io/pkg/buffer"
+	"mosn.io/pkg/utils"
 
 	"github.com/valyala/fasthttp"
 	// bridge to mosn
@@ -62,20 +67,40 @@ func (h *hstate) close() {
 
 // httpChannel is Channel implement
 type httpChannel struct {
-	pool *connPool
+	name      string
+	size      int
+	opts      poolOptions
+	pool      *connPool
+	tlsConfig *tls.Config
+
+	// targets are the pools of the target addresses specified by the requests
+	targets *targetPools
 }
 
 // newHttpChannel is used to create rpc.Channel according to ChannelConfig
 func newHttpChannel(config ChannelConfig) (rpc.Channel, error) {
-	hc := &httpChannel{}
-	hc.pool = newConnPool(
-		config.Size,
+	tlsConfig, err := newTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	hc := &httpChannel{
+		name:      channelName(config),
+		size:      config.Size,
+		opts:      newPoolOptions(config),
+		tlsConfig: tlsConfig,
+	}
+	hc.pool = hc.newPool(
 		// dialFunc
 		func() (net.Conn, error) {
 			_, _, err := net.SplitHostPort(config.Listener)
 			if err == nil {
-				return net.Dial("tcp", config.Listener)
+				conn, err := net.Dial("tcp", config.Listener)
+				if err != nil {
+					return nil, err
+				}
+				return secure(conn, tlsConfig, config.Listener)
 			}
+			// the pipe to the local mosn listener is not secured by TLS
 			local, remote := net.Pipe()
 			localTcpConn := &fakeTcpConn{c: local}
 			remoteTcpConn := &fakeTcpConn{c: remote}
@@ -90,6 +115,17 @@ func newHttpChannel(config ChannelConfig) (rpc.Channel, error) {
 			// 		hstate(net.Pipe) <-- readloop goroutine <---
 			return localTcpConn, nil
 		},
+	)
+	registerPool(hc.name, "", hc.pool)
+	hc.targets = newTargetPools(hc.name, config, tlsConfig, hc.newPool)
+	return hc, nil
+}
+
+// newPool creates a connPool with the dialFunc
+func (h *httpChannel) newPool(dialFunc func() (net.Conn, error)) *connPool {
+	return newConnPool(
+		h.size,
+		dialFunc,
 		// stateFunc
 		func() interface{} {
 			// hstate is a pipe for readloop goroutine to communicate with request goroutine
@@ -97,10 +133,31 @@ func newHttpChannel(config ChannelConfig) (rpc.Channel, error) {
 			s.reader, s.writer = net.Pipe()
 			return s
 		},
-		hc.onData,
-		hc.cleanup,
-	)
-	return hc, nil
+		h.onData,
+		h.cleanup,
+	).configure(h.opts)
+}
+
+// getPool returns the pool of the target address if the request specifies it, otherwise the pool of the listener.
+// The returned function must be called when the request no longer uses the pool
+func (h *httpChannel) getPool(req *rpc.RPCRequest) (*connPool, func()) {
+	addr := req.Header.Get(rpc.TargetAddress)
+	if addr == "" {
+		return h.pool, func() {}
+	}
+	pool, release := h.targets.get(addr)
+	// the closed pool of the listener fails the requests after the channel is closed
+	if pool == nil {
+		return h.pool, func() {}
+	}
+	return pool, release
+}
+
+// Close closes the pools of the channel, it is called when the invoker is closed
+func (h *httpChannel) Close() error {
+	h.pool.close()
+	h.targets.close()
+	return nil
 }
 
 // Do is used to handle RPCRequest and return RPCResponse
@@ -113,7 +170,9 @@ func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	// 2. get a fake connection with mosn
 	// The pool will start a readloop gorountine,
 	// which aims to read data from mosn and then write data to the hstate.writer
-	conn, _, err := h.pool.Get(ctx)
+	pool, release := h.getPool(req)
+	defer release()
+	conn, _, err := pool.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +181,7 @@ func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	deadline, _ := ctx.Deadline()
 	if err = conn.SetWriteDeadline(deadline); err != nil {
 		hstate.close()
-		h.pool.Put(conn, true)
+		pool.Put(conn, true)
 		return nil, common.Error(common.UnavailebleCode, err.Error())
 	}
 	// 4. write data to this fake connection
@@ -131,7 +190,7 @@ func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 
 	if _, err = httpReq.WriteTo(conn); err != nil {
 		hstate.close()
-		h.pool.Put(conn, true)
+		pool.Put(conn, true)
 		return nil, common.Error(common.UnavailebleCode, err.Error())
 	}
 
@@ -141,10 +200,10 @@ func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 
 	if err = httpResp.Read(bufio.NewReader(hstate.reader)); err != nil {
 		hstate.close()
-		h.pool.Put(conn, true)
+		pool.Put(conn, true)
 		return nil, common.Error(common.UnavailebleCode, err.Error())
 	}
-	h.pool.Put(conn, false)
+	pool.Put(conn, false)
 
 	// 6. convert result to rpc.RPCResponse,which is the response of rpc invoker
 	body := httpResp.Body()
@@ -187,6 +246,166 @@ func (h *httpChannel) constructReq(req *rpc.RPCRequest) *fasthttp.Request {
 	return httpReq
 }
 
+// DoStream is used to send the request body and receive the response body in chunks,
+// which are carried by the chunked transfer encoding.
+func (h *httpChannel) DoStream(req *rpc.RPCRequest) (rpc.RPCStream, error) {
+	ctx, cancel := streamContext(req)
+	pool, release := h.getPool(req)
+	conn, _, err := pool.Get(ctx)
+	if err != nil {
+		release()
+		cancel()
+		return nil, err
+	}
+	body, bodyWriter := io.Pipe()
+	s := &httpStream{
+		pool:    pool,
+		release: release,
+		conn:    conn,
+		body:    bodyWriter,
+		cancel:  cancel,
+		ready:   make(chan struct{}),
+	}
+	httpReq, err := h.constructStreamReq(ctx, req, body)
+	if err != nil {
+		s.Close()
+		return nil, common.Error(common.InvalidArgsCode, err.Error())
+	}
+	// write the request, it ends when the sending direction is closed
+	utils.GoWithRecover(func() {
+		if err := httpReq.Write(conn); err != nil {
+			body.CloseWithError(err)
+		}
+	}, nil)
+	// read the response header, the body is read by Recv
+	utils.GoWithRecover(func() {
+		defer close(s.ready)
+		resp, err := http.ReadResponse(bufio.NewReader(conn.state.(*hstate).reader), httpReq)
+		if err != nil {
+			s.err = common.Error(common.UnavailebleCode, err.Error())
+			return
+		}
+		s.resp = resp
+		if resp.StatusCode != http.StatusOK {
+			s.err = common.Errorf(common.UnavailebleCode, "http response code %d", resp.StatusCode)
+		}
+	}, nil)
+	// abort the stream when the context is done
+	utils.GoWithRecover(func() {
+		<-ctx.Done()
+		s.Close()
+	}, nil)
+	if len(req.Data) > 0 {
+		if err := s.Send(req.Data); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// constructStreamReq is handle rpc.RPCRequest to http.Request whose body is streamed
+func (h *httpChannel) constructStreamReq(ctx context.Context, req *rpc.RPCRequest, body io.Reader) (*http.Request, error) {
+	method := http.MethodPost
+	if verb := req.Header.Get("verb"); verb != "" {
+		method = verb
+		delete(req.Header, "verb")
+	}
+	uri := "http://localhost" + req.Method
+	if query := req.Header.Get("query_string"); query != "" {
+		uri += "?" + query
+		delete(req.Header, "query_string")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Range(func(key string, value string) bool {
+		if !strings.HasPrefix(key, ":") {
+			httpReq.Header.Set(key, value)
+		}
+		return true
+	})
+	httpReq.Header.Set("id", req.Id)
+	return httpReq, nil
+}
+
+// httpStream is RPCStream implement
+type httpStream struct {
+	pool *connPool
+	// release ends the use of the pool by the stream
+	release func()
+	conn    *wrapConn
+	body    *io.PipeWriter
+	cancel  context.CancelFunc
+	once    sync.Once
+
+	// ready is closed after resp or err is set
+	ready chan struct{}
+	resp  *http.Response
+	err   error
+}
+
+func (s *httpStream) Header() (rpc.RPCHeader, error) {
+	<-s.ready
+	if s.err != nil {
+		return nil, s.err
+	}
+	return rpc.RPCHeader(s.resp.Header), nil
+}
+
+func (s *httpStream) Send(data []byte) error {
+	if _, err := s.body.Write(data); err != nil {
+		return common.Error(common.UnavailebleCode, err.Error())
+	}
+	return nil
+}
+
+func (s *httpStream) CloseSend() error {
+	return s.body.Close()
+}
+
+func (s *httpStream) Recv() ([]byte, error) {
+	<-s.ready
+	if s.err != nil {
+		return nil, s.err
+	}
+	buf := make([]byte, defaultBufSize)
+	for {
+		n, err := s.resp.Body.Read(buf)
+		if n > 0 {
+			return buf[:n], nil
+		}
+		if err == io.EOF {
+			s.Close()
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, common.Error(common.UnavailebleCode, err.Error())
+		}
+	}
+}
+
+func (s *httpStream) Trailer() rpc.RPCHeader {
+	<-s.ready
+	if s.resp == nil {
+		return rpc.RPCHeader{}
+	}
+	return rpc.RPCHeader(s.resp.Trailer)
+}
+
+// Close releases the connection, it is not reused since the stream may be ended in the middle
+func (s *httpStream) Close() error {
+	s.once.Do(func() {
+		s.cancel()
+		s.body.CloseWithError(io.ErrClosedPipe)
+		s.conn.state.(*hstate).close()
+		s.pool.Put(s.conn, true)
+		s.release()
+	})
+	return nil
+}
+
 func (h *httpChannel) onData(conn *wrapConn) error {
 	hstate := conn.state.(*hstate)
 	return hstate.onData(conn.buf)
@@ -19,8 +19,11 @@ package channel
 import (
 	"bufio"
 	"context"
+	"io"
 	"log"
 	"net"
+	"net/http"
+	"net/http/httputil"
 	"strconv"
 	"strings"
 	"sync"
@@ -170,3 +173,68 @@ func TestConstructReq(t *testing.T) {
 		assert.Equal(t, "GET /bar HTTP/1.1\r\nHost: localhost\r\nContent-Length: 11\r\nId: foo\r\n\r\nhello world", sb.String())
 	}
 }
+
+// acceptChunkedEcho serves a http request on the connection, and echoes the chunks of the request body
+func acceptChunkedEcho(conn net.Conn, listener string) error {
+	go func() {
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		if _, err := conn.Write([]byte("HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\nX-Id: " + req.Header.Get("id") + "\r\n\r\n")); err != nil {
+			return
+		}
+		cw := httputil.NewChunkedWriter(conn)
+		buf := make([]byte, 1024)
+		for {
+			n, err := req.Body.Read(buf)
+			if n > 0 {
+				cw.Write(buf[:n])
+			}
+			if err != nil {
+				break
+			}
+		}
+		cw.Close()
+		conn.Write([]byte("\r\n"))
+	}()
+	return nil
+}
+
+func TestHttpChannelStream(t *testing.T) {
+	acceptFunc = acceptChunkedEcho
+
+	ch, err := newHttpChannel(ChannelConfig{Size: 1})
+	assert.Nil(t, err)
+	req := &rpc.RPCRequest{
+		Ctx:    context.Background(),
+		Id:     "echo",
+		Method: "/echo",
+		Data:   []byte("hello"),
+		Header: rpc.RPCHeader{},
+	}
+	stream, err := ch.(rpc.StreamChannel).DoStream(req)
+	assert.Nil(t, err)
+
+	header, err := stream.Header()
+	assert.Nil(t, err)
+	assert.Equal(t, "echo", header.Get("X-Id"))
+	data, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	assert.Nil(t, stream.Send([]byte("world")))
+	data, err = stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, "world", string(data))
+
+	assert.Nil(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// the connection is released
+	stream, err = ch.(rpc.StreamChannel).DoStream(&rpc.RPCRequest{Ctx: context.Background(), Method: "/echo", Header: rpc.RPCHeader{}})
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Close())
+}
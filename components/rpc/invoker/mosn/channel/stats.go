@@ -0,0 +1,118 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PoolStats is a snapshot of the state and the metrics of a connection pool
+type PoolStats struct {
+	// Channel is the name of the channel
+	Channel string `json:"channel"`
+	// Target is the target address of the pool, it's empty for the pool of the listener
+	Target    string `json:"target,omitempty"`
+	MaxActive int    `json:"max_active"`
+	// Active is the number of the conns in use
+	Active int `json:"active"`
+	// Idle is the number of the conns in the pool
+	Idle int `json:"idle"`
+	// Waits is the number of the requests which waited for a free conn
+	Waits          uint64 `json:"waits"`
+	WaitTimeouts   uint64 `json:"wait_timeouts"`
+	WaitDurationMs int64  `json:"wait_duration_ms"`
+	Dials          uint64 `json:"dials"`
+	DialErrors     uint64 `json:"dial_errors"`
+	// Evictions is the number of the conns closed due to the idle timeout or the max lifetime
+	Evictions         uint64 `json:"evictions"`
+	HeartbeatFailures uint64 `json:"heartbeat_failures"`
+}
+
+type poolKey struct {
+	channel string
+	target  string
+}
+
+var (
+	poolsMu sync.RWMutex
+	// pools are keyed by themselves, since the channels of different invokers may have the same name
+	pools = map[*connPool]poolKey{}
+)
+
+// registerPool makes the pool visible to GetPoolStats until it is closed
+func registerPool(channel, target string, p *connPool) {
+	poolsMu.Lock()
+	pools[p] = poolKey{channel: channel, target: target}
+	poolsMu.Unlock()
+}
+
+// unregisterPool removes the closed pool from GetPoolStats
+func unregisterPool(p *connPool) {
+	poolsMu.Lock()
+	delete(pools, p)
+	poolsMu.Unlock()
+}
+
+// channelName returns the name of the channel, which defaults to the protocol
+func channelName(config ChannelConfig) string {
+	if config.Name != "" {
+		return config.Name
+	}
+	return config.Protocol
+}
+
+// GetPoolStats returns the stats of the connection pools sorted by the channel and the target
+func GetPoolStats() []PoolStats {
+	poolsMu.RLock()
+	stats := make([]PoolStats, 0, len(pools))
+	for p, key := range pools {
+		s := p.stats()
+		s.Channel, s.Target = key.channel, key.target
+		stats = append(stats, s)
+	}
+	poolsMu.RUnlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Channel != stats[j].Channel {
+			return stats[i].Channel < stats[j].Channel
+		}
+		return stats[i].Target < stats[j].Target
+	})
+	return stats
+}
+
+// stats returns a snapshot of the pool
+func (p *connPool) stats() PoolStats {
+	p.mu.Lock()
+	idle := p.free.Len()
+	p.mu.Unlock()
+	return PoolStats{
+		MaxActive:         p.maxActive,
+		Active:            len(p.sema),
+		Idle:              idle,
+		Waits:             atomic.LoadUint64(&p.metrics.waits),
+		WaitTimeouts:      atomic.LoadUint64(&p.metrics.waitTimeouts),
+		WaitDurationMs:    time.Duration(atomic.LoadInt64(&p.metrics.waitNanos)).Milliseconds(),
+		Dials:             atomic.LoadUint64(&p.metrics.dials),
+		DialErrors:        atomic.LoadUint64(&p.metrics.dialErrors),
+		Evictions:         atomic.LoadUint64(&p.metrics.evictions),
+		HeartbeatFailures: atomic.LoadUint64(&p.metrics.heartbeatFailures),
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"crypto/tls"
+	"net"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/pkg/common"
+)
+
+// targetPools are the pools of the target addresses specified by the requests,
+// they are closed and unregistered once they stay unused longer than idleTimeout
+type targetPools struct {
+	channel     string
+	idleTimeout time.Duration
+	tlsConfig   *tls.Config
+	// newPool creates the pool of a target address with the dialFunc
+	newPool func(dialFunc func() (net.Conn, error)) *connPool
+
+	mu        sync.Mutex
+	pools     map[string]*targetPool
+	lastEvict time.Time
+	closed    bool
+}
+
+// targetPool is the pool of a target address
+type targetPool struct {
+	pool     *connPool
+	active   int
+	lastUsed time.Time
+}
+
+func newTargetPools(channel string, config ChannelConfig, tlsConfig *tls.Config,
+	newPool func(dialFunc func() (net.Conn, error)) *connPool) *targetPools {
+	t := &targetPools{
+		channel:     channel,
+		idleTimeout: time.Duration(config.IdleTimeoutMs) * time.Millisecond,
+		tlsConfig:   tlsConfig,
+		newPool:     newPool,
+		pools:       make(map[string]*targetPool),
+	}
+	if t.idleTimeout <= 0 {
+		t.idleTimeout = defaultTargetIdleTimeout
+	}
+	return t
+}
+
+// get returns the pool of the target address, it returns nil if the channel is closed.
+// The returned function must be called when the pool is no longer used by the request
+func (t *targetPools) get(addr string) (*connPool, func()) {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil, nil
+	}
+	t.evictIdle(now)
+	tp, ok := t.pools[addr]
+	if !ok {
+		tp = &targetPool{pool: t.newPool(func() (net.Conn, error) {
+			conn, err := net.Dial("tcp", addr)
+			if err != nil {
+				return nil, common.Error(common.UnavailebleCode, err.Error())
+			}
+			return secure(conn, t.tlsConfig, addr)
+		})}
+		t.pools[addr] = tp
+		registerPool(t.channel, addr, tp.pool)
+	}
+	tp.active++
+	return tp.pool, func() {
+		t.mu.Lock()
+		tp.active--
+		tp.lastUsed = time.Now()
+		t.mu.Unlock()
+	}
+}
+
+// evictIdle closes the target pools unused longer than the idle timeout, it must be called with t.mu held.
+// They are checked at most twice in the idle timeout
+func (t *targetPools) evictIdle(now time.Time) {
+	if now.Sub(t.lastEvict) < t.idleTimeout/2 {
+		return
+	}
+	t.lastEvict = now
+	for addr, tp := range t.pools {
+		if tp.active == 0 && now.Sub(tp.lastUsed) > t.idleTimeout {
+			tp.pool.close()
+			delete(t.pools, addr)
+		}
+	}
+}
+
+// close closes all the target pools, it is called when the channel is closed
+func (t *targetPools) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	for addr, tp := range t.pools {
+		tp.pool.close()
+		delete(t.pools, addr)
+	}
+}
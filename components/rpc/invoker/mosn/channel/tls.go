@@ -0,0 +1,133 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	common "mosn.io/layotto/components/pkg/common"
+)
+
+const handshakeTimeout = 5 * time.Second
+
+// TLSConfig is the mutual TLS config of the direct connections of a channel.
+// The PEM encoded certificates are usually injected from a secret store by the secret_ref of the rpc config,
+// e.g. {"name": "channel.0.tls.key", "key": "client-key"}.
+// They are loaded when the channel is created, the rotated certificates take effect after the rpc component
+// is applied again, e.g. by the ApplyComponent API of the lifecycle.
+type TLSConfig struct {
+	// Cert and Key are the identity presented to the servers
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+	// CA verifies the server certificates, the system roots are used if it is empty
+	CA string `json:"ca"`
+	// ServerName verifies the host name of the server certificates, it defaults to the host of the dialed address
+	ServerName string `json:"server_name"`
+	// ServerIdentities limit the accepted server certificates to the ones whose URI or DNS SANs contain one of them,
+	// e.g. "spiffe://cluster.local/ns/default/sa/hello"
+	ServerIdentities   []string `json:"server_identities"`
+	InsecureSkipVerify bool     `json:"insecure_skip_verify"`
+}
+
+// newTLSConfig builds the tls.Config from TLSConfig, it returns nil if TLS is not configured
+func newTLSConfig(c *TLSConfig) (*tls.Config, error) {
+	if c == nil {
+		return nil, nil
+	}
+	config := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if c.Cert != "" || c.Key != "" {
+		cert, err := tls.X509KeyPair([]byte(c.Cert), []byte(c.Key))
+		if err != nil {
+			return nil, fmt.Errorf("invalid tls certificate: %s", err.Error())
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	if c.CA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(c.CA)) {
+			return nil, errors.New("invalid tls ca")
+		}
+		config.RootCAs = pool
+	}
+	if len(c.ServerIdentities) > 0 {
+		identities := c.ServerIdentities
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("server certificate not found")
+			}
+			if !hasIdentity(cs.PeerCertificates[0], identities) {
+				return fmt.Errorf("server identity is not one of %v", identities)
+			}
+			return nil
+		}
+	}
+	return config, nil
+}
+
+// hasIdentity reports whether the URI or DNS SANs of cert contain one of the identities
+func hasIdentity(cert *x509.Certificate, identities []string) bool {
+	for _, id := range identities {
+		for _, uri := range cert.URIs {
+			if uri.String() == id {
+				return true
+			}
+		}
+		for _, name := range cert.DNSNames {
+			if name == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// secure performs the TLS handshake on conn if config is not nil, conn is closed if the handshake fails
+func secure(conn net.Conn, config *tls.Config, addr string) (net.Conn, error) {
+	if config == nil {
+		return conn, nil
+	}
+	if config.ServerName == "" {
+		config = config.Clone()
+		config.ServerName = addr
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			config.ServerName = host
+		}
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		conn.Close()
+		return nil, common.Error(common.UnavailebleCode, err.Error())
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, common.Errorf(common.UnavailebleCode, "tls handshake with %s failed: %s", addr, err.Error())
+	}
+	if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, common.Error(common.UnavailebleCode, err.Error())
+	}
+	return tlsConn, nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+	kpem string
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, identity string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if identity != "" {
+		u, _ := url.Parse(identity)
+		template.URIs = []*url.URL{u}
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		kpem: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+func TestHttpChannelMutualTLS(t *testing.T) {
+	ca := newTestCert(t, "ca", nil, "")
+	server := newTestCert(t, "server", ca, "spiffe://test/server")
+	client := newTestCert(t, "client", ca, "spiffe://test/client")
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	serverCert, err := tls.X509KeyPair([]byte(server.pem), []byte(server.kpem))
+	assert.Nil(t, err)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("caller", r.TLS.PeerCertificates[0].URIs[0].String())
+		w.Write(body)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+	addr := ts.Listener.Addr().String()
+
+	newRequest := func() *rpc.RPCRequest {
+		return &rpc.RPCRequest{
+			Ctx:     context.TODO(),
+			Id:      "foo",
+			Method:  "bar",
+			Data:    []byte("hello"),
+			Timeout: 1000,
+			Header:  rpc.RPCHeader{rpc.TargetAddress: []string{addr}},
+		}
+	}
+
+	t.Run("mutual tls", func(t *testing.T) {
+		channel, err := newHttpChannel(ChannelConfig{Size: 1, TLS: &TLSConfig{
+			Cert:             client.pem,
+			Key:              client.kpem,
+			CA:               ca.pem,
+			ServerIdentities: []string{"spiffe://test/server"},
+		}})
+		assert.Nil(t, err)
+		resp, err := channel.Do(newRequest())
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", string(resp.Data))
+		assert.Equal(t, "spiffe://test/client", resp.Header.Get("Caller"))
+	})
+
+	t.Run("unexpected server identity", func(t *testing.T) {
+		channel, err := newHttpChannel(ChannelConfig{Size: 1, TLS: &TLSConfig{
+			Cert:             client.pem,
+			Key:              client.kpem,
+			CA:               ca.pem,
+			ServerIdentities: []string{"spiffe://test/other"},
+		}})
+		assert.Nil(t, err)
+		_, err = channel.Do(newRequest())
+		assert.Error(t, err)
+	})
+
+	t.Run("untrusted server", func(t *testing.T) {
+		channel, err := newHttpChannel(ChannelConfig{Size: 1, TLS: &TLSConfig{
+			Cert: client.pem,
+			Key:  client.kpem,
+		}})
+		assert.Nil(t, err)
+		_, err = channel.Do(newRequest())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid certificate", func(t *testing.T) {
+		_, err := newHttpChannel(ChannelConfig{TLS: &TLSConfig{Cert: client.pem}})
+		assert.Error(t, err)
+		_, err = newXChannel(ChannelConfig{Protocol: "bolt", TLS: &TLSConfig{CA: "invalid"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestPipeWithoutTLS(t *testing.T) {
+	startTestServer()
+
+	// the pipe to the mosn listener is not secured, the TLS config is for the direct connections
+	config := ChannelConfig{Size: 1, Protocol: proto, Ext: map[string]interface{}{"class": "xxx"}, TLS: &TLSConfig{InsecureSkipVerify: true}}
+	channel, err := newXChannel(config)
+	assert.Nil(t, err)
+	req := &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte("hello world"), Timeout: 1000}
+	resp, err := channel.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", string(resp.Data))
+}
@@ -18,9 +18,9 @@ package channel
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -28,7 +28,6 @@ import (
 
 	"mosn.io/mosn/pkg/protocol/xprotocol/bolt"
 
-	"mosn.io/pkg/buffer"
 	"mosn.io/pkg/log"
 
 	"mosn.io/api"
@@ -54,15 +53,29 @@ func newXChannel(config ChannelConfig) (rpc.Channel, error) {
 	if err := proto.Init(config.Ext); err != nil {
 		return nil, err
 	}
-	m := &xChannel{proto: proto}
-	m.pool = newConnPool(
-		config.Size,
+	tlsConfig, err := newTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	m := &xChannel{
+		name:      channelName(config),
+		size:      config.Size,
+		opts:      newPoolOptions(config),
+		proto:     proto,
+		tlsConfig: tlsConfig,
+	}
+	m.pool = m.newPool(
 		// dialFunc
 		func() (net.Conn, error) {
 			_, _, err := net.SplitHostPort(config.Listener)
 			if err == nil {
-				return net.Dial("tcp", config.Listener)
+				conn, err := net.Dial("tcp", config.Listener)
+				if err != nil {
+					return nil, err
+				}
+				return secure(conn, tlsConfig, config.Listener)
 			}
+			// the pipe to the local mosn listener is not secured by TLS
 			local, remote := net.Pipe()
 			localTcpConn := &fakeTcpConn{c: local}
 			remoteTcpConn := &fakeTcpConn{c: remote}
@@ -78,13 +91,9 @@ func newXChannel(config ChannelConfig) (rpc.Channel, error) {
 			// 		xstate.calls[reqId](a channel) <-- readloop goroutine
 			return localTcpConn, nil
 		},
-		// stateFunc
-		func() interface{} {
-			return &xstate{calls: map[uint32]chan call{}}
-		},
-		m.onData,
-		m.cleanup,
 	)
+	registerPool(m.name, "", m.pool)
+	m.targets = newTargetPools(m.name, config, tlsConfig, m.newPool)
 	return m, nil
 }
 
@@ -102,113 +111,73 @@ type call struct {
 
 // xChannel is Channel implement
 type xChannel struct {
-	proto transport_protocol.TransportProtocol
-	pool  *connPool
+	name      string
+	size      int
+	opts      poolOptions
+	proto     transport_protocol.TransportProtocol
+	pool      *connPool
+	tlsConfig *tls.Config
+
+	// targets are the pools of the target addresses specified by the requests
+	targets *targetPools
 }
 
-// InvokeWithTargetAddress send request to specific provider address
-func (m *xChannel) InvokeWithTargetAddress(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
-	// 1. context.WithTimeout
-	timeout := time.Duration(req.Timeout) * time.Millisecond
-	ctx, cancel := context.WithTimeout(req.Ctx, timeout)
-	defer cancel()
-
-	// 2. get connection with specific address
-	conn, err := net.Dial("tcp", req.Header[rpc.TargetAddress][0])
-	if err != nil {
-		return nil, err
-	}
-	wc := &wrapConn{Conn: conn}
-
-	// 3. encode request
-	frame := m.proto.ToFrame(req)
-	buf, encErr := m.proto.Encode(req.Ctx, frame)
-	if encErr != nil {
-		return nil, common.Error(common.InternalCode, encErr.Error())
-	}
-
-	callChan := make(chan call, 1)
-	// 4. set timeout
-	deadline, _ := ctx.Deadline()
-	if err := conn.SetWriteDeadline(deadline); err != nil {
-		return nil, common.Error(common.UnavailebleCode, err.Error())
-	}
+// newPool creates a connPool with the dialFunc, the responses are dispatched to the requests by the request ids
+func (m *xChannel) newPool(dialFunc func() (net.Conn, error)) *connPool {
+	return newConnPool(
+		m.size,
+		dialFunc,
+		// stateFunc
+		func() interface{} {
+			return &xstate{calls: map[uint32]chan call{}}
+		},
+		m.onData,
+		m.cleanup,
+	).configure(m.opts)
+}
 
-	// 5. read package
-	if frame.GetStreamType() != api.RequestOneWay {
-		go m.readResponse(wc, callChan)
+// getPool returns the pool of the target address if the request specifies it, otherwise the pool of the listener.
+// The returned function must be called when the request no longer uses the pool
+func (m *xChannel) getPool(req *rpc.RPCRequest) (*connPool, func()) {
+	addr := req.Header.Get(rpc.TargetAddress)
+	if addr == "" {
+		return m.pool, func() {}
 	}
-
-	// 6. write packet
-	if _, err := conn.Write(buf.Bytes()); err != nil {
-		return nil, common.Error(common.UnavailebleCode, err.Error())
+	pool, release := m.targets.get(addr)
+	// the closed pool of the listener fails the requests after the channel is closed
+	if pool == nil {
+		return m.pool, func() {}
 	}
+	return pool, release
+}
 
-	if frame.GetStreamType() == api.RequestOneWay {
-		return &rpc.RPCResponse{Success: true}, nil
+// toFrame converts the request to a frame, the payload is encoded if the protocol is a PayloadCodec.
+// It returns whether the response needs to be decoded.
+func (m *xChannel) toFrame(req *rpc.RPCRequest) (api.XFrame, bool, error) {
+	codec, ok := m.proto.(transport_protocol.PayloadCodec)
+	if !ok {
+		return m.proto.ToFrame(req), false, nil
 	}
-
-	select {
-	case res := <-callChan:
-		if res.err != nil {
-			return nil, common.Error(common.UnavailebleCode, res.err.Error())
-		}
-		return m.proto.FromFrame(res.resp)
-	case <-ctx.Done():
-		return nil, common.Error(common.TimeoutCode, ErrTimeout.Error())
+	data, encoded, err := codec.EncodePayload(req)
+	if err != nil || !encoded {
+		return m.proto.ToFrame(req), false, err
 	}
+	// the request is not modified since it may be retried
+	encodedReq := *req
+	encodedReq.Data = data
+	return m.proto.ToFrame(&encodedReq), true, nil
 }
 
-func (m *xChannel) readResponse(wc *wrapConn, callChan chan<- call) {
-	var err error
-	defer func() {
-		if err != nil {
-			callChan <- call{err: err}
-		}
-		wc.Close()
-	}()
-
-	wc.buf = buffer.NewIoBuffer(defaultBufSize)
-	for {
-		// read data from connection
-		n, readErr := wc.buf.ReadOnce(wc.Conn)
-		if readErr != nil {
-			err = readErr
-			if readErr == io.EOF {
-				log.DefaultLogger.Debugf("[runtime][rpc]direct conn read-loop err: %s", readErr.Error())
-			} else {
-				log.DefaultLogger.Errorf("[runtime][rpc]direct conn read-loop err: %s", readErr.Error())
-			}
-		}
-
-		if n > 0 {
-			iframe, decodeErr := m.proto.Decode(context.TODO(), wc.buf)
-			if decodeErr != nil {
-				err = decodeErr
-				log.DefaultLogger.Errorf("[runtime][rpc]direct conn decode frame err: %s", err)
-				break
-			}
-			frame, ok := iframe.(api.XRespFrame)
-			if frame == nil {
-				continue
-			}
-			if !ok {
-				err = errors.New("[runtime][rpc]xchannel type not XRespFrame")
-				log.DefaultLogger.Errorf("[runtime][rpc]direct conn decode frame err: %s", err)
-				break
-			}
-			callChan <- call{resp: frame}
-			return
-
-		}
-		if err != nil {
-			break
-		}
-		if wc.buf != nil && wc.buf.Len() == 0 && wc.buf.Cap() > maxBufSize {
-			wc.buf.Free()
-			wc.buf.Alloc(defaultBufSize)
-		}
+// fromFrame converts the response frame, the payload is decoded if the payload of the request is encoded
+func (m *xChannel) fromFrame(frame api.XRespFrame, decode bool) (*rpc.RPCResponse, error) {
+	resp, err := m.proto.FromFrame(frame)
+	if err != nil || !decode {
+		return resp, err
+	}
+	if err := m.proto.(transport_protocol.PayloadCodec).DecodePayload(resp); err != nil {
+		return nil, err
 	}
+	return resp, nil
 }
 
 func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
@@ -217,8 +186,10 @@ func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	ctx, cancel := context.WithTimeout(req.Ctx, timeout)
 	defer cancel()
 
-	// 2. get fake connection with mosn
-	conn, isNewConn, err := m.pool.Get(ctx)
+	// 2. get fake connection with mosn, or the connection with the target address
+	pool, release := m.getPool(req)
+	defer release()
+	conn, isNewConn, err := pool.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -226,12 +197,16 @@ func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	xstate := conn.state.(*xstate)
 
 	// 3. encode request
-	frame := m.proto.ToFrame(req)
+	frame, decode, err := m.toFrame(req)
+	if err != nil {
+		pool.Put(conn, false)
+		return nil, err
+	}
 	id := atomic.AddUint32(&xstate.reqid, 1)
 	frame.SetRequestId(uint64(id))
 	buf, encErr := m.proto.Encode(req.Ctx, frame)
 	if encErr != nil {
-		m.pool.Put(conn, false)
+		pool.Put(conn, false)
 		return nil, common.Error(common.InternalCode, encErr.Error())
 	}
 
@@ -239,7 +214,7 @@ func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	// set timeout
 	deadline, _ := ctx.Deadline()
 	if err := conn.SetWriteDeadline(deadline); err != nil {
-		m.pool.Put(conn, true)
+		pool.Put(conn, true)
 		return nil, common.Error(common.UnavailebleCode, err.Error())
 	}
 	// register response channel
@@ -252,15 +227,15 @@ func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	// write packet
 	if _, err := conn.Write(buf.Bytes()); err != nil {
 		m.removeCall(xstate, id)
-		m.pool.Put(conn, true)
+		pool.Put(conn, true)
 		return nil, common.Error(common.UnavailebleCode, err.Error())
 	}
 	// if is new conn, send heart
 	if isNewConn {
-		go m.sendHeartbeat(conn)
+		go m.sendHeartbeat(pool, conn)
 	}
 
-	m.pool.Put(conn, false)
+	pool.Put(conn, false)
 	if frame.GetStreamType() == api.RequestOneWay {
 		return &rpc.RPCResponse{Success: true}, nil
 	}
@@ -271,18 +246,22 @@ func (m *xChannel) Invoke(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 		if res.err != nil {
 			return nil, common.Error(common.UnavailebleCode, res.err.Error())
 		}
-		return m.proto.FromFrame(res.resp)
+		return m.fromFrame(res.resp, decode)
 	case <-ctx.Done():
 		m.removeCall(xstate, id)
 		return nil, common.Error(common.TimeoutCode, ErrTimeout.Error())
 	}
 }
 
+// Close closes the pools of the channel, it is called when the invoker is closed
+func (m *xChannel) Close() error {
+	m.pool.close()
+	m.targets.close()
+	return nil
+}
+
 // Do is handle RPCRequest to RPCResponse
 func (m *xChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
-	if _, ok := req.Header[rpc.TargetAddress]; ok && len(req.Header[rpc.TargetAddress]) > 0 {
-		return m.InvokeWithTargetAddress(req)
-	}
 	return m.Invoke(req)
 }
 
@@ -339,7 +318,7 @@ func (m *xChannel) cleanup(c *wrapConn, err error) {
 	xstate.mu.Unlock()
 }
 
-func (m *xChannel) sendHeartbeat(c *wrapConn) {
+func (m *xChannel) sendHeartbeat(pool *connPool, c *wrapConn) {
 	xstate := c.state.(*xstate)
 	request := &bolt.Request{
 		RequestHeader: bolt.RequestHeader{
@@ -371,6 +350,7 @@ func (m *xChannel) sendHeartbeat(c *wrapConn) {
 				return
 			}
 			if _, err := c.Write(buf.Bytes()); err != nil {
+				atomic.AddUint64(&pool.metrics.heartbeatFailures, 1)
 				failCount++
 				if failCount >= maxFailCount {
 					log.DefaultLogger.Errorf("[RPC][Runtime] Heartbeat response failed due to error: %+v. The number of consecutive failures (%d) exceeds the maximum allowed (%d). Closing the connection.", err, failCount, maxFailCount)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strconv"
 	"strings"
@@ -319,3 +320,69 @@ func TestReadloopError(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, string(resp.Data), "ok")
 }
+
+func TestChannelTargetAddress(t *testing.T) {
+	ts := &testserver{XProtocol: (&bolt.XCodec{}).NewXProtocol(context.TODO())}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer lis.Close()
+	var accepted int32
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&accepted, 1)
+			go ts.readLoop(conn)
+		}
+	}()
+
+	config := ChannelConfig{Size: 1, Protocol: proto, Ext: map[string]interface{}{"class": "xxx"}}
+	channel, err := newXChannel(config)
+	assert.Nil(t, err)
+	targets := channel.(*xChannel).targets
+
+	addr := lis.Addr().String()
+	for _, data := range []string{"echo 1", "timeout", "echo 2"} {
+		req := &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte(data), Timeout: 500, Header: rpc.RPCHeader{rpc.TargetAddress: {addr}}}
+		resp, err := channel.Do(req)
+		if data == "timeout" {
+			assert.True(t, strings.Contains(err.Error(), ErrTimeout.Error()))
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, data, string(resp.Data))
+	}
+	// the connection to the target is pooled
+	assert.Equal(t, int32(1), atomic.LoadInt32(&accepted))
+	assert.Len(t, targets.pools, 1)
+
+	// the idle pool is closed and unregistered
+	targets.mu.Lock()
+	targets.evictIdle(time.Now().Add(2 * targets.idleTimeout))
+	targets.mu.Unlock()
+	assert.Len(t, targets.pools, 0)
+	for _, s := range GetPoolStats() {
+		assert.NotEqual(t, addr, s.Target)
+	}
+}
+
+func TestChannelClose(t *testing.T) {
+	startTestServer()
+
+	config := ChannelConfig{Size: 1, Protocol: proto, Ext: map[string]interface{}{"class": "xxx"}}
+	channel, err := newXChannel(config)
+	assert.Nil(t, err)
+	req := &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte("hello world"), Timeout: 1000}
+	_, err = channel.Do(req)
+	assert.Nil(t, err)
+
+	assert.Nil(t, channel.(io.Closer).Close())
+	_, err = channel.Do(req)
+	assert.Error(t, err)
+	req.Header = rpc.RPCHeader{rpc.TargetAddress: {"127.0.0.1:1"}}
+	_, err = channel.Do(req)
+	assert.Error(t, err)
+	assert.Len(t, channel.(*xChannel).targets.pools, 0)
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mosn
+
+import (
+	"context"
+	"encoding/json"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+const (
+	defaultResolverCacheTTLMs = 5000
+
+	roundRobinPolicy     = "round_robin"
+	leastRequestPolicy   = "least_request"
+	consistentHashPolicy = "consistent_hash"
+
+	hashVirtualNodes = 100
+)
+
+// resolverConfig is the config of resolving the service ids to the target addresses
+type resolverConfig struct {
+	// Name is the name of the registered resolver, e.g. "nacos"
+	Name   string          `json:"name"`
+	Config json.RawMessage `json:"config"`
+	// CacheTTLMs is how long the resolved instances are cached
+	CacheTTLMs int `json:"cache_ttl_ms"`
+}
+
+// balancerConfig is the config of choosing an instance of a service
+type balancerConfig struct {
+	// Policy is one of round_robin, least_request and consistent_hash, round_robin by default
+	Policy string `json:"policy"`
+	// HashHeader is the header hashed by consistent_hash, the requests without it are balanced in round robin
+	HashHeader string `json:"hash_header"`
+}
+
+type resolved struct {
+	instances []resolver.Instance
+	expireAt  time.Time
+}
+
+// discovery chooses the target address of a request among the instances of the service.
+// The unhealthy instances and the ones whose circuit breakers are open are skipped.
+type discovery struct {
+	resolver resolver.Resolver
+	ttl      time.Duration
+	balancer balancer
+
+	mu    sync.Mutex
+	cache map[string]*resolved
+}
+
+func newDiscovery(rc *resolverConfig, bc *balancerConfig) (*discovery, error) {
+	if rc == nil {
+		return nil, nil
+	}
+	r, err := resolver.NewResolver(rc.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Init(rc.Config); err != nil {
+		return nil, err
+	}
+	b, err := newBalancer(bc)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	ttl := rc.CacheTTLMs
+	if ttl <= 0 {
+		ttl = defaultResolverCacheTTLMs
+	}
+	return &discovery{
+		resolver: r,
+		ttl:      time.Duration(ttl) * time.Millisecond,
+		balancer: b,
+		cache:    make(map[string]*resolved),
+	}, nil
+}
+
+// resolve returns the cached instances of the service, the stale ones are used if the resolver fails
+func (d *discovery) resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	d.mu.Lock()
+	cached, ok := d.cache[id]
+	d.mu.Unlock()
+	if ok && time.Now().Before(cached.expireAt) {
+		return cached.instances, nil
+	}
+	instances, err := d.resolver.Resolve(ctx, id)
+	if err != nil {
+		if ok {
+			log.DefaultLogger.Warnf("[runtime][rpc]failed to resolve %s, use the stale instances: %s", id, err.Error())
+			return cached.instances, nil
+		}
+		return nil, common.Errorf(common.UnavailebleCode, "failed to resolve %s: %s", id, err.Error())
+	}
+	d.mu.Lock()
+	d.cache[id] = &resolved{instances: instances, expireAt: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+	return instances, nil
+}
+
+// pick chooses the target address of the request
+func (d *discovery) pick(ctx context.Context, req *rpc.RPCRequest, breakers *breakerGroup) (string, error) {
+	instances, err := d.resolve(ctx, req.Id)
+	if err != nil {
+		return "", err
+	}
+	candidates := make([]resolver.Instance, 0, len(instances))
+	for _, ins := range instances {
+		if ins.Healthy && breakers.available(ins.Address) {
+			candidates = append(candidates, ins)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", common.Errorf(common.UnavailebleCode, "no available instance of %s", req.Id)
+	}
+	addr := d.balancer.pick(req, candidates)
+	d.balancer.start(addr)
+	return addr, nil
+}
+
+// done is called when the request to the address finishes
+func (d *discovery) done(addr string) {
+	d.balancer.done(addr)
+}
+
+// close closes the resolver
+func (d *discovery) close() {
+	d.resolver.Close()
+}
+
+// weight returns the weight of the instance, the instances without a positive weight count as 1
+func weight(ins resolver.Instance) int {
+	if ins.Weight <= 0 {
+		return 1
+	}
+	return ins.Weight
+}
+
+// balancer chooses an instance among the available ones by their weights
+type balancer interface {
+	pick(req *rpc.RPCRequest, instances []resolver.Instance) string
+	start(addr string)
+	done(addr string)
+}
+
+func newBalancer(config *balancerConfig) (balancer, error) {
+	if config == nil {
+		return &roundRobin{}, nil
+	}
+	switch config.Policy {
+	case "", roundRobinPolicy:
+		return &roundRobin{}, nil
+	case leastRequestPolicy:
+		return &leastRequest{active: make(map[string]int64)}, nil
+	case consistentHashPolicy:
+		if config.HashHeader == "" {
+			return nil, common.Error(common.InvalidArgsCode, "missing hash_header of consistent_hash")
+		}
+		return &consistentHash{header: config.HashHeader}, nil
+	}
+	return nil, common.Errorf(common.InvalidArgsCode, "unknown load balancing policy %s", config.Policy)
+}
+
+// roundRobin picks the instances in turn, an instance is picked as many times as its weight in a round
+type roundRobin struct {
+	next uint64
+}
+
+func (b *roundRobin) pick(req *rpc.RPCRequest, instances []resolver.Instance) string {
+	total := 0
+	for _, ins := range instances {
+		total += weight(ins)
+	}
+	n := int(atomic.AddUint64(&b.next, 1) % uint64(total))
+	for _, ins := range instances {
+		if n -= weight(ins); n < 0 {
+			return ins.Address
+		}
+	}
+	return instances[len(instances)-1].Address
+}
+
+func (b *roundRobin) start(addr string) {}
+
+func (b *roundRobin) done(addr string) {}
+
+// leastRequest picks the instance with the fewest active requests per weight
+type leastRequest struct {
+	roundRobin
+	mu     sync.Mutex
+	active map[string]int64
+}
+
+func (b *leastRequest) pick(req *rpc.RPCRequest, instances []resolver.Instance) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// start from a different instance each time, so the ties are broken in round robin
+	offset := int(atomic.AddUint64(&b.next, 1) % uint64(len(instances)))
+	best := instances[offset]
+	for i := 1; i < len(instances); i++ {
+		ins := instances[(offset+i)%len(instances)]
+		// active/weight < best active/best weight
+		if b.active[ins.Address]*int64(weight(best)) < b.active[best.Address]*int64(weight(ins)) {
+			best = ins
+		}
+	}
+	return best.Address
+}
+
+func (b *leastRequest) start(addr string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.active[addr]++
+}
+
+func (b *leastRequest) done(addr string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.active[addr]--; b.active[addr] <= 0 {
+		delete(b.active, addr)
+	}
+}
+
+// consistentHash picks the instance on a hash ring by the header value,
+// so the requests with the same value go to the same instance while the instances are unchanged.
+// An instance has virtual nodes on the ring in proportion to its weight
+type consistentHash struct {
+	roundRobin
+	header string
+
+	mu    sync.Mutex
+	key   string
+	ring  []uint32
+	addrs map[uint32]string
+}
+
+func (b *consistentHash) pick(req *rpc.RPCRequest, instances []resolver.Instance) string {
+	value := req.Header.Get(b.header)
+	if value == "" {
+		return b.roundRobin.pick(req, instances)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.build(instances)
+	h := crc32.ChecksumIEEE([]byte(value))
+	i := sort.Search(len(b.ring), func(i int) bool { return b.ring[i] >= h })
+	if i == len(b.ring) {
+		i = 0
+	}
+	return b.addrs[b.ring[i]]
+}
+
+// build rebuilds the ring if the instances are changed
+func (b *consistentHash) build(instances []resolver.Instance) {
+	weights := make(map[string]int, len(instances))
+	addrs := make([]string, 0, len(instances))
+	nodes := 0
+	for _, ins := range instances {
+		addrs = append(addrs, ins.Address+"*"+strconv.Itoa(weight(ins)))
+		weights[ins.Address] = weight(ins)
+		nodes += weight(ins) * hashVirtualNodes
+	}
+	sort.Strings(addrs)
+	key := strings.Join(addrs, ",")
+	if key == b.key {
+		return
+	}
+	b.key = key
+	b.ring = make([]uint32, 0, nodes)
+	b.addrs = make(map[uint32]string, nodes)
+	// the nodes are added in order, so the ring is the same for the same instances
+	sorted := make([]string, 0, len(weights))
+	for addr := range weights {
+		sorted = append(sorted, addr)
+	}
+	sort.Strings(sorted)
+	for _, addr := range sorted {
+		for i := 0; i < weights[addr]*hashVirtualNodes; i++ {
+			h := crc32.ChecksumIEEE([]byte(addr + "#" + strconv.Itoa(i)))
+			b.ring = append(b.ring, h)
+			b.addrs[h] = addr
+		}
+	}
+	sort.Slice(b.ring, func(i, j int) bool { return b.ring[i] < b.ring[j] })
+}
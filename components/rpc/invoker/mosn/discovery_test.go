@@ -0,0 +1,211 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mosn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+type fakeResolver struct {
+	instances map[string][]resolver.Instance
+	err       error
+	calls     int
+}
+
+func (r *fakeResolver) Init(config json.RawMessage) error {
+	return nil
+}
+
+func (r *fakeResolver) Resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	r.calls++
+	return r.instances[id], r.err
+}
+
+func (r *fakeResolver) Close() error {
+	return nil
+}
+
+var testResolver = &fakeResolver{}
+
+func init() {
+	resolver.RegisterResolver("fake", func() resolver.Resolver {
+		return testResolver
+	})
+}
+
+type addressChannel struct{}
+
+func (c *addressChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	return &rpc.RPCResponse{Data: []byte(req.Header.Get(rpc.TargetAddress))}, nil
+}
+
+func Test_mosnInvoker_Discovery(t *testing.T) {
+	testResolver.instances = map[string][]resolver.Instance{
+		"hello": {
+			{Address: "127.0.0.1:1", Healthy: true},
+			{Address: "127.0.0.1:2", Healthy: true},
+			{Address: "127.0.0.1:3", Healthy: false},
+		},
+	}
+	channel.RegistChannel("address", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return &addressChannel{}, nil
+	})
+	invoker := NewMosnInvoker()
+	conf := rpc.RpcConfig{
+		Config: []byte(`{"channel":[{"protocol":"address"}],"resolver":{"name":"fake"}}`),
+	}
+	assert.Nil(t, invoker.Init(conf))
+
+	invoke := func(header rpc.RPCHeader) string {
+		resp, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "hello", Method: "say", Header: header})
+		assert.Nil(t, err)
+		return string(resp.Data)
+	}
+	seen := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		seen[invoke(rpc.RPCHeader{})] = true
+	}
+	assert.Equal(t, map[string]bool{"127.0.0.1:1": true, "127.0.0.1:2": true}, seen)
+	// the target address specified by the caller is kept
+	assert.Equal(t, "127.0.0.1:9", invoke(rpc.RPCHeader{rpc.TargetAddress: {"127.0.0.1:9"}}))
+
+	_, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "unknown", Method: "say", Header: rpc.RPCHeader{}})
+	assert.Error(t, err)
+}
+
+func Test_discovery(t *testing.T) {
+	t.Run("cache", func(t *testing.T) {
+		r := &fakeResolver{instances: map[string][]resolver.Instance{"hello": {{Address: "a", Healthy: true}}}}
+		d := &discovery{resolver: r, ttl: time.Minute, balancer: &roundRobin{}, cache: map[string]*resolved{}}
+		for i := 0; i < 3; i++ {
+			addr, err := d.pick(context.Background(), &rpc.RPCRequest{Id: "hello"}, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, "a", addr)
+		}
+		assert.Equal(t, 1, r.calls)
+
+		// the stale instances are used if the resolver fails
+		d.cache["hello"].expireAt = time.Now()
+		r.err = errors.New("unavailable")
+		addr, err := d.pick(context.Background(), &rpc.RPCRequest{Id: "hello"}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "a", addr)
+	})
+
+	t.Run("skip open breakers", func(t *testing.T) {
+		r := &fakeResolver{instances: map[string][]resolver.Instance{"hello": {{Address: "a", Healthy: true}, {Address: "b", Healthy: true}}}}
+		d := &discovery{resolver: r, ttl: time.Minute, balancer: &roundRobin{}, cache: map[string]*resolved{}}
+		g := newBreakerGroup(&breakerConfig{FailureThreshold: 1})
+		g.get("a").done(false)
+		for i := 0; i < 3; i++ {
+			addr, err := d.pick(context.Background(), &rpc.RPCRequest{Id: "hello"}, g)
+			assert.Nil(t, err)
+			assert.Equal(t, "b", addr)
+		}
+		g.get("b").done(false)
+		_, err := d.pick(context.Background(), &rpc.RPCRequest{Id: "hello"}, g)
+		assert.Error(t, err)
+	})
+}
+
+func Test_balancer(t *testing.T) {
+	instances := []resolver.Instance{{Address: "a"}, {Address: "b"}, {Address: "c"}}
+
+	t.Run("least request", func(t *testing.T) {
+		b, err := newBalancer(&balancerConfig{Policy: leastRequestPolicy})
+		assert.Nil(t, err)
+		b.start("a")
+		b.start("b")
+		assert.Equal(t, "c", b.pick(&rpc.RPCRequest{}, instances))
+		b.start("c")
+		b.start("c")
+		b.done("a")
+		assert.Equal(t, "a", b.pick(&rpc.RPCRequest{}, instances))
+	})
+
+	t.Run("consistent hash", func(t *testing.T) {
+		b, err := newBalancer(&balancerConfig{Policy: consistentHashPolicy, HashHeader: "user"})
+		assert.Nil(t, err)
+		req := &rpc.RPCRequest{Header: rpc.RPCHeader{"user": {"layotto"}}}
+		addr := b.pick(req, instances)
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, addr, b.pick(req, instances))
+		}
+		// the other instances are not affected when an instance is removed
+		var rest []resolver.Instance
+		for _, ins := range instances {
+			if ins.Address != addr {
+				rest = append(rest, ins)
+			}
+		}
+		assert.NotEqual(t, addr, b.pick(req, rest))
+		assert.Equal(t, addr, b.pick(req, instances))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newBalancer(&balancerConfig{Policy: consistentHashPolicy})
+		assert.Error(t, err)
+		_, err = newBalancer(&balancerConfig{Policy: "random"})
+		assert.Error(t, err)
+	})
+}
+
+func Test_weightedBalancer(t *testing.T) {
+	instances := []resolver.Instance{{Address: "a", Weight: 3}, {Address: "b"}}
+
+	t.Run("round robin", func(t *testing.T) {
+		b := &roundRobin{}
+		picked := map[string]int{}
+		for i := 0; i < 8; i++ {
+			picked[b.pick(&rpc.RPCRequest{}, instances)]++
+		}
+		assert.Equal(t, map[string]int{"a": 6, "b": 2}, picked)
+	})
+
+	t.Run("least request", func(t *testing.T) {
+		b, err := newBalancer(&balancerConfig{Policy: leastRequestPolicy})
+		assert.Nil(t, err)
+		b.start("a")
+		b.start("a")
+		// 2/3 active requests per weight of a is fewer than 1/1 of b
+		b.start("b")
+		assert.Equal(t, "a", b.pick(&rpc.RPCRequest{}, instances))
+		b.start("a")
+		b.start("a")
+		assert.Equal(t, "b", b.pick(&rpc.RPCRequest{}, instances))
+	})
+
+	t.Run("consistent hash", func(t *testing.T) {
+		b := &consistentHash{header: "user"}
+		b.build(instances)
+		nodes := map[string]int{}
+		for _, addr := range b.addrs {
+			nodes[addr]++
+		}
+		assert.Equal(t, 3*hashVirtualNodes, nodes["a"])
+		assert.Equal(t, hashVirtualNodes, nodes["b"])
+	})
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"sync"
+
+	"mosn.io/layotto/components/pkg/actuators"
+)
+
+const (
+	componentName = "rpc-mosn"
+	breakersKey   = "circuit_breakers"
+)
+
+var (
+	once      sync.Once
+	indicator = &breakerIndicator{}
+)
+
+// breakerIndicator reports the states of the circuit breakers of all the mosn invokers in the details.
+// The unavailable targets never make layotto DOWN, since the sidecar serves the other requests well.
+type breakerIndicator struct {
+	mu     sync.Mutex
+	groups []*breakerGroup
+}
+
+func (i *breakerIndicator) register(g *breakerGroup) {
+	if g == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.groups = append(i.groups, g)
+}
+
+// unregister removes the breakers of a closed invoker
+func (i *breakerIndicator) unregister(g *breakerGroup) {
+	if g == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for idx, registered := range i.groups {
+		if registered == g {
+			i.groups = append(i.groups[:idx], i.groups[idx+1:]...)
+			return
+		}
+	}
+}
+
+func (i *breakerIndicator) Report() (status actuators.Status, details map[string]interface{}) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	states := make(map[string]breakerState)
+	for _, g := range i.groups {
+		for target, state := range g.states() {
+			states[target] = state
+		}
+	}
+	return actuators.UP, map[string]interface{}{breakersKey: states}
+}
+
+func registerIndicator() {
+	once.Do(func() {
+		actuators.SetComponentsIndicator(componentName, &actuators.ComponentsIndicator{
+			ReadinessIndicator: indicator,
+			LivenessIndicator:  indicator,
+		})
+	})
+}
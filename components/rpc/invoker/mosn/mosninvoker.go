@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strconv"
+	"sync"
 
 	// bridge to mosn
 	_ "mosn.io/mosn/pkg/filter/network/proxy"
 	"mosn.io/pkg/log"
 
+	"mosn.io/layotto/components/pkg/common"
 	"mosn.io/layotto/components/rpc"
 	"mosn.io/layotto/components/rpc/callback"
 	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
@@ -39,8 +41,12 @@ const (
 
 // mosnInvoker is Invoker implement
 type mosnInvoker struct {
-	channel rpc.Channel
-	cb      rpc.Callback
+	router    *router
+	acl       *accessControl
+	retry     *retryPolicy
+	breakers  *breakerGroup
+	discovery *discovery
+	cb        rpc.Callback
 }
 
 // mosnConfig is mosn config
@@ -48,10 +54,22 @@ type mosnConfig struct {
 	Before  []rpc.CallbackFunc      `json:"before_invoke"`
 	After   []rpc.CallbackFunc      `json:"after_invoke"`
 	Channel []channel.ChannelConfig `json:"channel"`
+	// Routes choose the channel of a request, the first channel is used if no route matches
+	Routes []routeConfig `json:"routes"`
+	// Retry and CircuitBreaker are disabled if not configured
+	Retry          *retryConfig   `json:"retry"`
+	CircuitBreaker *breakerConfig `json:"circuit_breaker"`
+	// Resolver resolves the service ids to the target addresses, the requests are sent to the listeners if not configured
+	Resolver     *resolverConfig `json:"resolver"`
+	LoadBalancer *balancerConfig `json:"load_balancer"`
+	// AccessControl is the egress policy of the local apps, it is checked before the callbacks.
+	// All the requests are allowed if not configured
+	AccessControl *aclConfig `json:"access_control"`
 }
 
 // NewMosnInvoker is init mosnInvoker
 func NewMosnInvoker() rpc.Invoker {
+	registerIndicator()
 	invoker := &mosnInvoker{cb: callback.NewCallback()}
 	return invoker
 }
@@ -70,17 +88,49 @@ func (m *mosnInvoker) Init(conf rpc.RpcConfig) error {
 	for _, after := range config.After {
 		m.cb.AddAfterInvoke(after)
 	}
+	// the invoker fails to init if a callback is not found or its config is invalid
+	if validator, ok := m.cb.(rpc.CallbackValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
 
 	if len(config.Channel) == 0 {
 		return errors.New("missing channel config")
 	}
 
-	// todo support multiple channel
-	channel, err := channel.GetChannel(config.Channel[0])
+	router, err := newRouter(config.Channel, config.Routes)
 	if err != nil {
 		return err
 	}
-	m.channel = channel
+	m.router = router
+
+	if m.acl, err = newAccessControl(config.AccessControl); err != nil {
+		router.close()
+		return err
+	}
+	if m.retry, err = newRetryPolicy(config.Retry); err != nil {
+		router.close()
+		return err
+	}
+	if m.discovery, err = newDiscovery(config.Resolver, config.LoadBalancer); err != nil {
+		router.close()
+		return err
+	}
+	m.breakers = newBreakerGroup(config.CircuitBreaker)
+	indicator.register(m.breakers)
+	return nil
+}
+
+// Close releases the invoker, it is called when the invoker is replaced or removed at runtime
+func (m *mosnInvoker) Close() error {
+	indicator.unregister(m.breakers)
+	if m.router != nil {
+		m.router.close()
+	}
+	if m.discovery != nil {
+		m.discovery.close()
+	}
 	return nil
 }
 
@@ -105,20 +155,25 @@ func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rp
 	}
 	req.Ctx = ctx
 	log.DefaultLogger.Debugf("[runtime][rpc]request %+v", req)
-	// 2. beforeInvoke callback
+	// 2. check access control
+	if err := m.acl.check(req); err != nil {
+		log.DefaultLogger.Errorf("[runtime][rpc]access denied %s", err.Error())
+		return nil, err
+	}
+	// 3. beforeInvoke callback
 	req, err = m.cb.BeforeInvoke(req)
 	if err != nil {
 		log.DefaultLogger.Errorf("[runtime][rpc]before filter error %s", err.Error())
 		return nil, err
 	}
-	// 3. do invocation
-	resp, err = m.channel.Do(req)
+	// 4. do invocation
+	resp, err = m.do(ctx, req)
 	if err != nil {
 		log.DefaultLogger.Errorf("[runtime][rpc]error %s", err.Error())
 		return nil, err
 	}
 	resp.Ctx = req.Ctx
-	// 4. afterInvoke callback
+	// 5. afterInvoke callback
 	resp, err = m.cb.AfterInvoke(resp)
 	if err != nil {
 		log.DefaultLogger.Errorf("[runtime][rpc]after filter error %s", err.Error())
@@ -126,3 +181,120 @@ func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rp
 	}
 	return resp, nil
 }
+
+// do sends the request through the routed channel, and retries it according to the retry policy.
+// The timeout of each attempt is limited by the deadline of ctx.
+func (m *mosnInvoker) do(ctx context.Context, req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	if req.Header == nil {
+		req.Header = rpc.RPCHeader{}
+	}
+	ch := m.router.route(req)
+	// the target address specified by the caller is kept
+	resolve := m.discovery != nil && req.Header.Get(rpc.TargetAddress) == ""
+	timeout := req.Timeout
+	m.retry.onRequest()
+	for attempt := 1; ; attempt++ {
+		if err := applyDeadline(ctx, req, timeout); err != nil {
+			return nil, err
+		}
+		resp, err := m.attempt(ctx, ch, req, resolve)
+		if err == nil {
+			return resp, nil
+		}
+		if !m.retry.shouldRetry(ctx, req, attempt, err) {
+			return nil, err
+		}
+		log.DefaultLogger.Warnf("[runtime][rpc]retry request %s %s after attempt %d failed: %s", req.Id, req.Method, attempt, err.Error())
+		if err := sleep(ctx, m.retry.backoff(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends the request once, to the instance chosen by the discovery if resolve is true
+func (m *mosnInvoker) attempt(ctx context.Context, ch rpc.Channel, req *rpc.RPCRequest, resolve bool) (*rpc.RPCResponse, error) {
+	if resolve {
+		addr, err := m.discovery.pick(ctx, req, m.breakers)
+		if err != nil {
+			return nil, err
+		}
+		defer m.discovery.done(addr)
+		req.Header[rpc.TargetAddress] = []string{addr}
+	}
+	target := targetOf(req)
+	b := m.breakers.get(target)
+	if b != nil && !b.allow() {
+		return nil, common.Errorf(common.UnavailebleCode, "circuit breaker of %s is open", target)
+	}
+	resp, err := ch.Do(req)
+	if b != nil {
+		b.done(!retryable(err))
+	}
+	return resp, err
+}
+
+// InvokeStream starts a stream with the service through the routed channel.
+// The before_invoke callbacks are applied to the request, but the after_invoke callbacks are not applied to the stream.
+func (m *mosnInvoker) InvokeStream(ctx context.Context, req *rpc.RPCRequest) (stream rpc.RPCStream, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("[runtime][rpc]mosn invoker panic: %v, stack info: %+v", r, string(debug.Stack()))
+			log.DefaultLogger.Errorf("%v", err)
+		}
+	}()
+
+	req.Ctx = ctx
+	if err := m.acl.check(req); err != nil {
+		log.DefaultLogger.Errorf("[runtime][rpc]access denied %s", err.Error())
+		return nil, err
+	}
+	req, err = m.cb.BeforeInvoke(req)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime][rpc]before filter error %s", err.Error())
+		return nil, err
+	}
+	ch, ok := m.router.route(req).(rpc.StreamChannel)
+	if !ok {
+		return nil, common.Errorf(common.InvalidArgsCode, "the channel of %s does not support streaming", req.Id)
+	}
+	if req.Header == nil {
+		req.Header = rpc.RPCHeader{}
+	}
+	done := func() {}
+	if m.discovery != nil && req.Header.Get(rpc.TargetAddress) == "" {
+		addr, err := m.discovery.pick(ctx, req, m.breakers)
+		if err != nil {
+			return nil, err
+		}
+		req.Header[rpc.TargetAddress] = []string{addr}
+		done = func() { m.discovery.done(addr) }
+	}
+	target := targetOf(req)
+	b := m.breakers.get(target)
+	if b != nil && !b.allow() {
+		done()
+		return nil, common.Errorf(common.UnavailebleCode, "circuit breaker of %s is open", target)
+	}
+	stream, err = ch.DoStream(req)
+	if b != nil {
+		b.done(!retryable(err))
+	}
+	if err != nil {
+		done()
+		log.DefaultLogger.Errorf("[runtime][rpc]error %s", err.Error())
+		return nil, err
+	}
+	return &trackedStream{RPCStream: stream, done: done}, nil
+}
+
+// trackedStream calls done once when the stream is closed
+type trackedStream struct {
+	rpc.RPCStream
+	once sync.Once
+	done func()
+}
+
+func (s *trackedStream) Close() error {
+	s.once.Do(s.done)
+	return s.RPCStream.Close()
+}
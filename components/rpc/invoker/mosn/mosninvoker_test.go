@@ -57,6 +57,16 @@ func Test_mosnInvoker_Init(t *testing.T) {
 		assert.Equal(t, "channel fake not found", err.Error())
 	})
 
+	t.Run("invalid callback", func(t *testing.T) {
+		invoker := NewMosnInvoker()
+		conf := rpc.RpcConfig{
+			Config: []byte(`{"before_invoke": [{"name":"hmac_sign","config":{}}], "channel": [{"protocol":"fake"}]}`),
+		}
+		err := invoker.Init(conf)
+		assert.NotNil(t, err)
+		assert.Equal(t, "init before filter hmac_sign err: missing secret of hmac_sign", err.Error())
+	})
+
 	t.Run("success", func(t *testing.T) {
 		channel.RegistChannel("fake", func(config channel.ChannelConfig) (rpc.Channel, error) {
 			return nil, nil
@@ -143,3 +153,35 @@ func (c *fakeChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 
 	return rsp, nil
 }
+
+type fakeStreamChannel struct {
+	fakeChannel
+	req *rpc.RPCRequest
+}
+
+func (c *fakeStreamChannel) DoStream(req *rpc.RPCRequest) (rpc.RPCStream, error) {
+	c.req = req
+	return nil, nil
+}
+
+func Test_mosnInvoker_InvokeStream(t *testing.T) {
+	ch := &fakeStreamChannel{}
+	channel.RegistChannel("fake_stream", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return ch, nil
+	})
+	channel.RegistChannel("fake", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return &fakeChannel{}, nil
+	})
+	invoker := NewMosnInvoker()
+	conf := rpc.RpcConfig{
+		Config: []byte(`{"channel": [{"protocol":"fake_stream"},{"protocol":"fake"}],"routes":[{"service":"unary","channel":"fake"}]}`),
+	}
+	assert.Nil(t, invoker.Init(conf))
+
+	_, err := invoker.(rpc.StreamInvoker).InvokeStream(context.Background(), &rpc.RPCRequest{Id: "stream", Method: "Feed", Header: rpc.RPCHeader{}})
+	assert.Nil(t, err)
+	assert.Equal(t, "Feed", ch.req.Method)
+
+	_, err = invoker.(rpc.StreamInvoker).InvokeStream(context.Background(), &rpc.RPCRequest{Id: "unary", Method: "Feed", Header: rpc.RPCHeader{}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	defaultRetryBackoffMs    = 100
+	defaultRetryMaxBackoffMs = 1000
+	defaultRetryBudgetRatio  = 0.2
+	defaultRetryMinPerWindow = 10
+	retryBudgetWindow        = 10 * time.Second
+)
+
+// retryConfig is the retry policy of the invoker.
+// Only the requests whose method matches one of the Methods patterns are retried, so they must be idempotent.
+type retryConfig struct {
+	// MaxAttempts is the max number of attempts including the first one
+	MaxAttempts  int      `json:"max_attempts"`
+	Methods      []string `json:"methods"`
+	BackoffMs    int      `json:"backoff_ms"`
+	MaxBackoffMs int      `json:"max_backoff_ms"`
+	// BudgetRatio limits the retries to a ratio of the requests in a window of 10 seconds,
+	// MinRetriesPerWindow retries are always allowed in a window.
+	BudgetRatio         float64 `json:"budget_ratio"`
+	MinRetriesPerWindow int     `json:"min_retries_per_window"`
+}
+
+// retryPolicy decides whether a failed request should be retried. A nil retryPolicy never retries.
+type retryPolicy struct {
+	retryConfig
+	budget *retryBudget
+}
+
+func newRetryPolicy(config *retryConfig) (*retryPolicy, error) {
+	if config == nil || config.MaxAttempts <= 1 {
+		return nil, nil
+	}
+	for _, m := range config.Methods {
+		if err := validPattern(m); err != nil {
+			return nil, err
+		}
+	}
+	c := *config
+	if c.BackoffMs <= 0 {
+		c.BackoffMs = defaultRetryBackoffMs
+	}
+	if c.MaxBackoffMs < c.BackoffMs {
+		c.MaxBackoffMs = defaultRetryMaxBackoffMs
+		if c.MaxBackoffMs < c.BackoffMs {
+			c.MaxBackoffMs = c.BackoffMs
+		}
+	}
+	if c.BudgetRatio <= 0 {
+		c.BudgetRatio = defaultRetryBudgetRatio
+	}
+	if c.MinRetriesPerWindow <= 0 {
+		c.MinRetriesPerWindow = defaultRetryMinPerWindow
+	}
+	return &retryPolicy{
+		retryConfig: c,
+		budget:      &retryBudget{ratio: c.BudgetRatio, min: c.MinRetriesPerWindow},
+	}, nil
+}
+
+// onRequest records a request in the retry budget
+func (p *retryPolicy) onRequest() {
+	if p == nil {
+		return
+	}
+	p.budget.deposit()
+}
+
+// shouldRetry checks whether the request can be sent again after the attempt failed with err
+func (p *retryPolicy) shouldRetry(ctx context.Context, req *rpc.RPCRequest, attempt int, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if req.Header.Get(rpc.RequestType) == rpc.Oneway || !retryable(err) || !p.idempotent(req.Method) {
+		return false
+	}
+	return p.budget.withdraw()
+}
+
+// backoff returns the time to wait before the next attempt, which grows exponentially
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.BackoffMs
+	for i := 1; i < attempt && backoff < p.MaxBackoffMs; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoffMs {
+		backoff = p.MaxBackoffMs
+	}
+	return time.Duration(backoff) * time.Millisecond
+}
+
+func (p *retryPolicy) idempotent(method string) bool {
+	for _, pattern := range p.Methods {
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// retryable reports whether the error is caused by the network or the target rather than the request itself
+func retryable(err error) bool {
+	var e common.CommonError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.Code() == common.UnavailebleCode || e.Code() == common.TimeoutCode
+}
+
+// retryBudget limits the retries to avoid retry storms when the targets are overloaded
+type retryBudget struct {
+	mu       sync.Mutex
+	ratio    float64
+	min      int
+	start    time.Time
+	requests int
+	retries  int
+}
+
+func (b *retryBudget) roll(now time.Time) {
+	if now.Sub(b.start) >= retryBudgetWindow {
+		b.start = now
+		b.requests = 0
+		b.retries = 0
+	}
+}
+
+func (b *retryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(time.Now())
+	b.requests++
+}
+
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(time.Now())
+	if b.retries >= b.min && float64(b.retries) >= b.ratio*float64(b.requests) {
+		return false
+	}
+	b.retries++
+	return true
+}
+
+// applyDeadline sets the timeout of the attempt to the smaller one of the request timeout and the context deadline
+func applyDeadline(ctx context.Context, req *rpc.RPCRequest, timeout int32) error {
+	req.Timeout = timeout
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+	remaining := time.Until(deadline).Milliseconds()
+	if remaining <= 0 {
+		return common.Error(common.TimeoutCode, context.DeadlineExceeded.Error())
+	}
+	if remaining < int64(timeout) {
+		req.Timeout = int32(remaining)
+	}
+	return nil
+}
+
+// sleep waits for d, it returns an error if the context is done before that
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return common.Error(common.TimeoutCode, fmt.Sprintf("retry canceled: %v", ctx.Err()))
+	}
+}
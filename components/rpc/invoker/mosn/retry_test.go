@@ -0,0 +1,137 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mosn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+type flakyChannel struct {
+	failures int
+	calls    int
+	timeouts []int32
+}
+
+func (c *flakyChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	c.calls++
+	c.timeouts = append(c.timeouts, req.Timeout)
+	if c.calls <= c.failures {
+		return nil, common.Error(common.UnavailebleCode, "connection refused")
+	}
+	return &rpc.RPCResponse{Data: []byte("ok")}, nil
+}
+
+func newFlakyInvoker(t *testing.T, ch *flakyChannel, config string) rpc.Invoker {
+	channel.RegistChannel("flaky", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return ch, nil
+	})
+	invoker := NewMosnInvoker()
+	assert.Nil(t, invoker.Init(rpc.RpcConfig{Config: []byte(config)}))
+	return invoker
+}
+
+func Test_mosnInvoker_Retry(t *testing.T) {
+	config := `{"channel":[{"protocol":"flaky"}],"retry":{"max_attempts":3,"methods":["get*"],"backoff_ms":1}}`
+
+	t.Run("retry idempotent method", func(t *testing.T) {
+		ch := &flakyChannel{failures: 2}
+		invoker := newFlakyInvoker(t, ch, config)
+		resp, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "s", Method: "getUser", Header: rpc.RPCHeader{}})
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", string(resp.Data))
+		assert.Equal(t, 3, ch.calls)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		ch := &flakyChannel{failures: 3}
+		invoker := newFlakyInvoker(t, ch, config)
+		_, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "s", Method: "getUser", Header: rpc.RPCHeader{}})
+		assert.Error(t, err)
+		assert.Equal(t, 3, ch.calls)
+	})
+
+	t.Run("not idempotent", func(t *testing.T) {
+		ch := &flakyChannel{failures: 1}
+		invoker := newFlakyInvoker(t, ch, config)
+		_, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "s", Method: "createUser", Header: rpc.RPCHeader{}})
+		assert.Error(t, err)
+		assert.Equal(t, 1, ch.calls)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		ch := &flakyChannel{failures: 1}
+		invoker := newFlakyInvoker(t, ch, config)
+		header := rpc.RPCHeader{rpc.RequestType: {rpc.Oneway}}
+		_, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: "s", Method: "getUser", Header: header})
+		assert.Error(t, err)
+		assert.Equal(t, 1, ch.calls)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ch := &flakyChannel{}
+		invoker := newFlakyInvoker(t, ch, config)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := invoker.Invoke(ctx, &rpc.RPCRequest{Id: "s", Method: "getUser", Timeout: 5000, Header: rpc.RPCHeader{}})
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, ch.timeouts[0], int32(1000))
+
+		ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+		_, err = invoker.Invoke(ctx, &rpc.RPCRequest{Id: "s", Method: "getUser", Header: rpc.RPCHeader{}})
+		assert.Error(t, err)
+		assert.Equal(t, 1, ch.calls)
+	})
+}
+
+func Test_retryPolicy(t *testing.T) {
+	p, err := newRetryPolicy(&retryConfig{MaxAttempts: 5, Methods: []string{"*"}, BackoffMs: 10, MaxBackoffMs: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, 10*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 20*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 50*time.Millisecond, p.backoff(4))
+
+	// disabled
+	p, err = newRetryPolicy(&retryConfig{MaxAttempts: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, p)
+
+	_, err = newRetryPolicy(&retryConfig{MaxAttempts: 2, Methods: []string{"["}})
+	assert.Error(t, err)
+
+	assert.False(t, retryable(errors.New("unknown")))
+	assert.False(t, retryable(common.Error(common.InternalCode, "encode")))
+	assert.True(t, retryable(common.Error(common.TimeoutCode, "timeout")))
+}
+
+func Test_retryBudget(t *testing.T) {
+	b := &retryBudget{ratio: 0.5, min: 1}
+	for i := 0; i < 4; i++ {
+		b.deposit()
+	}
+	assert.True(t, b.withdraw())
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw())
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"fmt"
+	"io"
+	"path"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+// routeConfig is a routing rule, a request matching all the non-empty conditions is sent to the channel.
+// Service and Method are patterns in the syntax of path.Match, e.g. "com.alipay.*"
+type routeConfig struct {
+	Service string            `json:"service"`
+	Method  string            `json:"method"`
+	Header  map[string]string `json:"header"`
+	Channel string            `json:"channel"`
+}
+
+type route struct {
+	routeConfig
+	channel rpc.Channel
+}
+
+// match checks whether the request matches the route
+func (r *route) match(req *rpc.RPCRequest) bool {
+	if r.Service != "" {
+		if ok, _ := path.Match(r.Service, req.Id); !ok {
+			return false
+		}
+	}
+	if r.Method != "" {
+		if ok, _ := path.Match(r.Method, req.Method); !ok {
+			return false
+		}
+	}
+	for k, v := range r.Header {
+		if req.Header.Get(k) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// router dispatches requests to the channels according to the routing rules
+type router struct {
+	routes   []*route
+	fallback rpc.Channel
+	channels []rpc.Channel
+}
+
+func newRouter(configs []channel.ChannelConfig, routes []routeConfig) (*router, error) {
+	channels := make(map[string]rpc.Channel, len(configs))
+	r := &router{}
+	var err error
+	// the created channels are closed if the config is invalid
+	defer func() {
+		if err != nil {
+			r.close()
+		}
+	}()
+	for i, conf := range configs {
+		name := conf.Name
+		if name == "" {
+			name = conf.Protocol
+		}
+		if _, ok := channels[name]; ok {
+			err = fmt.Errorf("duplicate channel %s", name)
+			return nil, err
+		}
+		var ch rpc.Channel
+		if ch, err = channel.GetChannel(conf); err != nil {
+			return nil, err
+		}
+		channels[name] = ch
+		r.channels = append(r.channels, ch)
+		if i == 0 {
+			r.fallback = ch
+		}
+	}
+	for _, conf := range routes {
+		if err = validPattern(conf.Service); err != nil {
+			return nil, err
+		}
+		if err = validPattern(conf.Method); err != nil {
+			return nil, err
+		}
+		ch, ok := channels[conf.Channel]
+		if !ok {
+			err = fmt.Errorf("route to channel %s not found", conf.Channel)
+			return nil, err
+		}
+		r.routes = append(r.routes, &route{routeConfig: conf, channel: ch})
+	}
+	return r, nil
+}
+
+// route returns the channel of the first matched route, or the first channel if none matches
+func (r *router) route(req *rpc.RPCRequest) rpc.Channel {
+	for _, rt := range r.routes {
+		if rt.match(req) {
+			return rt.channel
+		}
+	}
+	return r.fallback
+}
+
+// close closes the channels holding connections
+func (r *router) close() {
+	for _, ch := range r.channels {
+		if c, ok := ch.(io.Closer); ok {
+			c.Close()
+		}
+	}
+}
+
+func validPattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid route pattern %s: %v", pattern, err)
+	}
+	return nil
+}
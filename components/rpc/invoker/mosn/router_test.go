@@ -0,0 +1,101 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+type namedChannel struct {
+	name string
+}
+
+func (c *namedChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	return &rpc.RPCResponse{Data: []byte(c.name)}, nil
+}
+
+func registNamedChannels() {
+	for _, proto := range []string{"fake_bolt", "fake_dubbo", "fake_http"} {
+		channel.RegistChannel(proto, func(config channel.ChannelConfig) (rpc.Channel, error) {
+			return &namedChannel{name: config.Name}, nil
+		})
+	}
+}
+
+func Test_mosnInvoker_Routes(t *testing.T) {
+	registNamedChannels()
+	invoker := NewMosnInvoker()
+	conf := rpc.RpcConfig{
+		Config: []byte(`{
+			"channel": [
+				{"name":"bolt","protocol":"fake_bolt"},
+				{"name":"dubbo","protocol":"fake_dubbo"},
+				{"name":"http","protocol":"fake_http"}
+			],
+			"routes": [
+				{"service":"org.apache.dubbo.*","channel":"dubbo"},
+				{"method":"/api/*","channel":"http"},
+				{"header":{"protocol":"http"},"channel":"http"}
+			]
+		}`),
+	}
+	assert.Nil(t, invoker.Init(conf))
+
+	invoke := func(id, method string, header rpc.RPCHeader) string {
+		if header == nil {
+			header = rpc.RPCHeader{}
+		}
+		resp, err := invoker.Invoke(context.Background(), &rpc.RPCRequest{Id: id, Method: method, Header: header})
+		assert.Nil(t, err)
+		return string(resp.Data)
+	}
+	assert.Equal(t, "dubbo", invoke("org.apache.dubbo.HelloService", "sayHello", nil))
+	assert.Equal(t, "http", invoke("hello", "/api/hello", nil))
+	assert.Equal(t, "http", invoke("hello", "sayHello", rpc.RPCHeader{"protocol": {"http"}}))
+	// fallback to the first channel
+	assert.Equal(t, "bolt", invoke("com.alipay.HelloService", "sayHello", nil))
+}
+
+func Test_newRouter(t *testing.T) {
+	registNamedChannels()
+
+	t.Run("default name", func(t *testing.T) {
+		_, err := newRouter([]channel.ChannelConfig{{Protocol: "fake_bolt"}}, []routeConfig{{Channel: "fake_bolt"}})
+		assert.Nil(t, err)
+	})
+
+	t.Run("duplicate channel", func(t *testing.T) {
+		_, err := newRouter([]channel.ChannelConfig{{Protocol: "fake_bolt"}, {Protocol: "fake_bolt"}}, nil)
+		assert.Equal(t, "duplicate channel fake_bolt", err.Error())
+	})
+
+	t.Run("channel not found", func(t *testing.T) {
+		_, err := newRouter([]channel.ChannelConfig{{Protocol: "fake_bolt"}}, []routeConfig{{Channel: "dubbo"}})
+		assert.Equal(t, "route to channel dubbo not found", err.Error())
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := newRouter([]channel.ChannelConfig{{Protocol: "fake_bolt"}}, []routeConfig{{Service: "[", Channel: "fake_bolt"}})
+		assert.Error(t, err)
+	})
+}
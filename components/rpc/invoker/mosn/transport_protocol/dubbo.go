@@ -56,6 +56,36 @@ func (d *dubboProtocol) ToFrame(req *rpc.RPCRequest) api.XFrame {
 	return dubboReq
 }
 
+// EncodePayload encodes the json arguments to a dubbo request with hessian2 serialization,
+// the requests whose content type is not json are sent as they are
+func (d *dubboProtocol) EncodePayload(req *rpc.RPCRequest) ([]byte, bool, error) {
+	if !isJSONRequest(req) {
+		return nil, false, nil
+	}
+	data, err := encodeJSONRequest(req)
+	if err != nil {
+		return nil, false, common.Error(common.InvalidArgsCode, err.Error())
+	}
+	return data, true, nil
+}
+
+// DecodePayload decodes the hessian2 result of a json request to json, the attachments are set to the headers
+func (d *dubboProtocol) DecodePayload(resp *rpc.RPCResponse) error {
+	data, attachments, err := decodeJSONResponse(resp.Data)
+	if err != nil {
+		return common.Error(common.InternalCode, err.Error())
+	}
+	if resp.Header == nil {
+		resp.Header = rpc.RPCHeader{}
+	}
+	for k, v := range attachments {
+		resp.Header[k] = []string{v}
+	}
+	resp.Data = data
+	resp.ContentType = jsonContentType
+	return nil
+}
+
 // FromFrame is dubboProtocol transform
 func (d *dubboProtocol) FromFrame(resp api.XRespFrame) (*rpc.RPCResponse, error) {
 	if resp.GetStatusCode() != dubbo.RespStatusOK {
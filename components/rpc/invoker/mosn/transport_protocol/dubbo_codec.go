@@ -0,0 +1,323 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transport_protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	hessian "github.com/apache/dubbo-go-hessian2"
+	"mosn.io/mosn/pkg/protocol/xprotocol/dubbo"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/internal/hessianjson"
+)
+
+const (
+	// ParameterTypesHeader is the header of the java types of the json arguments separated by commas,
+	// e.g. "java.lang.String,int,com.example.User". The types are inferred from the arguments if it is not set.
+	ParameterTypesHeader = "parameter_types"
+	// classKey in a json object specifies the java class of the object
+	classKey = "class"
+
+	jsonContentType = "application/json"
+	dubboVersion    = "2.0.2"
+
+	flagRequest      = 0x80
+	flagTwoWay       = 0x40
+	hessian2SerialID = 2
+)
+
+// the flags of the dubbo response body
+const (
+	responseWithException = iota
+	responseValue
+	responseNullValue
+	responseWithExceptionWithAttachments
+	responseValueWithAttachments
+	responseNullValueWithAttachments
+)
+
+var primitiveDescriptors = map[string]string{
+	"void":    "V",
+	"boolean": "Z",
+	"byte":    "B",
+	"char":    "C",
+	"short":   "S",
+	"int":     "I",
+	"long":    "J",
+	"float":   "F",
+	"double":  "D",
+}
+
+// isJSONRequest checks whether the request data is the json arguments rather than an encoded dubbo frame
+func isJSONRequest(req *rpc.RPCRequest) bool {
+	if !strings.HasPrefix(req.ContentType, jsonContentType) {
+		return false
+	}
+	return len(req.Data) < 2 || req.Data[0] != dubbo.MagicTag[0] || req.Data[1] != dubbo.MagicTag[1]
+}
+
+// encodeJSONRequest encodes the request whose data is a json array of the arguments to a dubbo frame with hessian2 serialization.
+// The service is the request id, the version and group are set by the headers.
+func encodeJSONRequest(req *rpc.RPCRequest) ([]byte, error) {
+	args, err := decodeJSONArgs(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	var types []string
+	if header := req.Header.Get(ParameterTypesHeader); header != "" {
+		for _, t := range strings.Split(header, ",") {
+			types = append(types, strings.TrimSpace(t))
+		}
+		if len(types) != len(args) {
+			return nil, fmt.Errorf("%d parameter types are specified for %d arguments", len(types), len(args))
+		}
+	} else {
+		for _, arg := range args {
+			types = append(types, javaTypeOf(arg))
+		}
+	}
+	version := req.Header.Get(dubbo.VersionNameHeader)
+	group := req.Header.Get(dubbo.GroupNameHeader)
+
+	encoder := hessian.NewEncoder()
+	for _, v := range []string{dubboVersion, req.Id, version, req.Method, javaDescriptor(types)} {
+		if err := encoder.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+	for i, arg := range args {
+		if err := encodeHessian(encoder, toJavaValue(types[i], arg)); err != nil {
+			return nil, err
+		}
+	}
+	attachments := map[interface{}]interface{}{
+		"path":      req.Id,
+		"interface": req.Id,
+	}
+	if version != "" {
+		attachments["version"] = version
+	}
+	if group != "" {
+		attachments["group"] = group
+	}
+	if err := encoder.Encode(attachments); err != nil {
+		return nil, err
+	}
+
+	body := encoder.Buffer()
+	frame := make([]byte, dubbo.HeaderLen, dubbo.HeaderLen+len(body))
+	copy(frame, dubbo.MagicTag)
+	frame[dubbo.FlagIdx] = flagRequest | hessian2SerialID
+	if req.Header.Get(rpc.RequestType) != rpc.Oneway {
+		frame[dubbo.FlagIdx] |= flagTwoWay
+	}
+	binary.BigEndian.PutUint32(frame[dubbo.DataLenIdx:], uint32(len(body)))
+	return append(frame, body...), nil
+}
+
+// decodeJSONArgs decodes the json array of the arguments, an empty data means no arguments
+func decodeJSONArgs(data []byte) ([]interface{}, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var args []interface{}
+	if err := decoder.Decode(&args); err != nil {
+		return nil, fmt.Errorf("json array of the arguments is required: %s", err.Error())
+	}
+	for i := range args {
+		args[i] = hessianjson.FromJSONValue(args[i])
+	}
+	return args, nil
+}
+
+// decodeJSONResponse decodes the hessian2 body of the dubbo response to the json result and the attachments
+func decodeJSONResponse(body []byte) ([]byte, map[string]string, error) {
+	decoder := hessian.NewDecoder(body)
+	flag, err := decoder.Decode()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid dubbo response: %s", err.Error())
+	}
+	var result, exception interface{}
+	switch toInt(flag) {
+	case responseValue, responseValueWithAttachments:
+		if result, err = decoder.Decode(); err != nil {
+			return nil, nil, err
+		}
+	case responseWithException, responseWithExceptionWithAttachments:
+		if exception, err = decoder.Decode(); err != nil {
+			return nil, nil, err
+		}
+	case responseNullValue, responseNullValueWithAttachments:
+	default:
+		return nil, nil, fmt.Errorf("unknown dubbo response flag %v", flag)
+	}
+	attachments := make(map[string]string)
+	if f := toInt(flag); f >= responseWithExceptionWithAttachments {
+		v, err := decoder.Decode()
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if m, ok := v.(map[interface{}]interface{}); ok {
+			for k, item := range m {
+				attachments[fmt.Sprint(k)] = fmt.Sprint(item)
+			}
+		}
+	}
+	if exception != nil {
+		return nil, attachments, fmt.Errorf("dubbo exception: %v", exception)
+	}
+	data, err := json.Marshal(hessianjson.ToJSONValue(result))
+	return data, attachments, err
+}
+
+func toInt(v interface{}) int {
+	switch i := v.(type) {
+	case int32:
+		return int(i)
+	case int64:
+		return int(i)
+	}
+	return -1
+}
+
+// javaTypeOf infers the java type of the json value
+func javaTypeOf(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return "java.lang.String"
+	case bool:
+		return "boolean"
+	case int64:
+		return "long"
+	case float64:
+		return "double"
+	case []interface{}:
+		return "java.util.List"
+	case map[string]interface{}:
+		if class, ok := value[classKey].(string); ok && class != "" {
+			return class
+		}
+		return "java.util.Map"
+	}
+	return "java.lang.Object"
+}
+
+// javaDescriptor converts the java types to the parameter descriptor of dubbo, e.g. "Ljava/lang/String;I"
+func javaDescriptor(types []string) string {
+	var b strings.Builder
+	for _, t := range types {
+		for strings.HasSuffix(t, "[]") {
+			b.WriteByte('[')
+			t = strings.TrimSuffix(t, "[]")
+		}
+		if d, ok := primitiveDescriptors[t]; ok {
+			b.WriteString(d)
+			continue
+		}
+		b.WriteString("L" + strings.ReplaceAll(t, ".", "/") + ";")
+	}
+	return b.String()
+}
+
+// toJavaValue converts the json value to the hessian2 value of the java type
+func toJavaValue(t string, v interface{}) interface{} {
+	switch t {
+	case "int", "java.lang.Integer", "short", "java.lang.Short", "byte", "java.lang.Byte":
+		switch n := v.(type) {
+		case int64:
+			return int32(n)
+		case float64:
+			return int32(n)
+		}
+	case "long", "java.lang.Long":
+		if n, ok := v.(float64); ok {
+			return int64(n)
+		}
+	case "float", "java.lang.Float", "double", "java.lang.Double":
+		if n, ok := v.(int64); ok {
+			return float64(n)
+		}
+	case "char", "java.lang.Character", "java.lang.String":
+		if n, ok := v.(int64); ok {
+			return fmt.Sprint(n)
+		}
+	default:
+		// the java class of an object is the parameter type if it is not specified
+		if m, ok := v.(map[string]interface{}); ok && !strings.HasPrefix(t, "java.") {
+			if _, ok := m[classKey]; !ok {
+				m[classKey] = t
+			}
+		}
+	}
+	return v
+}
+
+// encodeHessian encodes the value, the json objects with the class key are encoded as typed maps,
+// which are deserialized to the objects of the class by java
+func encodeHessian(e *hessian.Encoder, v interface{}) error {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		class, _ := value[classKey].(string)
+		if class != "" {
+			e.Append([]byte{hessian.BC_MAP})
+			if err := e.Encode(class); err != nil {
+				return err
+			}
+		} else {
+			e.Append([]byte{hessian.BC_MAP_UNTYPED})
+		}
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			if k == classKey && class != "" {
+				continue
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := e.Encode(k); err != nil {
+				return err
+			}
+			if err := encodeHessian(e, value[k]); err != nil {
+				return err
+			}
+		}
+		e.Append([]byte{hessian.BC_END})
+		return nil
+	case []interface{}:
+		e.Append([]byte{hessian.BC_LIST_FIXED_UNTYPED})
+		if err := e.Encode(int32(len(value))); err != nil {
+			return err
+		}
+		for _, item := range value {
+			if err := encodeHessian(e, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return e.Encode(v)
+}
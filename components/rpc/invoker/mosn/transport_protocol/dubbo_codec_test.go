@@ -0,0 +1,158 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transport_protocol
+
+import (
+	"context"
+	"testing"
+
+	hessian "github.com/apache/dubbo-go-hessian2"
+	"github.com/stretchr/testify/assert"
+	"mosn.io/mosn/pkg/protocol/xprotocol/dubbo"
+	"mosn.io/pkg/buffer"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_javaDescriptor(t *testing.T) {
+	assert.Equal(t, "", javaDescriptor(nil))
+	assert.Equal(t, "Ljava/lang/String;IJ[I[[Lcom/example/User;",
+		javaDescriptor([]string{"java.lang.String", "int", "long", "int[]", "com.example.User[][]"}))
+}
+
+func Test_dubboProtocol_EncodePayload(t *testing.T) {
+	d := newDubboProtocol().(*dubboProtocol)
+
+	t.Run("not json", func(t *testing.T) {
+		_, ok, err := d.EncodePayload(&rpc.RPCRequest{Data: buildDubboRequestData(1)})
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		_, ok, err = d.EncodePayload(&rpc.RPCRequest{ContentType: jsonContentType, Data: buildDubboRequestData(1)})
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := d.EncodePayload(&rpc.RPCRequest{ContentType: jsonContentType, Data: []byte(`{}`)})
+		assert.Error(t, err)
+		_, _, err = d.EncodePayload(&rpc.RPCRequest{
+			ContentType: jsonContentType,
+			Data:        []byte(`["a"]`),
+			Header:      rpc.RPCHeader{ParameterTypesHeader: {"int,int"}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		req := &rpc.RPCRequest{
+			Id:          "com.example.UserService",
+			Method:      "update",
+			ContentType: jsonContentType,
+			Data:        []byte(`["alice", 18, {"name":"alice","tags":["a"]}, 1.5]`),
+			Header: rpc.RPCHeader{
+				ParameterTypesHeader:    {"java.lang.String, int, com.example.User, double"},
+				dubbo.VersionNameHeader: {"1.0.0"},
+				dubbo.GroupNameHeader:   {"test"},
+			},
+		}
+		data, ok, err := d.EncodePayload(req)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		frame := decodeDubboFrame(t, d, data)
+		assert.Equal(t, "com.example.UserService", frame.Header.CommonHeader[dubbo.ServiceNameHeader])
+		assert.Equal(t, "update", frame.Header.CommonHeader[dubbo.MethodNameHeader])
+		assert.Equal(t, "1.0.0", frame.Header.CommonHeader[dubbo.VersionNameHeader])
+		assert.True(t, frame.IsTwoWay)
+
+		decoder := hessian.NewDecoder(frame.GetData().Bytes())
+		var values []interface{}
+		for i := 0; i < 9; i++ {
+			v, err := decoder.Decode()
+			assert.Nil(t, err)
+			values = append(values, v)
+		}
+		assert.Equal(t, []interface{}{"2.0.2", "com.example.UserService", "1.0.0", "update",
+			"Ljava/lang/String;ILcom/example/User;D", "alice", int32(18)}, values[:7])
+		user := values[7].(map[interface{}]interface{})
+		assert.Equal(t, "alice", user["name"])
+		assert.Equal(t, []interface{}{"a"}, user["tags"])
+		assert.Equal(t, 1.5, values[8])
+		attachments, err := decoder.Decode()
+		assert.Nil(t, err)
+		assert.Equal(t, "test", attachments.(map[interface{}]interface{})["group"])
+	})
+
+	t.Run("infer types", func(t *testing.T) {
+		req := &rpc.RPCRequest{
+			Id:          "com.example.UserService",
+			Method:      "find",
+			ContentType: jsonContentType,
+			Data:        []byte(`["alice", 1, true, {"class":"com.example.Query"}, [1]]`),
+			Header:      rpc.RPCHeader{rpc.RequestType: {rpc.Oneway}},
+		}
+		data, ok, err := d.EncodePayload(req)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		frame := decodeDubboFrame(t, d, data)
+		assert.False(t, frame.IsTwoWay)
+
+		decoder := hessian.NewDecoder(frame.GetData().Bytes())
+		for i := 0; i < 4; i++ {
+			decoder.Decode()
+		}
+		desc, _ := decoder.Decode()
+		assert.Equal(t, "Ljava/lang/String;JZLcom/example/Query;Ljava/util/List;", desc)
+	})
+}
+
+func Test_dubboProtocol_DecodePayload(t *testing.T) {
+	d := newDubboProtocol().(*dubboProtocol)
+	encode := func(values ...interface{}) []byte {
+		encoder := hessian.NewEncoder()
+		for _, v := range values {
+			assert.Nil(t, encoder.Encode(v))
+		}
+		return encoder.Buffer()
+	}
+
+	resp := &rpc.RPCResponse{Data: encode(int32(responseValueWithAttachments),
+		map[interface{}]interface{}{"name": "alice", "age": int32(18)},
+		map[interface{}]interface{}{"traceId": "t1"})}
+	assert.Nil(t, d.DecodePayload(resp))
+	assert.JSONEq(t, `{"name":"alice","age":18}`, string(resp.Data))
+	assert.Equal(t, jsonContentType, resp.ContentType)
+	assert.Equal(t, "t1", resp.Header.Get("traceId"))
+
+	resp = &rpc.RPCResponse{Data: encode(int32(responseNullValue))}
+	assert.Nil(t, d.DecodePayload(resp))
+	assert.Equal(t, "null", string(resp.Data))
+
+	resp = &rpc.RPCResponse{Data: encode(int32(responseWithException), "user not found")}
+	err := d.DecodePayload(resp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "user not found")
+
+	assert.Error(t, d.DecodePayload(&rpc.RPCResponse{Data: encode(int32(9))}))
+	assert.Error(t, d.DecodePayload(&rpc.RPCResponse{Data: buffer.NewIoBufferString("").Bytes()}))
+}
+
+func decodeDubboFrame(t *testing.T, d *dubboProtocol, data []byte) *dubbo.Frame {
+	frame, err := d.Decode(context.TODO(), buffer.NewIoBufferBytes(data))
+	assert.Nil(t, err)
+	return frame.(*dubbo.Frame)
+}
@@ -35,6 +35,15 @@ type TransportProtocol interface {
 	FromFrame(api.XRespFrame) (*rpc.RPCResponse, error)
 }
 
+// PayloadCodec is implemented by the protocols which encode the payloads of some requests and decode their responses,
+// e.g. the json arguments of dubbo requests are encoded to hessian2, and the results are decoded back to json
+type PayloadCodec interface {
+	// EncodePayload returns the encoded payload of the request, ok is false if the request is not handled by the codec
+	EncodePayload(req *rpc.RPCRequest) (data []byte, ok bool, err error)
+	// DecodePayload decodes the response of the request whose payload is encoded
+	DecodePayload(resp *rpc.RPCResponse) error
+}
+
 // GetProtocol is get TransportProtocol
 func GetProtocol(protocol string) TransportProtocol {
 	return protocolRegistry[protocol]
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/consul/api"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+const (
+	Name = "consul"
+)
+
+func init() {
+	resolver.RegisterResolver(Name, NewResolver)
+}
+
+// config is the config of the consul resolver, the service id is resolved as the service name
+type config struct {
+	Address    string `json:"address"`
+	Scheme     string `json:"scheme"`
+	Token      string `json:"token"`
+	Datacenter string `json:"datacenter"`
+	Tag        string `json:"tag"`
+}
+
+// healthClient is the part of api.Health used by the resolver
+type healthClient interface {
+	Service(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error)
+}
+
+// consulResolver is Resolver implement
+type consulResolver struct {
+	config config
+	health healthClient
+}
+
+// NewResolver creates a consul resolver
+func NewResolver() resolver.Resolver {
+	return &consulResolver{}
+}
+
+// Init is init consul resolver config
+func (r *consulResolver) Init(data json.RawMessage) error {
+	if err := json.Unmarshal(data, &r.config); err != nil {
+		return err
+	}
+	if r.config.Address == "" {
+		return errors.New("missing address of consul resolver")
+	}
+	client, err := api.NewClient(&api.Config{
+		Address:    r.config.Address,
+		Scheme:     r.config.Scheme,
+		Token:      r.config.Token,
+		Datacenter: r.config.Datacenter,
+	})
+	if err != nil {
+		return err
+	}
+	r.health = client.Health()
+	return nil
+}
+
+// Resolve returns the instances of the service, the instances are healthy if all the checks are passing
+func (r *consulResolver) Resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	entries, _, err := r.health.Service(id, r.config.Tag, false, (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]resolver.Instance, 0, len(entries))
+	for _, entry := range entries {
+		host := entry.Service.Address
+		if host == "" {
+			host = entry.Node.Address
+		}
+		res = append(res, resolver.Instance{
+			Address:  net.JoinHostPort(host, strconv.Itoa(entry.Service.Port)),
+			Weight:   entry.Service.Weights.Passing,
+			Healthy:  entry.Checks.AggregatedStatus() == api.HealthPassing,
+			Metadata: entry.Service.Meta,
+		})
+	}
+	return res, nil
+}
+
+// Close is nothing to release
+func (r *consulResolver) Close() error {
+	return nil
+}
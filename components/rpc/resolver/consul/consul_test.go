@@ -0,0 +1,66 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package consul
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+type fakeHealthClient struct {
+	service string
+	tag     string
+}
+
+func (c *fakeHealthClient) Service(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	c.service, c.tag = service, tag
+	return []*api.ServiceEntry{
+		{
+			Node:    &api.Node{Address: "10.0.0.1"},
+			Service: &api.AgentService{Port: 1, Weights: api.AgentWeights{Passing: 1}},
+			Checks:  api.HealthChecks{{Status: api.HealthPassing}},
+		},
+		{
+			Node:    &api.Node{Address: "10.0.0.2"},
+			Service: &api.AgentService{Address: "127.0.0.1", Port: 2},
+			Checks:  api.HealthChecks{{Status: api.HealthCritical}},
+		},
+	}, nil, nil
+}
+
+func TestConsulResolver(t *testing.T) {
+	health := &fakeHealthClient{}
+	r := &consulResolver{config: config{Tag: "v1"}, health: health}
+	instances, err := r.Resolve(context.Background(), "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", health.service)
+	assert.Equal(t, "v1", health.tag)
+	assert.Equal(t, []resolver.Instance{
+		{Address: "10.0.0.1:1", Weight: 1, Healthy: true},
+		{Address: "127.0.0.1:2", Healthy: false},
+	}, instances)
+	assert.Nil(t, r.Close())
+}
+
+func TestConsulResolverInit(t *testing.T) {
+	assert.Error(t, NewResolver().Init([]byte(`{}`)))
+	assert.Nil(t, NewResolver().Init([]byte(`{"address":"127.0.0.1:8500"}`)))
+}
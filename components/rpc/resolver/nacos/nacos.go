@@ -0,0 +1,145 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/clients"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+const (
+	Name             = "nacos"
+	defaultTimeoutMs = 10000
+)
+
+func init() {
+	resolver.RegisterResolver(Name, NewResolver)
+}
+
+// config is the config of the nacos resolver, the service id is resolved as the service name
+type config struct {
+	// Address is the addresses of the nacos servers in the format of ip:port
+	Address     []string `json:"address"`
+	NamespaceId string   `json:"namespace_id"`
+	GroupName   string   `json:"group_name"`
+	Clusters    []string `json:"clusters"`
+	Username    string   `json:"username"`
+	Password    string   `json:"password"`
+	TimeoutMs   uint64   `json:"timeout_ms"`
+	CacheDir    string   `json:"cache_dir"`
+}
+
+// namingClient is the part of naming_client.INamingClient used by the resolver
+type namingClient interface {
+	SelectAllInstances(param vo.SelectAllInstancesParam) ([]model.Instance, error)
+	CloseClient()
+}
+
+// nacosResolver is Resolver implement
+type nacosResolver struct {
+	config config
+	client namingClient
+}
+
+// NewResolver creates a nacos resolver
+func NewResolver() resolver.Resolver {
+	return &nacosResolver{}
+}
+
+// Init is init nacos resolver config
+func (r *nacosResolver) Init(data json.RawMessage) error {
+	if err := json.Unmarshal(data, &r.config); err != nil {
+		return err
+	}
+	if len(r.config.Address) == 0 {
+		return errors.New("missing address of nacos resolver")
+	}
+	if r.config.TimeoutMs == 0 {
+		r.config.TimeoutMs = defaultTimeoutMs
+	}
+	serverConfigs := make([]constant.ServerConfig, 0, len(r.config.Address))
+	for _, addr := range r.config.Address {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return err
+		}
+		p, err := strconv.ParseUint(port, 10, 64)
+		if err != nil {
+			return err
+		}
+		serverConfigs = append(serverConfigs, *constant.NewServerConfig(host, p))
+	}
+	clientConfig := *constant.NewClientConfig(
+		constant.WithTimeoutMs(r.config.TimeoutMs),
+		constant.WithNamespaceId(r.config.NamespaceId),
+		constant.WithUsername(r.config.Username),
+		constant.WithPassword(r.config.Password),
+		constant.WithNotLoadCacheAtStart(true),
+		constant.WithCacheDir(r.config.CacheDir),
+	)
+	client, err := clients.NewNamingClient(vo.NacosClientParam{
+		ClientConfig:  &clientConfig,
+		ServerConfigs: serverConfigs,
+	})
+	if err != nil {
+		return err
+	}
+	r.client = client
+	return nil
+}
+
+// Resolve returns the enabled instances of the service
+func (r *nacosResolver) Resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	instances, err := r.client.SelectAllInstances(vo.SelectAllInstancesParam{
+		ServiceName: id,
+		GroupName:   r.config.GroupName,
+		Clusters:    r.config.Clusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]resolver.Instance, 0, len(instances))
+	for _, ins := range instances {
+		if !ins.Enable || ins.Weight <= 0 {
+			continue
+		}
+		res = append(res, resolver.Instance{
+			Address:  net.JoinHostPort(ins.Ip, strconv.FormatUint(ins.Port, 10)),
+			Weight:   int(ins.Weight),
+			Healthy:  ins.Healthy,
+			Metadata: ins.Metadata,
+		})
+	}
+	return res, nil
+}
+
+// Close closes the nacos client
+func (r *nacosResolver) Close() error {
+	if r.client != nil {
+		r.client.CloseClient()
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nacos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+type fakeNamingClient struct {
+	param vo.SelectAllInstancesParam
+}
+
+func (c *fakeNamingClient) SelectAllInstances(param vo.SelectAllInstancesParam) ([]model.Instance, error) {
+	c.param = param
+	return []model.Instance{
+		{Ip: "127.0.0.1", Port: 1, Weight: 1, Healthy: true, Enable: true},
+		{Ip: "127.0.0.1", Port: 2, Weight: 1, Healthy: false, Enable: true},
+		{Ip: "127.0.0.1", Port: 3, Weight: 1, Healthy: true, Enable: false},
+		{Ip: "127.0.0.1", Port: 4, Weight: 0, Healthy: true, Enable: true},
+	}, nil
+}
+
+func (c *fakeNamingClient) CloseClient() {}
+
+func TestNacosResolver(t *testing.T) {
+	client := &fakeNamingClient{}
+	r := &nacosResolver{config: config{GroupName: "rpc"}, client: client}
+	instances, err := r.Resolve(context.Background(), "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", client.param.ServiceName)
+	assert.Equal(t, "rpc", client.param.GroupName)
+	assert.Equal(t, []resolver.Instance{
+		{Address: "127.0.0.1:1", Weight: 1, Healthy: true},
+		{Address: "127.0.0.1:2", Weight: 1, Healthy: false},
+	}, instances)
+	assert.Nil(t, r.Close())
+}
+
+func TestNacosResolverInit(t *testing.T) {
+	assert.Error(t, NewResolver().Init([]byte(`{}`)))
+	assert.Error(t, NewResolver().Init([]byte(`{"address":["127.0.0.1"]}`)))
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Instance is an instance of a service
+type Instance struct {
+	// Address is the address of the instance, e.g. "127.0.0.1:12200"
+	Address  string            `json:"address"`
+	Weight   int               `json:"weight"`
+	Healthy  bool              `json:"healthy"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// Resolver resolves the id of a service to its instances
+type Resolver interface {
+	// Init is init resolver config
+	Init(config json.RawMessage) error
+	// Resolve returns the instances of the service, including the unhealthy ones
+	Resolve(ctx context.Context, id string) ([]Instance, error)
+	// Close releases the resources of the resolver
+	Close() error
+}
+
+var (
+	// to storage the factories of Resolver
+	registry = map[string]func() Resolver{}
+)
+
+// RegisterResolver is set the factory of a Resolver
+func RegisterResolver(name string, f func() Resolver) {
+	registry[name] = f
+}
+
+// NewResolver creates a Resolver by name
+func NewResolver(name string) (Resolver, error) {
+	f, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("resolver %s not found", name)
+	}
+	return f(), nil
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+const (
+	Name = "static"
+)
+
+func init() {
+	resolver.RegisterResolver(Name, NewResolver)
+}
+
+// config is the config of the static resolver.
+// The instances are read from Services, or from the json file at Path, which is reloaded when it is modified.
+// The instances are healthy unless they are marked as unhealthy in the file.
+type config struct {
+	Path     string                `json:"path"`
+	Services map[string][]instance `json:"services"`
+}
+
+type instance struct {
+	Address  string            `json:"address"`
+	Weight   int               `json:"weight"`
+	Healthy  *bool             `json:"healthy"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// staticResolver is Resolver implement
+type staticResolver struct {
+	mu       sync.RWMutex
+	path     string
+	modTime  time.Time
+	services map[string][]resolver.Instance
+}
+
+// NewResolver creates a static resolver
+func NewResolver() resolver.Resolver {
+	return &staticResolver{}
+}
+
+// Init is init static resolver config
+func (r *staticResolver) Init(data json.RawMessage) error {
+	var c config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+	if c.Path == "" && len(c.Services) == 0 {
+		return errors.New("missing path or services of static resolver")
+	}
+	r.path = c.Path
+	r.services = parse(c.Services)
+	if r.path != "" {
+		return r.reload()
+	}
+	return nil
+}
+
+// Resolve returns the instances of the service
+func (r *staticResolver) Resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	if r.path != "" {
+		if err := r.reload(); err != nil {
+			return nil, err
+		}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.services[id], nil
+}
+
+// Close is nothing to release
+func (r *staticResolver) Close() error {
+	return nil
+}
+
+// reload reads the file again if it is modified
+func (r *staticResolver) reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	r.mu.RLock()
+	modified := info.ModTime() != r.modTime
+	r.mu.RUnlock()
+	if !modified {
+		return nil
+	}
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	var services map[string][]instance
+	if err := json.Unmarshal(data, &services); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.services = parse(services)
+	r.modTime = info.ModTime()
+	return nil
+}
+
+// parse marks the instances healthy if the field is omitted
+func parse(services map[string][]instance) map[string][]resolver.Instance {
+	res := make(map[string][]resolver.Instance, len(services))
+	for id, instances := range services {
+		for _, ins := range instances {
+			res[id] = append(res[id], resolver.Instance{
+				Address:  ins.Address,
+				Weight:   ins.Weight,
+				Healthy:  ins.Healthy == nil || *ins.Healthy,
+				Metadata: ins.Metadata,
+			})
+		}
+	}
+	return res
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package static
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+func TestStaticResolver(t *testing.T) {
+	t.Run("services", func(t *testing.T) {
+		r, err := resolver.NewResolver(Name)
+		assert.Nil(t, err)
+		err = r.Init([]byte(`{"services":{"hello":[{"address":"127.0.0.1:1"},{"address":"127.0.0.1:2","healthy":false}]}}`))
+		assert.Nil(t, err)
+		instances, err := r.Resolve(context.Background(), "hello")
+		assert.Nil(t, err)
+		assert.Equal(t, []resolver.Instance{
+			{Address: "127.0.0.1:1", Healthy: true},
+			{Address: "127.0.0.1:2", Healthy: false},
+		}, instances)
+		assert.Nil(t, r.Close())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "services.json")
+		assert.Nil(t, os.WriteFile(path, []byte(`{"hello":[{"address":"127.0.0.1:1"}]}`), 0644))
+		r := NewResolver()
+		assert.Nil(t, r.Init([]byte(`{"path":"`+path+`"}`)))
+		instances, err := r.Resolve(context.Background(), "hello")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1:1", instances[0].Address)
+
+		// reloaded after modified
+		assert.Nil(t, os.WriteFile(path, []byte(`{"hello":[{"address":"127.0.0.1:2"}]}`), 0644))
+		future := time.Now().Add(time.Minute)
+		assert.Nil(t, os.Chtimes(path, future, future))
+		instances, err = r.Resolve(context.Background(), "hello")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1:2", instances[0].Address)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Error(t, NewResolver().Init([]byte(`{}`)))
+		assert.Error(t, NewResolver().Init([]byte(`{"path":"not_exist.json"}`)))
+		_, err := resolver.NewResolver("not_exist")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package zookeeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-zookeeper/zk"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+const (
+	Name                  = "zookeeper"
+	defaultBasePath       = "/services"
+	defaultSessionTimeout = 5 * time.Second
+)
+
+func init() {
+	resolver.RegisterResolver(Name, NewResolver)
+}
+
+// config is the config of the zookeeper resolver.
+// The instances of a service are the children of "{base_path}/{id}", named by their addresses,
+// e.g. "/services/com.alipay.HelloService/127.0.0.1:12200".
+// The url encoded names like the ones registered by dubbo are also supported.
+type config struct {
+	Hosts            []string `json:"hosts"`
+	BasePath         string   `json:"base_path"`
+	SessionTimeoutMs int      `json:"session_timeout_ms"`
+}
+
+// zkConn is the part of zk.Conn used by the resolver
+type zkConn interface {
+	Children(path string) ([]string, *zk.Stat, error)
+	Close()
+}
+
+// zookeeperResolver is Resolver implement
+type zookeeperResolver struct {
+	basePath string
+	conn     zkConn
+}
+
+// NewResolver creates a zookeeper resolver
+func NewResolver() resolver.Resolver {
+	return &zookeeperResolver{}
+}
+
+// Init is init zookeeper resolver config
+func (r *zookeeperResolver) Init(data json.RawMessage) error {
+	var c config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+	if len(c.Hosts) == 0 {
+		return errors.New("missing hosts of zookeeper resolver")
+	}
+	r.basePath = c.BasePath
+	if r.basePath == "" {
+		r.basePath = defaultBasePath
+	}
+	timeout := defaultSessionTimeout
+	if c.SessionTimeoutMs > 0 {
+		timeout = time.Duration(c.SessionTimeoutMs) * time.Millisecond
+	}
+	conn, _, err := zk.Connect(c.Hosts, timeout)
+	if err != nil {
+		return err
+	}
+	r.conn = conn
+	return nil
+}
+
+// Resolve returns the instances of the service, the registered instances are considered healthy
+// since the ephemeral nodes are removed along with the sessions
+func (r *zookeeperResolver) Resolve(ctx context.Context, id string) ([]resolver.Instance, error) {
+	children, _, err := r.conn.Children(path.Join(r.basePath, id))
+	if err == zk.ErrNoNode {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := make([]resolver.Instance, 0, len(children))
+	for _, child := range children {
+		addr := parseAddress(child)
+		if addr == "" {
+			continue
+		}
+		res = append(res, resolver.Instance{Address: addr, Healthy: true})
+	}
+	return res, nil
+}
+
+// Close closes the zookeeper connection
+func (r *zookeeperResolver) Close() error {
+	if r.conn != nil {
+		r.conn.Close()
+	}
+	return nil
+}
+
+// parseAddress gets the address from the node name, which is an address or an url encoded url
+func parseAddress(name string) string {
+	if !strings.Contains(name, "%") {
+		return name
+	}
+	raw, err := url.QueryUnescape(name)
+	if err != nil {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
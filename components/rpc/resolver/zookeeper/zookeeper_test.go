@@ -0,0 +1,66 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package zookeeper
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/go-zookeeper/zk"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc/resolver"
+)
+
+type fakeConn struct {
+	children map[string][]string
+}
+
+func (c *fakeConn) Children(path string) ([]string, *zk.Stat, error) {
+	children, ok := c.children[path]
+	if !ok {
+		return nil, nil, zk.ErrNoNode
+	}
+	return children, &zk.Stat{}, nil
+}
+
+func (c *fakeConn) Close() {}
+
+func TestZookeeperResolver(t *testing.T) {
+	dubbo := url.QueryEscape("dubbo://127.0.0.1:20880/com.alipay.HelloService?version=1.0")
+	r := &zookeeperResolver{
+		basePath: defaultBasePath,
+		conn: &fakeConn{children: map[string][]string{
+			"/services/hello": {"127.0.0.1:1", dubbo},
+		}},
+	}
+	instances, err := r.Resolve(context.Background(), "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, []resolver.Instance{
+		{Address: "127.0.0.1:1", Healthy: true},
+		{Address: "127.0.0.1:20880", Healthy: true},
+	}, instances)
+
+	instances, err = r.Resolve(context.Background(), "not_exist")
+	assert.Nil(t, err)
+	assert.Empty(t, instances)
+	assert.Nil(t, r.Close())
+}
+
+func TestZookeeperResolverInit(t *testing.T) {
+	assert.Error(t, NewResolver().Init([]byte(`{}`)))
+}
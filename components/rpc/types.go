@@ -20,12 +20,16 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+
+	"mosn.io/layotto/components/ref"
 )
 
 const (
 	TargetAddress    = "rpc_target_address"
 	RequestTimeoutMs = "rpc_request_timeout"
 	RequestType      = "rpc_request_type"
+	// CallerAppId is the app id of the caller, it is set by the runtime and checked by the access control
+	CallerAppId = "rpc_caller_app_id"
 )
 
 const (
@@ -88,6 +92,8 @@ type RPCResponse struct {
 
 type RpcConfig struct {
 	Config json.RawMessage
+	// SecretRef injects secrets into Config, the names to inject as can be paths separated by dots
+	SecretRef []*ref.SecretRefConfig `json:"secret_ref,omitempty"`
 }
 
 // Invoker is interface for init rpc config or invoke rpc request
@@ -109,6 +115,12 @@ type Callback interface {
 	AfterInvoke(*RPCResponse) (*RPCResponse, error)
 }
 
+// CallbackValidator is implemented by the Callback reporting the funcs failed to be added
+type CallbackValidator interface {
+	// Validate returns the error of the funcs not found or with invalid configs
+	Validate() error
+}
+
 // CallbackFunc is Callback implement
 type CallbackFunc struct {
 	Name   string          `json:"name"`
@@ -119,3 +131,30 @@ type CallbackFunc struct {
 type Channel interface {
 	Do(*RPCRequest) (*RPCResponse, error)
 }
+
+// RPCStream is a stream of messages exchanged with the target service
+type RPCStream interface {
+	// Header returns the header of the response, it blocks until the header is received
+	Header() (RPCHeader, error)
+	// Send sends a message to the target service
+	Send(data []byte) error
+	// CloseSend closes the sending direction of the stream
+	CloseSend() error
+	// Recv receives a message from the target service, it returns io.EOF when the stream ends successfully
+	Recv() ([]byte, error)
+	// Trailer returns the trailer of the response, it is only available after Recv returns io.EOF
+	Trailer() RPCHeader
+	// Close aborts the stream and releases the resources
+	Close() error
+}
+
+// StreamChannel is implemented by the channels supporting streaming invocation.
+// RPCRequest.Data is sent as the first message if it is not empty.
+type StreamChannel interface {
+	DoStream(*RPCRequest) (RPCStream, error)
+}
+
+// StreamInvoker is implemented by the invokers supporting client streaming, server streaming and bidirectional streaming invocation
+type StreamInvoker interface {
+	InvokeStream(ctx context.Context, req *RPCRequest) (RPCStream, error)
+}
@@ -13,10 +13,14 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"sync"
 
+	_ "github.com/go-sql-driver/mysql"
+
 	"mosn.io/layotto/kit/logger"
 
 	"mosn.io/layotto/components/pkg/actuators"
@@ -25,7 +29,8 @@ import (
 )
 
 const (
-	componentName = "sequencer-mysql"
+	componentName    = "sequencer-mysql"
+	defaultTableName = "layotto_sequencer"
 )
 
 var (
@@ -40,10 +45,14 @@ func init() {
 }
 
 type MySQLSequencer struct {
+	// mu protects db and metadata, which can be replaced by ApplyConfig
+	mu         sync.RWMutex
 	metadata   utils.MySQLMetadata
 	biggerThan map[string]int64
 	logger     logger.Logger
 	db         *sql.DB
+	// openDB opens a connection pool with the given metadata
+	openDB func(m utils.MySQLMetadata) (*sql.DB, error)
 }
 
 func NewMySQLSequencer() *MySQLSequencer {
@@ -53,6 +62,7 @@ func NewMySQLSequencer() *MySQLSequencer {
 	})
 	s := &MySQLSequencer{
 		logger: logger.NewLayottoLogger("sequencer/mysql"),
+		openDB: openMySQL,
 	}
 
 	logger.RegisterComponentLoggerListener("sequencer/mysql", s)
@@ -82,24 +92,89 @@ func (e *MySQLSequencer) Init(config sequencer.Configuration) error {
 		return err
 	}
 
-	if len(e.biggerThan) > 0 {
-		var Key string
-		var Value int64
-		for k, bt := range e.biggerThan {
-			if bt > 0 {
-				m.Db.QueryRow(fmt.Sprintf("SELECT sequencer_key,sequencer_value FROM %s WHERE sequencer_key = ?", m.TableName), k).Scan(&Key, &Value)
-				if Value < bt {
-					return fmt.Errorf("mysql sequencer error: can not satisfy biggerThan guarantee.key: %s,key in MySQL: %s", k, Key)
-				}
+	if err = e.checkBiggerThan(context.Background(), e.metadata.Db, m.TableName); err != nil {
+		return err
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (e *MySQLSequencer) checkBiggerThan(ctx context.Context, db *sql.DB, tableName string) error {
+	var Key string
+	var Value int64
+	for k, bt := range e.biggerThan {
+		if bt > 0 {
+			db.QueryRowContext(ctx, fmt.Sprintf("SELECT sequencer_key,sequencer_value FROM %s WHERE sequencer_key = ?", tableName), k).Scan(&Key, &Value)
+			if Value < bt {
+				return fmt.Errorf("mysql sequencer error: can not satisfy biggerThan guarantee.key: %s,key in MySQL: %s", k, Key)
 			}
 		}
 	}
+	return nil
+}
+
+// ApplyConfig connects to mysql with the new metadata and replaces the current connection pool.
+// The new database is prepared and checked against the biggerThan guarantee before it is used,
+// and the current connection pool is kept if anything goes wrong.
+func (e *MySQLSequencer) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	// 1. parse and validate config
+	m, err := utils.ParseMySQLMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	if m.MysqlUrl == "" {
+		return errors.New("mysql sequencer error: mysqlUrl is required")
+	}
+	if m.TableName == "" {
+		m.TableName = defaultTableName
+	}
+	// 2. prepare the new connection pool
+	db, err := e.openDB(m)
+	if err != nil {
+		return err
+	}
+	if err = e.prepareDB(ctx, db, m.TableName); err != nil {
+		db.Close()
+		return err
+	}
+	// 3. swap and close the old connection pool
+	m.Db = db
+	e.mu.Lock()
+	old := e.db
+	e.db = db
+	e.metadata = m
+	e.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
 	return nil
 }
 
+func (e *MySQLSequencer) prepareDB(ctx context.Context, db *sql.DB, tableName string) error {
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	createTable := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			sequencer_key VARCHAR(255),
+			sequencer_value INT,
+			UNIQUE INDEX (sequencer_key));`, tableName)
+	if _, err := db.ExecContext(ctx, createTable); err != nil {
+		return err
+	}
+	return e.checkBiggerThan(ctx, db, tableName)
+}
+
+func openMySQL(m utils.MySQLMetadata) (*sql.DB, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8&parseTime=true&loc=Local", m.UserName, m.Password, m.MysqlUrl, m.DataBaseName)
+	return sql.Open("mysql", dsn)
+}
+
 func (e *MySQLSequencer) GetNextId(req *sequencer.GetNextIdRequest) (*sequencer.GetNextIdResponse, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 
 	metadata, err := utils.ParseMySQLMetadata(req.Metadata)
 	metadata.Db = e.db
@@ -132,7 +207,10 @@ func (e *MySQLSequencer) GetNextId(req *sequencer.GetNextIdRequest) (*sequencer.
 			return nil, err
 		}
 	}
-	defer e.Close(metadata.Db)
+	// the connection pool is shared by the requests, and closed when it is replaced
+	if err = begin.Commit(); err != nil {
+		return nil, err
+	}
 
 	return &sequencer.GetNextIdResponse{
 		NextId: Value,
@@ -145,6 +223,8 @@ func (e *MySQLSequencer) GetSegment(req *sequencer.GetSegmentRequest) (support b
 		return true, nil, nil
 	}
 
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 	metadata, err := utils.ParseMySQLMetadata(req.Metadata)
 
 	var Key string
@@ -179,7 +259,9 @@ func (e *MySQLSequencer) GetSegment(req *sequencer.GetSegmentRequest) (support b
 			return false, nil, err1
 		}
 	}
-	defer e.Close(metadata.Db)
+	if err = begin.Commit(); err != nil {
+		return false, nil, err
+	}
 
 	return false, &sequencer.GetSegmentResponse{
 		From: Value - int64(req.Size) + 1,
@@ -13,6 +13,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -21,6 +22,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 
+	"mosn.io/layotto/components/pkg/utils"
 	"mosn.io/layotto/components/sequencer"
 )
 
@@ -78,7 +80,6 @@ func TestMySQLSequencer_GetNextId(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT").WillReturnRows(rows)
 	mock.ExpectExec("UPDATE").WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("INSERT INTO").WithArgs(tableName, Key, Value, Version).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	properties := make(map[string]string)
@@ -115,7 +116,6 @@ func TestMySQLSequencer_GetSegment(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT").WillReturnRows(rows)
 	mock.ExpectExec("UPDATE").WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("INSERT INTO").WithArgs(tableName, Key, Size, Version).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	properties := make(map[string]string)
@@ -342,3 +342,72 @@ func TestMySQLSequencer_Segment_UpdateError(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestMySQLSequencer_ApplyConfig(t *testing.T) {
+	oldDB, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	newDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	assert.NoError(t, err)
+	defer newDB.Close()
+
+	comp := NewMySQLSequencer()
+	comp.db = oldDB
+	comp.biggerThan = map[string]int64{Key: 10}
+	comp.openDB = func(m utils.MySQLMetadata) (*sql.DB, error) {
+		return newDB, nil
+	}
+	properties := map[string]string{
+		"tableName": tableName,
+		"userName":  userName,
+		"password":  password,
+		"mysqlUrl":  MySQLUrl,
+	}
+
+	t.Run("no mysqlUrl", func(t *testing.T) {
+		err := comp.ApplyConfig(context.Background(), map[string]string{"tableName": tableName})
+		assert.Error(t, err)
+		assert.Equal(t, oldDB, comp.db)
+	})
+
+	t.Run("biggerThan not satisfied", func(t *testing.T) {
+		mock.ExpectPing()
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"sequencer_key", "sequencer_value"}).AddRow(Key, 1))
+		mock.ExpectClose()
+		err := comp.ApplyConfig(context.Background(), properties)
+		assert.Error(t, err)
+		assert.Equal(t, oldDB, comp.db)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		newDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+		assert.NoError(t, err)
+		comp.openDB = func(m utils.MySQLMetadata) (*sql.DB, error) {
+			return newDB, nil
+		}
+		mock.ExpectPing()
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"sequencer_key", "sequencer_value"}).AddRow(Key, 20))
+		err = comp.ApplyConfig(context.Background(), properties)
+		assert.NoError(t, err)
+		assert.Equal(t, newDB, comp.db)
+		assert.Equal(t, tableName, comp.metadata.TableName)
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		// the new connection pool serves the requests one after another
+		req := &sequencer.GetNextIdRequest{Key: Key, Metadata: properties}
+		for i := 0; i < 2; i++ {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"sequencer_key", "sequencer_value", "version"}).AddRow(Key, 20+i, Version))
+			mock.ExpectExec("UPDATE").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+			_, err = comp.GetNextId(req)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		// the replaced connection pool is closed
+		assert.Error(t, oldDB.Ping())
+	})
+}
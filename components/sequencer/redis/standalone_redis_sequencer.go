@@ -14,6 +14,7 @@ package redis
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/go-redis/redis/v8"
@@ -41,6 +42,8 @@ func init() {
 }
 
 type StandaloneRedisSequencer struct {
+	// mu protects client and metadata, which can be replaced by ApplyConfig
+	mu         sync.RWMutex
 	client     *redis.Client
 	metadata   utils.RedisMetadata
 	biggerThan map[string]int64
@@ -98,28 +101,69 @@ func (s *StandaloneRedisSequencer) Init(config sequencer.Configuration) error {
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 
 	//check biggerThan, initialize if not satisfied
+	if err = s.checkBiggerThan(s.ctx, s.client); err != nil {
+		readinessIndicator.ReportError(err.Error())
+		livenessIndicator.ReportError(err.Error())
+		return err
+	}
+	readinessIndicator.SetStarted()
+	livenessIndicator.SetStarted()
+	return nil
+}
+
+func (s *StandaloneRedisSequencer) checkBiggerThan(ctx context.Context, client *redis.Client) error {
 	for k, needV := range s.biggerThan {
 		if needV <= 0 {
 			continue
 		}
 
-		eval := s.client.Eval(s.ctx, initScript, []string{k}, needV)
-		err = eval.Err()
+		eval := client.Eval(ctx, initScript, []string{k}, needV)
 		//occur error,  such as value is string type
-		if err != nil {
-			readinessIndicator.ReportError(err.Error())
-			livenessIndicator.ReportError(err.Error())
+		if err := eval.Err(); err != nil {
 			return err
 		}
 		//As long as there is no error, the initialization is successful
 		//It may be a reset value or it may be satisfied before
 	}
+	return nil
+}
+
+// ApplyConfig replaces the redis client with one built from the new metadata.
+// The biggerThan constraints are checked against the new redis before it is used,
+// and the current client is kept if anything goes wrong.
+func (s *StandaloneRedisSequencer) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	// 1. parse and validate config
+	m, err := utils.ParseRedisMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	// 2. prepare the new client
+	client := utils.NewRedisClient(m)
+	if _, err = client.Ping(ctx).Result(); err != nil {
+		client.Close()
+		return fmt.Errorf("[standaloneRedisSequencer]: error connecting to redis at %s: %s", m.Host, err)
+	}
+	if err = s.checkBiggerThan(ctx, client); err != nil {
+		client.Close()
+		return err
+	}
+	// 3. swap and close the old client
+	s.mu.Lock()
+	old := s.client
+	s.client = client
+	s.metadata = m
+	s.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
 	readinessIndicator.SetStarted()
 	livenessIndicator.SetStarted()
 	return nil
 }
 
 func (s *StandaloneRedisSequencer) GetNextId(req *sequencer.GetNextIdRequest) (*sequencer.GetNextIdResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
 	incr := s.client.Incr(s.ctx, req.Key)
 
@@ -140,6 +184,8 @@ func (s *StandaloneRedisSequencer) GetSegment(req *sequencer.GetSegmentRequest)
 		return true, nil, nil
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	by := s.client.IncrBy(s.ctx, req.Key, int64(req.Size))
 	err := by.Err()
 	if err != nil {
@@ -153,5 +199,7 @@ func (s *StandaloneRedisSequencer) GetSegment(req *sequencer.GetSegmentRequest)
 }
 func (s *StandaloneRedisSequencer) Close() error {
 	s.cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return s.client.Close()
 }
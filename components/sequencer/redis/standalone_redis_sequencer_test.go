@@ -13,6 +13,7 @@
 package redis
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -147,3 +148,36 @@ func TestStandaloneRedisSequencer_segment(t *testing.T) {
 	assert.Equal(t, defalutVal+6, id.To)
 
 }
+
+func TestStandaloneRedisSequencer_ApplyConfig(t *testing.T) {
+	s1, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s2.Close()
+	comp := NewStandaloneRedisSequencer()
+	cfg := sequencer.Configuration{
+		Properties: map[string]string{"redisHost": s1.Addr()},
+		BiggerThan: map[string]int64{key: 100},
+	}
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	defer comp.Close()
+
+	// the biggerThan check fails on the new redis, so the old client is kept
+	s2.Set(key, "abc")
+	err = comp.ApplyConfig(context.Background(), map[string]string{"redisHost": s2.Addr()})
+	assert.Error(t, err)
+	id, err := comp.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), id.NextId)
+
+	// switch to the new redis, which is initialized with biggerThan
+	s2.Del(key)
+	err = comp.ApplyConfig(context.Background(), map[string]string{"redisHost": s2.Addr()})
+	assert.NoError(t, err)
+	id, err = comp.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), id.NextId)
+}
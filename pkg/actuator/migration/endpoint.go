@@ -0,0 +1,204 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/replication"
+	"mosn.io/layotto/pkg/actuator"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	"mosn.io/layotto/pkg/grpc/default_api"
+)
+
+const (
+	migrationKey = "file_migration"
+	jobsKey      = "jobs"
+	jobKey       = "job"
+
+	// CheckpointFolderEnvVar sets the folder of the checkpoints of the migrations, replacing the default one under the temp dir
+	CheckpointFolderEnvVar = "LAYOTTO_FILE_MIGRATION_CHECKPOINT_FOLDER"
+)
+
+// GetCheckpointFolderPath gets the path of the folder storing the checkpoints of the migrations
+func GetCheckpointFolderPath() string {
+	if v, ok := os.LookupEnv(CheckpointFolderEnvVar); ok {
+		return v
+	}
+	return filepath.Join(os.TempDir(), "layotto", migrationKey)
+}
+
+// init file migration Endpoint.
+func init() {
+	actuator.GetDefault().AddEndpoint(migrationKey, NewEndpoint())
+}
+
+// StartRequest starts a migration copying the files from the source store to the target store.
+// The checkpoint is a file name in the checkpoint folder rather than a path, it can't contain path separators or ".."
+type StartRequest struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	replication.MigrationOptions
+}
+
+// JobStatus is the status of a migration
+type JobStatus struct {
+	Source   string                        `json:"source"`
+	Target   string                        `json:"target"`
+	Running  bool                          `json:"running"`
+	Error    string                        `json:"error,omitempty"`
+	Progress replication.MigrationProgress `json:"progress"`
+}
+
+type job struct {
+	source    string
+	target    string
+	migration *replication.Migration
+	running   bool
+	err       error
+}
+
+// Endpoint runs the one-shot migrations between the file stores.
+// A migration is started by POST /actuator/file_migration with the StartRequest as the body,
+// and the migrations are listed by GET /actuator/file_migration.
+// There is at most one migration running between the same stores.
+type Endpoint struct {
+	store         func(name string) (file.File, bool)
+	checkpointDir string
+	mu            sync.Mutex
+	jobs          map[string]*job
+}
+
+func NewEndpoint() *Endpoint {
+	return &Endpoint{store: defaultStore, checkpointDir: GetCheckpointFolderPath(), jobs: make(map[string]*job)}
+}
+
+// defaultStore finds the file store by the Runtime API
+func defaultStore(name string) (file.File, bool) {
+	stores, ok := default_api.LayottoAPISingleton.(interface {
+		FileStore(name string) (file.File, bool)
+	})
+	if !ok {
+		return nil, false
+	}
+	return stores.FileStore(name)
+}
+
+// Handle starts a migration or lists the migrations.
+// The structure of the returned map is like:
+//
+//	{
+//	 "jobs": [
+//	   {
+//	     "source": "oss_old",
+//	     "target": "oss_new",
+//	     "running": false,
+//	     "progress": {
+//	       "marker": "dir/z.txt",
+//	       "copied": 100,
+//	       "failed": ["dir/a.txt"],
+//	       "done": true
+//	     }
+//	   }
+//	 ]
+//	}
+func (e *Endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	if strings.ToUpper(http.GetRequestMethod(ctx)) == "POST" {
+		s, err := e.start(http.GetRequestBody(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{jobKey: s}, nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	jobs := make([]JobStatus, 0, len(e.jobs))
+	for _, j := range e.jobs {
+		jobs = append(jobs, j.status())
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Source+"->"+jobs[i].Target < jobs[j].Source+"->"+jobs[j].Target
+	})
+	return map[string]interface{}{jobsKey: jobs}, nil
+}
+
+func (e *Endpoint) start(body []byte) (*JobStatus, error) {
+	req := &StartRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	checkpoint := req.Checkpoint
+	if checkpoint == "" || checkpoint == "." || strings.Contains(checkpoint, "..") || strings.ContainsAny(checkpoint, `/\`) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checkpoint %q, it must be a file name", checkpoint)
+	}
+	if err := os.MkdirAll(e.checkpointDir, 0755); err != nil {
+		return nil, status.Errorf(codes.Internal, "create checkpoint folder fail: %v", err)
+	}
+	req.Checkpoint = filepath.Join(e.checkpointDir, checkpoint)
+	source, ok := e.store(req.Source)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file store %s is not found", req.Source)
+	}
+	target, ok := e.store(req.Target)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file store %s is not found", req.Target)
+	}
+	m, err := replication.NewMigration(source, target, req.MigrationOptions)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	key := req.Source + "->" + req.Target
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if j, ok := e.jobs[key]; ok && j.running {
+		return nil, status.Errorf(codes.AlreadyExists, "migration from %s to %s is running", req.Source, req.Target)
+	}
+	j := &job{source: req.Source, target: req.Target, migration: m, running: true}
+	e.jobs[key] = j
+	go func() {
+		err := m.Run(context.Background())
+		if err != nil {
+			log.DefaultLogger.Errorf("[actuator][file_migration] migration from %s to %s fail, err: %v", j.source, j.target, err)
+		}
+		e.mu.Lock()
+		j.running = false
+		j.err = err
+		e.mu.Unlock()
+	}()
+	s := j.status()
+	return &s, nil
+}
+
+func (j *job) status() JobStatus {
+	s := JobStatus{Source: j.source, Target: j.target, Running: j.running, Progress: j.migration.Progress()}
+	if j.err != nil {
+		s.Error = j.err.Error()
+	}
+	return s
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/local"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+)
+
+func newRequestContext(method string, body []byte) context.Context {
+	ctx := context.WithValue(context.Background(), http.ContextKeyRequestMethod{}, method)
+	return context.WithValue(ctx, http.ContextKeyRequestData{}, body)
+}
+
+func TestEndpoint_Handle(t *testing.T) {
+	source, target := t.TempDir(), t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		require.Nil(t, os.WriteFile(filepath.Join(source, name), []byte(name), 0644))
+	}
+	ep := NewEndpoint()
+	ep.checkpointDir = t.TempDir()
+	ep.store = func(name string) (file.File, bool) {
+		if name != "local" {
+			return nil, false
+		}
+		return local.NewLocalStore(), true
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"source":        "local",
+		"target":        "local",
+		"directory":     source,
+		"source_prefix": source + "/",
+		"target_prefix": target + "/",
+		"page_size":     2,
+		"checkpoint":    "checkpoint.json",
+		"target_metadata": map[string]string{
+			local.FileMode: strconv.Itoa(0644),
+			local.FileFlag: strconv.Itoa(os.O_WRONLY | os.O_CREATE | os.O_TRUNC),
+		},
+	})
+	result, err := ep.Handle(newRequestContext("POST", body), nil)
+	require.Nil(t, err)
+	assert.Equal(t, "local", result["job"].(*JobStatus).Source)
+
+	assert.Eventually(t, func() bool {
+		result, err := ep.Handle(newRequestContext("GET", nil), nil)
+		jobs := result["jobs"].([]JobStatus)
+		return err == nil && len(jobs) == 1 && !jobs[0].Running
+	}, time.Second, 10*time.Millisecond)
+	result, _ = ep.Handle(newRequestContext("GET", nil), nil)
+	job := result["jobs"].([]JobStatus)[0]
+	assert.Empty(t, job.Error)
+	assert.True(t, job.Progress.Done)
+	assert.Equal(t, int64(3), job.Progress.Copied)
+	data, err := os.ReadFile(filepath.Join(target, "c.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "c.txt", string(data))
+	_, err = os.Stat(filepath.Join(ep.checkpointDir, "checkpoint.json"))
+	assert.Nil(t, err)
+
+	_, err = ep.Handle(newRequestContext("POST", []byte(`{"source":"local","target":"missing","checkpoint":"c"}`)), nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ep.Handle(newRequestContext("POST", []byte(`{"source":"local","target":"local"}`)), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ep.Handle(newRequestContext("POST", []byte(`not json`)), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	// the checkpoints are confined to the checkpoint folder
+	for _, checkpoint := range []string{"../c", "dir/c", `dir\c`, "/etc/passwd", ".."} {
+		body, _ := json.Marshal(map[string]string{"source": "local", "target": "local", "checkpoint": checkpoint})
+		_, err = ep.Handle(newRequestContext("POST", body), nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), checkpoint)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+	"mosn.io/layotto/pkg/actuator"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+)
+
+const (
+	rpcKey   = "rpc"
+	poolsKey = "pools"
+)
+
+// init rpc Endpoint.
+func init() {
+	actuator.GetDefault().AddEndpoint(rpcKey, NewEndpoint())
+}
+
+type Endpoint struct {
+	poolStats func() []channel.PoolStats
+}
+
+func NewEndpoint() *Endpoint {
+	return &Endpoint{poolStats: channel.GetPoolStats}
+}
+
+// Handle lists the connection pools of the rpc channels, the pools can be filtered by the channel name in the params.
+// The structure of the returned map is like:
+//
+//	{
+//	 "pools": [
+//	   {
+//	     "channel": "bolt",
+//	     "max_active": 10,
+//	     "active": 1,
+//	     "idle": 2,
+//	     ...
+//	   }
+//	 ]
+//	}
+func (e *Endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	var name string
+	if params != nil && params.HasNext() {
+		name = params.Next()
+	}
+	pools := make([]channel.PoolStats, 0)
+	for _, s := range e.poolStats() {
+		if name == "" || s.Channel == name {
+			pools = append(pools, s)
+		}
+	}
+	return map[string]interface{}{poolsKey: pools}, nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+type mockScanner struct {
+	params []string
+}
+
+func (m *mockScanner) Next() string {
+	if len(m.params) == 0 {
+		return ""
+	}
+	p := m.params[0]
+	m.params = m.params[1:]
+	return p
+}
+
+func (m *mockScanner) HasNext() bool {
+	return len(m.params) > 0
+}
+
+func TestEndpoint_Handle(t *testing.T) {
+	ep := NewEndpoint()
+	ep.poolStats = func() []channel.PoolStats {
+		return []channel.PoolStats{
+			{Channel: "bolt", MaxActive: 10, Active: 1, Idle: 2},
+			{Channel: "http", MaxActive: 5, Target: "127.0.0.1:8080"},
+		}
+	}
+
+	handle, err := ep.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, handle["pools"], 2)
+
+	handle, err = ep.Handle(context.Background(), &mockScanner{params: []string{"http"}})
+	assert.Nil(t, err)
+	pools := handle["pools"].([]channel.PoolStats)
+	assert.Len(t, pools, 1)
+	assert.Equal(t, "127.0.0.1:8080", pools[0].Target)
+
+	handle, err = ep.Handle(context.Background(), &mockScanner{params: []string{"dubbo"}})
+	assert.Nil(t, err)
+	assert.Len(t, handle["pools"], 0)
+}
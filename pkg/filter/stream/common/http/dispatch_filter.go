@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"mosn.io/api"
 	mosnhttp "mosn.io/mosn/pkg/protocol/http"
 	"mosn.io/mosn/pkg/types"
@@ -65,6 +67,13 @@ func (dis *DispatchFilter) OnReceive(ctx context.Context, headers api.HeaderMap,
 	if requestData != nil {
 		ctx = context.WithValue(ctx, ContextKeyRequestData{}, requestData.Bytes())
 	}
+	if method, err := variable.GetString(ctx, types.VarHttpRequestMethod); err == nil {
+		ctx = context.WithValue(ctx, ContextKeyRequestMethod{}, method)
+	}
+	if query, err := variable.GetString(ctx, types.VarHttpRequestArg); err == nil {
+		ctx = context.WithValue(ctx, ContextKeyRequestQuery{}, query)
+	}
+	ctx = context.WithValue(ctx, ContextKeyRequestHeader{}, headers)
 	epName := resolver.Next()
 	endpoint, ok := dis.requestHandler.GetEndpoint(epName)
 	if !ok {
@@ -72,17 +81,56 @@ func (dis *DispatchFilter) OnReceive(ctx context.Context, headers api.HeaderMap,
 		dis.write404()
 		return api.StreamFilterStop
 	}
-	json, err := endpoint.Handle(ctx, resolver)
-	var code int
-	if err != nil {
-		code = http.StatusInternalServerError
-	} else {
-		code = http.StatusOK
+	if rawEndpoint, ok := endpoint.(RawEndpoint); ok {
+		raw, json, err := rawEndpoint.HandleRaw(ctx, resolver)
+		if raw != nil {
+			dis.writeRawResult(raw, httpStatus(err))
+		} else {
+			dis.writeJsonResult(json, httpStatus(err))
+		}
+		return api.StreamFilterStop
 	}
-	dis.writeJsonResult(json, code)
+	json, err := endpoint.Handle(ctx, resolver)
+	dis.writeJsonResult(json, httpStatus(err))
 	return api.StreamFilterStop
 }
 
+// httpStatus converts the error returned by the endpoint to the http status code.
+// The errors with grpc status codes are converted accordingly, and the others are internal server errors.
+func httpStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError
+	}
+	switch s.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func (dis *DispatchFilter) write404() {
 	dis.writeJsonResult(nil, http.StatusNotFound)
 }
@@ -113,3 +161,16 @@ func (dis *DispatchFilter) writeJsonResult(jsonObject map[string]interface{}, co
 	// 3. write response
 	dis.handler.SendDirectResponse(rspHeader, data, nil)
 }
+
+func (dis *DispatchFilter) writeRawResult(raw *RawResponse, code int) {
+	contentType := raw.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	rspHeader := mosnhttp.ResponseHeader{
+		ResponseHeader: &fasthttp.ResponseHeader{},
+	}
+	rspHeader.Set("Content-Type", contentType)
+	rspHeader.SetStatusCode(code)
+	dis.handler.SendDirectResponse(rspHeader, buffer.NewIoBufferBytes(raw.Body), nil)
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_httpStatus(t *testing.T) {
+	assert.Equal(t, http.StatusOK, httpStatus(nil))
+	assert.Equal(t, http.StatusInternalServerError, httpStatus(errors.New("error")))
+	assert.Equal(t, http.StatusBadRequest, httpStatus(status.Error(codes.InvalidArgument, "")))
+	assert.Equal(t, http.StatusNotFound, httpStatus(status.Error(codes.NotFound, "")))
+	assert.Equal(t, http.StatusForbidden, httpStatus(status.Error(codes.PermissionDenied, "")))
+	assert.Equal(t, http.StatusServiceUnavailable, httpStatus(status.Error(codes.Unavailable, "")))
+	assert.Equal(t, http.StatusInternalServerError, httpStatus(status.Error(codes.Internal, "")))
+}
@@ -23,3 +23,16 @@ import (
 type Endpoint interface {
 	Handle(ctx context.Context, params ParamsScanner) (jsonObject map[string]interface{}, err error)
 }
+
+// RawEndpoint is implemented by the endpoints responding with the raw content rather than json, e.g. the file downloads
+type RawEndpoint interface {
+	Endpoint
+	// HandleRaw returns the raw response, or the json result like Handle if the raw response is nil
+	HandleRaw(ctx context.Context, params ParamsScanner) (raw *RawResponse, jsonObject map[string]interface{}, err error)
+}
+
+// RawResponse is the content responded as is
+type RawResponse struct {
+	ContentType string
+	Body        []byte
+}
@@ -20,11 +20,26 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/url"
+
+	"mosn.io/api"
 )
 
 type ContextKeyRequestData struct {
 }
 
+// ContextKeyRequestMethod is the key of the http method in the context
+type ContextKeyRequestMethod struct {
+}
+
+// ContextKeyRequestQuery is the key of the raw query string in the context
+type ContextKeyRequestQuery struct {
+}
+
+// ContextKeyRequestHeader is the key of the request headers in the context
+type ContextKeyRequestHeader struct {
+}
+
 type RequestHandler interface {
 	GetEndpoint(name string) (endpoint Endpoint, ok bool)
 }
@@ -41,3 +56,31 @@ func GetRequestData(ctx context.Context) (map[string]interface{}, error) {
 	}
 	return conf, nil
 }
+
+// GetRequestBody returns the raw request body, it returns nil if the request has no body
+func GetRequestBody(ctx context.Context) []byte {
+	data, _ := ctx.Value(ContextKeyRequestData{}).([]byte)
+	return data
+}
+
+// GetRequestMethod returns the http method of the request, e.g. GET
+func GetRequestMethod(ctx context.Context) string {
+	method, _ := ctx.Value(ContextKeyRequestMethod{}).(string)
+	return method
+}
+
+// GetRequestQuery returns the parsed query string of the request
+func GetRequestQuery(ctx context.Context) (url.Values, error) {
+	query, _ := ctx.Value(ContextKeyRequestQuery{}).(string)
+	return url.ParseQuery(query)
+}
+
+// GetRequestHeader returns the value of the request header, it returns "" if the header is not found
+func GetRequestHeader(ctx context.Context, key string) string {
+	header, ok := ctx.Value(ContextKeyRequestHeader{}).(api.HeaderMap)
+	if !ok || header == nil {
+		return ""
+	}
+	v, _ := header.Get(key)
+	return v
+}
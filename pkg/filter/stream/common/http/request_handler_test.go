@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"mosn.io/mosn/pkg/protocol"
 )
 
 func Test_GetRequestData_isError(t *testing.T) {
@@ -46,3 +47,24 @@ func Test_GetRequestData_isOk(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expected, data)
 }
+
+func Test_GetRequestInfo(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, GetRequestBody(ctx))
+	assert.Equal(t, "", GetRequestMethod(ctx))
+	assert.Equal(t, "", GetRequestHeader(ctx, "Content-Type"))
+	query, err := GetRequestQuery(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, query)
+
+	ctx = context.WithValue(ctx, ContextKeyRequestData{}, []byte("body"))
+	ctx = context.WithValue(ctx, ContextKeyRequestMethod{}, "POST")
+	ctx = context.WithValue(ctx, ContextKeyRequestQuery{}, "a=1&a=2")
+	ctx = context.WithValue(ctx, ContextKeyRequestHeader{}, protocol.CommonHeader{"Content-Type": "text/plain"})
+	assert.Equal(t, []byte("body"), GetRequestBody(ctx))
+	assert.Equal(t, "POST", GetRequestMethod(ctx))
+	assert.Equal(t, "text/plain", GetRequestHeader(ctx, "Content-Type"))
+	query, err = GetRequestQuery(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2"}, query["a"])
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// configurationRoutes are the routes of /gateway/configuration
+func configurationRoutes() []*route {
+	return []*route{
+		// GET /gateway/configuration/{store_name}?app_id=app&group=g&label=l&keys=k1&keys=k2
+		newRoute(methodGet, "{store_name}",
+			func() proto.Message { return &runtimev1pb.GetConfigurationRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetConfiguration(ctx, req.(*runtimev1pb.GetConfigurationRequest))
+			}),
+		// POST /gateway/configuration/{store_name} with the body {"app_id":"app","items":[...]}
+		newRoute(methodPost, "{store_name}",
+			func() proto.Message { return &runtimev1pb.SaveConfigurationRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.SaveConfiguration(ctx, req.(*runtimev1pb.SaveConfigurationRequest))
+			}),
+		// DELETE /gateway/configuration/{store_name}?app_id=app&keys=k1&keys=k2
+		newRoute(methodDelete, "{store_name}",
+			func() proto.Message { return &runtimev1pb.DeleteConfigurationRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.DeleteConfiguration(ctx, req.(*runtimev1pb.DeleteConfigurationRequest))
+			}),
+	}
+}
@@ -0,0 +1,285 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// maxBufferedFileSize is the size limit of the file contents sent and received by the gateway,
+// the contents are buffered in the memory of the sidecar. The larger files are transferred by the grpc api
+var maxBufferedFileSize = 32 << 20
+
+// fileRoutes are the routes of /gateway/file, the file names can contain slashes
+func fileRoutes() []*route {
+	return []*route{
+		// GET /gateway/file/{store_name}/{name...}?offset=0&length=1024
+		// The content is returned as {"data":"base64 encoded content"}, up to maxBufferedFileSize
+		newRoute(methodGet, "{store_name}/{name...}",
+			func() proto.Message { return &runtimev1pb.GetFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				stream := &getFileStream{ctx: ctx}
+				if err := api.GetFile(req.(*runtimev1pb.GetFileRequest), stream); err != nil {
+					return nil, err
+				}
+				return &runtimev1pb.GetFileResponse{Data: stream.data.Bytes()}, nil
+			}).withoutGuard(),
+		// PUT /gateway/file/{store_name}/{name...}?metadata.key=value&content_md5=md5 with the raw content as the body
+		newRoute(methodPut, "{store_name}/{name...}",
+			func() proto.Message { return &runtimev1pb.PutFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				if len(req.(*runtimev1pb.PutFileRequest).Data) > maxBufferedFileSize {
+					return nil, errFileTooLarge()
+				}
+				stream := &putFileStream{ctx: ctx, req: req.(*runtimev1pb.PutFileRequest)}
+				if err := api.PutFile(stream); err != nil {
+					return nil, err
+				}
+				return &emptypb.Empty{}, nil
+			}).withRawBody("data", "").withoutGuard(),
+		// DELETE /gateway/file/{store_name}/{name...}
+		newRoute(methodDelete, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.DelFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.DelFile(ctx, req.(*runtimev1pb.DelFileRequest))
+			}),
+		// GET /gateway/file/{store_name}?request.name=prefix&page_size=10&marker=m
+		newRoute(methodGet, "{request.store_name}",
+			func() proto.Message { return &runtimev1pb.ListFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.ListFile(ctx, req.(*runtimev1pb.ListFileRequest))
+			}),
+	}
+}
+
+// fileMetaRoutes are the routes of /gateway/file_meta
+func fileMetaRoutes() []*route {
+	return []*route{
+		// GET /gateway/file_meta/{store_name}/{name...}
+		newRoute(methodGet, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.GetFileMetaRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetFileMeta(ctx, req.(*runtimev1pb.GetFileMetaRequest))
+			}),
+	}
+}
+
+// fileUploadOffsetRoutes are the routes of /gateway/file_upload_offset
+func fileUploadOffsetRoutes() []*route {
+	return []*route{
+		// GET /gateway/file_upload_offset/{store_name}/{name...}
+		newRoute(methodGet, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.GetFileUploadOffsetRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetFileUploadOffset(ctx, req.(*runtimev1pb.GetFileUploadOffsetRequest))
+			}),
+	}
+}
+
+// fileSignURLRoutes are the routes of /gateway/file_sign_url
+func fileSignURLRoutes() []*route {
+	return []*route{
+		// GET /gateway/file_sign_url/{store_name}/{name...}?method=GET&expires_in_sec=600
+		newRoute(methodGet, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.SignFileURLRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.SignFileURL(ctx, req.(*runtimev1pb.SignFileURLRequest))
+			}),
+	}
+}
+
+// fileDirRoutes are the routes of /gateway/file_dir
+func fileDirRoutes() []*route {
+	return []*route{
+		// POST /gateway/file_dir/{store_name}/{name...}
+		newRoute(methodPost, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.MkdirRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.Mkdir(ctx, req.(*runtimev1pb.MkdirRequest))
+			}),
+	}
+}
+
+// fileRenameRoutes are the routes of /gateway/file_rename
+func fileRenameRoutes() []*route {
+	return []*route{
+		// POST /gateway/file_rename/{store_name}/{name...}?target=new.txt
+		newRoute(methodPost, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.RenameFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.RenameFile(ctx, req.(*runtimev1pb.RenameFileRequest))
+			}),
+	}
+}
+
+// fileCopyRoutes are the routes of /gateway/file_copy
+func fileCopyRoutes() []*route {
+	return []*route{
+		// POST /gateway/file_copy/{store_name}/{name...}?target=copy.txt
+		newRoute(methodPost, "{request.store_name}/{request.name...}",
+			func() proto.Message { return &runtimev1pb.CopyFileRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.CopyFile(ctx, req.(*runtimev1pb.CopyFileRequest))
+			}),
+	}
+}
+
+// signedFileVerifier verifies the urls signed by the stores whose urls are served by the sidecar,
+// it is implemented by the default Runtime API
+type signedFileVerifier interface {
+	VerifySignedFileURL(ctx context.Context, storeName string, method string, query url.Values) (*runtimev1pb.FileRequest, error)
+}
+
+// signedFileEndpoint serves the files by the signed urls of the stores like local.
+// GET /gateway/signed_file/{store_name}?name=a.txt&method=GET&expires=1650000000&signature=hex returns the raw content,
+// and PUT stores the raw body as the file. Both are buffered by the sidecar, so the files are limited to maxBufferedFileSize
+type signedFileEndpoint struct {
+	runtimeAPI func() runtimev1pb.RuntimeServer
+}
+
+func newSignedFileEndpoint(runtimeAPI func() runtimev1pb.RuntimeServer) *signedFileEndpoint {
+	return &signedFileEndpoint{runtimeAPI: runtimeAPI}
+}
+
+// Handle returns the content of the file as {"data":"base64 encoded content"}
+func (e *signedFileEndpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	raw, result, err := e.HandleRaw(ctx, params)
+	if raw != nil {
+		return encode(&runtimev1pb.GetFileResponse{Data: raw.Body})
+	}
+	return result, err
+}
+
+func (e *signedFileEndpoint) HandleRaw(ctx context.Context, params http.ParamsScanner) (*http.RawResponse, map[string]interface{}, error) {
+	var segments []string
+	for params.HasNext() {
+		segments = append(segments, params.Next())
+	}
+	if len(segments) != 1 {
+		return rawErrorResult(status.Errorf(codes.NotFound, "path %s is not found", strings.Join(segments, "/")))
+	}
+	api := e.runtimeAPI()
+	if api == nil {
+		return rawErrorResult(status.Error(codes.Unavailable, "runtime api is not ready"))
+	}
+	verifier, ok := api.(signedFileVerifier)
+	if !ok {
+		return rawErrorResult(status.Error(codes.Unimplemented, "signed urls are not supported"))
+	}
+	query, err := http.GetRequestQuery(ctx)
+	if err != nil {
+		return rawErrorResult(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	method := strings.ToUpper(http.GetRequestMethod(ctx))
+	req, err := verifier.VerifySignedFileURL(ctx, segments[0], method, query)
+	if err != nil {
+		return rawErrorResult(err)
+	}
+	switch method {
+	case methodGet:
+		stream := &getFileStream{ctx: ctx}
+		if err = api.GetFile(&runtimev1pb.GetFileRequest{StoreName: req.StoreName, Name: req.Name, Metadata: req.Metadata}, stream); err != nil {
+			return rawErrorResult(err)
+		}
+		return &http.RawResponse{ContentType: mime.TypeByExtension(path.Ext(req.Name)), Body: stream.data.Bytes()}, nil, nil
+	case methodPut:
+		body := http.GetRequestBody(ctx)
+		if len(body) > maxBufferedFileSize {
+			return rawErrorResult(errFileTooLarge())
+		}
+		stream := &putFileStream{ctx: ctx, req: &runtimev1pb.PutFileRequest{
+			StoreName: req.StoreName,
+			Name:      req.Name,
+			Metadata:  req.Metadata,
+			Data:      body,
+		}}
+		if err = api.PutFile(stream); err != nil {
+			return rawErrorResult(err)
+		}
+		result, err := encode(&emptypb.Empty{})
+		return nil, result, err
+	default:
+		return rawErrorResult(status.Errorf(codes.Unimplemented, "method %s is not allowed", method))
+	}
+}
+
+func rawErrorResult(err error) (*http.RawResponse, map[string]interface{}, error) {
+	result, err := errorResult(err)
+	return nil, result, err
+}
+
+func errFileTooLarge() error {
+	return status.Errorf(codes.ResourceExhausted, "files larger than %d bytes are not served by the gateway", maxBufferedFileSize)
+}
+
+// getFileStream collects the file content sent by GetFile, it fails GetFile once the content exceeds maxBufferedFileSize
+type getFileStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (s *getFileStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *getFileStream) Send(resp *runtimev1pb.GetFileResponse) error {
+	if s.data.Len()+len(resp.Data) > maxBufferedFileSize {
+		return errFileTooLarge()
+	}
+	s.data.Write(resp.Data)
+	return nil
+}
+
+// putFileStream receives the whole file content as a single request in PutFile
+type putFileStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *runtimev1pb.PutFileRequest
+}
+
+func (s *putFileStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *putFileStream) Recv() (*runtimev1pb.PutFileRequest, error) {
+	if s.req == nil {
+		return nil, io.EOF
+	}
+	req := s.req
+	s.req = nil
+	return req, nil
+}
+
+func (s *putFileStream) SendAndClose(*emptypb.Empty) error {
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	"mosn.io/layotto/pkg/grpc/default_api"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+var singleton = New(func() runtimev1pb.RuntimeServer {
+	if default_api.LayottoAPISingleton == nil {
+		return nil
+	}
+	return default_api.LayottoAPISingleton
+})
+
+// GetDefault returns the Gateway sharing the Runtime API implementation with the grpc server
+func GetDefault() *Gateway {
+	return singleton
+}
+
+// Gateway is the http/json facade of the Runtime gRPC API.
+// It is served by the gateway stream filter, with the REST-style paths like /gateway/state/{store_name}/{key}
+type Gateway struct {
+	endpointRegistry map[string]http.Endpoint
+}
+
+// New creates a Gateway, the Runtime API is got by the function on each request
+// because it is not registered until the grpc server starts
+func New(runtimeAPI func() runtimev1pb.RuntimeServer) *Gateway {
+	g := &Gateway{endpointRegistry: make(map[string]http.Endpoint)}
+	g.AddEndpoint("state", newEndpoint(runtimeAPI, stateRoutes()))
+	g.AddEndpoint("configuration", newEndpoint(runtimeAPI, configurationRoutes()))
+	g.AddEndpoint("lock", newEndpoint(runtimeAPI, lockRoutes()))
+	g.AddEndpoint("sequencer", newEndpoint(runtimeAPI, sequencerRoutes()))
+	g.AddEndpoint("publish", newEndpoint(runtimeAPI, publishRoutes()))
+	g.AddEndpoint("secrets", newEndpoint(runtimeAPI, secretRoutes()))
+	g.AddEndpoint("file", newEndpoint(runtimeAPI, fileRoutes()))
+	g.AddEndpoint("file_meta", newEndpoint(runtimeAPI, fileMetaRoutes()))
+	g.AddEndpoint("file_upload_offset", newEndpoint(runtimeAPI, fileUploadOffsetRoutes()))
+	g.AddEndpoint("file_sign_url", newEndpoint(runtimeAPI, fileSignURLRoutes()))
+	g.AddEndpoint("file_dir", newEndpoint(runtimeAPI, fileDirRoutes()))
+	g.AddEndpoint("file_rename", newEndpoint(runtimeAPI, fileRenameRoutes()))
+	g.AddEndpoint("file_copy", newEndpoint(runtimeAPI, fileCopyRoutes()))
+	g.AddEndpoint("signed_file", newSignedFileEndpoint(runtimeAPI))
+	return g
+}
+
+// GetEndpoint get an Endpoint from Gateway with name.
+func (g *Gateway) GetEndpoint(name string) (endpoint http.Endpoint, ok bool) {
+	e, ok := g.endpointRegistry[name]
+	return e, ok
+}
+
+// AddEndpoint add an Endpoint to Gateway.
+func (g *Gateway) AddEndpoint(name string, ep http.Endpoint) {
+	if _, ok := g.endpointRegistry[name]; ok {
+		log.DefaultLogger.Warnf("Duplicate Endpoint name: %v !", name)
+	}
+	g.endpointRegistry[name] = ep
+}
+
+// guardedAPI is implemented by the Runtime API whose components can be swapped at runtime,
+// the requests are tracked by the guard so that they are drained before the swapping
+type guardedAPI interface {
+	RequestGuard() *lifecycle.RequestGuard
+}
+
+// guard returns the request guard of the Runtime API, the nil guard runs the requests directly
+func guard(api runtimev1pb.RuntimeServer) *lifecycle.RequestGuard {
+	if g, ok := api.(guardedAPI); ok {
+		return g.RequestGuard()
+	}
+	return nil
+}
+
+// endpoint dispatches the requests to the Runtime API according to the routes
+type endpoint struct {
+	runtimeAPI func() runtimev1pb.RuntimeServer
+	routes     []*route
+}
+
+func newEndpoint(runtimeAPI func() runtimev1pb.RuntimeServer, routes []*route) *endpoint {
+	return &endpoint{runtimeAPI: runtimeAPI, routes: routes}
+}
+
+// Handle invokes the Runtime API of the matched route, the response message is returned as json.
+// The errors are returned with grpc status codes, which are converted to the http status codes by the filter.
+func (e *endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	var segments []string
+	for params.HasNext() {
+		segments = append(segments, params.Next())
+	}
+	method := strings.ToUpper(http.GetRequestMethod(ctx))
+	var matched bool
+	for _, r := range e.routes {
+		values, ok := r.match(segments)
+		if !ok {
+			continue
+		}
+		matched = true
+		if r.method != method {
+			continue
+		}
+		return e.handle(ctx, r, values)
+	}
+	if matched {
+		return errorResult(status.Errorf(codes.Unimplemented, "method %s is not allowed", method))
+	}
+	return errorResult(status.Errorf(codes.NotFound, "path %s is not found", strings.Join(segments, "/")))
+}
+
+func (e *endpoint) handle(ctx context.Context, r *route, values map[string]string) (map[string]interface{}, error) {
+	api := e.runtimeAPI()
+	if api == nil {
+		return errorResult(status.Error(codes.Unavailable, "runtime api is not ready"))
+	}
+	req, err := r.decode(ctx, values)
+	if err != nil {
+		return errorResult(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	var resp proto.Message
+	call := func() {
+		resp, err = r.call(ctx, api, req)
+	}
+	if r.unguarded {
+		call()
+	} else {
+		guard(api).Track(call)
+	}
+	if err != nil {
+		log.DefaultLogger.Errorf("[gateway] %s %s failed: %v", r.method, r.pattern, err)
+		return errorResult(err)
+	}
+	return encode(resp)
+}
+
+// errorResult returns the error as json, e.g. {"code":"NotFound","message":"..."}
+func errorResult(err error) (map[string]interface{}, error) {
+	s := status.Convert(err)
+	return map[string]interface{}{
+		"code":    s.Code().String(),
+		"message": s.Message(),
+	}, s.Err()
+}
@@ -0,0 +1,369 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"mosn.io/mosn/pkg/protocol"
+
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+type fakeRuntime struct {
+	runtimev1pb.UnimplementedRuntimeServer
+	req interface{}
+}
+
+func (f *fakeRuntime) GetState(ctx context.Context, req *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
+	f.req = req
+	if req.Key == "missing" {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &runtimev1pb.GetStateResponse{Data: []byte("value"), Etag: "1"}, nil
+}
+
+func (f *fakeRuntime) SaveState(ctx context.Context, req *runtimev1pb.SaveStateRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRuntime) GetConfiguration(ctx context.Context, req *runtimev1pb.GetConfigurationRequest) (*runtimev1pb.GetConfigurationResponse, error) {
+	f.req = req
+	return &runtimev1pb.GetConfigurationResponse{}, nil
+}
+
+func (f *fakeRuntime) Unlock(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
+	f.req = req
+	return &runtimev1pb.UnlockResponse{Status: runtimev1pb.UnlockResponse_LOCK_BELONG_TO_OTHERS}, nil
+}
+
+func (f *fakeRuntime) GetNextId(ctx context.Context, req *runtimev1pb.GetNextIdRequest) (*runtimev1pb.GetNextIdResponse, error) {
+	f.req = req
+	return &runtimev1pb.GetNextIdResponse{NextId: 10}, nil
+}
+
+func (f *fakeRuntime) PublishEvent(ctx context.Context, req *runtimev1pb.PublishEventRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRuntime) GetFile(req *runtimev1pb.GetFileRequest, stream runtimev1pb.Runtime_GetFileServer) error {
+	f.req = req
+	stream.Send(&runtimev1pb.GetFileResponse{Data: []byte("hello ")})
+	return stream.Send(&runtimev1pb.GetFileResponse{Data: []byte("world")})
+}
+
+func (f *fakeRuntime) PutFile(stream runtimev1pb.Runtime_PutFileServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	f.req = req
+	if _, err := stream.Recv(); err != io.EOF {
+		return err
+	}
+	return stream.SendAndClose(&emptypb.Empty{})
+}
+
+func (f *fakeRuntime) GetFileUploadOffset(ctx context.Context, req *runtimev1pb.GetFileUploadOffsetRequest) (*runtimev1pb.GetFileUploadOffsetResponse, error) {
+	f.req = req
+	return &runtimev1pb.GetFileUploadOffsetResponse{Offset: 1024}, nil
+}
+
+func (f *fakeRuntime) SignFileURL(ctx context.Context, req *runtimev1pb.SignFileURLRequest) (*runtimev1pb.SignFileURLResponse, error) {
+	f.req = req
+	return &runtimev1pb.SignFileURLResponse{Url: "http://127.0.0.1/signed"}, nil
+}
+
+func (f *fakeRuntime) VerifySignedFileURL(ctx context.Context, storeName string, method string, query url.Values) (*runtimev1pb.FileRequest, error) {
+	if query.Get("signature") != "valid" || query.Get("method") != method {
+		return nil, status.Error(codes.PermissionDenied, "invalid signature")
+	}
+	return &runtimev1pb.FileRequest{StoreName: storeName, Name: query.Get("name"), Metadata: map[string]string{"k": "v"}}, nil
+}
+
+func (f *fakeRuntime) DelFile(ctx context.Context, req *runtimev1pb.DelFileRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRuntime) Mkdir(ctx context.Context, req *runtimev1pb.MkdirRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRuntime) RenameFile(ctx context.Context, req *runtimev1pb.RenameFileRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRuntime) CopyFile(ctx context.Context, req *runtimev1pb.CopyFileRequest) (*emptypb.Empty, error) {
+	f.req = req
+	return &emptypb.Empty{}, nil
+}
+
+func newRequestContext(method string, query string, body string, header map[string]string) context.Context {
+	ctx := context.WithValue(context.Background(), http.ContextKeyRequestMethod{}, method)
+	ctx = context.WithValue(ctx, http.ContextKeyRequestQuery{}, query)
+	if body != "" {
+		ctx = context.WithValue(ctx, http.ContextKeyRequestData{}, []byte(body))
+	}
+	return context.WithValue(ctx, http.ContextKeyRequestHeader{}, protocol.CommonHeader(header))
+}
+
+func TestGateway(t *testing.T) {
+	runtime := &fakeRuntime{}
+	g := New(func() runtimev1pb.RuntimeServer { return runtime })
+	handle := func(ctx context.Context, path string) (map[string]interface{}, error) {
+		resolver := http.NewPathResolver(path)
+		ep, ok := g.GetEndpoint(resolver.Next())
+		if !ok {
+			return nil, status.Error(codes.NotFound, path)
+		}
+		return ep.Handle(ctx, resolver)
+	}
+
+	t.Run("get state", func(t *testing.T) {
+		result, err := handle(newRequestContext("GET", "consistency=CONSISTENCY_STRONG&metadata.partition=p1", "", nil), "/state/redis/key1")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"data": base64.StdEncoding.EncodeToString([]byte("value")), "etag": "1"}, result)
+		req := runtime.req.(*runtimev1pb.GetStateRequest)
+		assert.Equal(t, "redis", req.StoreName)
+		assert.Equal(t, "key1", req.Key)
+		assert.Equal(t, runtimev1pb.StateOptions_CONSISTENCY_STRONG, req.Consistency)
+		assert.Equal(t, map[string]string{"partition": "p1"}, req.Metadata)
+
+		result, err = handle(newRequestContext("GET", "", "", nil), "/state/redis/missing")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "NotFound", result["code"])
+	})
+
+	t.Run("save state", func(t *testing.T) {
+		body := `{"states":[{"key":"k1","value":"` + base64.StdEncoding.EncodeToString([]byte("v1")) + `"}]}`
+		_, err := handle(newRequestContext("post", "", body, nil), "/state/redis")
+		assert.Nil(t, err)
+		req := runtime.req.(*runtimev1pb.SaveStateRequest)
+		assert.Equal(t, "redis", req.StoreName)
+		assert.Equal(t, "k1", req.States[0].Key)
+		assert.Equal(t, []byte("v1"), req.States[0].Value)
+
+		_, err = handle(newRequestContext("POST", "", `{"unknown":1}`, nil), "/state/redis")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("configuration", func(t *testing.T) {
+		_, err := handle(newRequestContext("GET", "app_id=app&keys=k1&keys=k2", "", nil), "/configuration/apollo")
+		assert.Nil(t, err)
+		req := runtime.req.(*runtimev1pb.GetConfigurationRequest)
+		assert.Equal(t, "app", req.AppId)
+		assert.Equal(t, []string{"k1", "k2"}, req.Keys)
+
+		_, err = handle(newRequestContext("GET", "unknown=1", "", nil), "/configuration/apollo")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("lock and sequencer", func(t *testing.T) {
+		result, err := handle(newRequestContext("DELETE", "lock_owner=owner", "", nil), "/lock/redis/res1")
+		assert.Nil(t, err)
+		assert.Equal(t, "LOCK_BELONG_TO_OTHERS", result["status"])
+		unlock := runtime.req.(*runtimev1pb.UnlockRequest)
+		assert.Equal(t, "redis", unlock.StoreName)
+		assert.Equal(t, "res1", unlock.ResourceId)
+		assert.Equal(t, "owner", unlock.LockOwner)
+
+		result, err = handle(newRequestContext("GET", "options.increment=STRONG", "", nil), "/sequencer/redis/key1")
+		assert.Nil(t, err)
+		assert.Equal(t, "10", result["next_id"])
+		assert.Equal(t, runtimev1pb.SequencerOptions_STRONG, runtime.req.(*runtimev1pb.GetNextIdRequest).Options.Increment)
+	})
+
+	t.Run("publish", func(t *testing.T) {
+		_, err := handle(newRequestContext("POST", "metadata.ttl=10", `{"hello":"world"}`, map[string]string{"Content-Type": "application/json"}), "/publish/redis/topic1")
+		assert.Nil(t, err)
+		req := runtime.req.(*runtimev1pb.PublishEventRequest)
+		assert.Equal(t, "topic1", req.Topic)
+		assert.Equal(t, `{"hello":"world"}`, string(req.Data))
+		assert.Equal(t, "application/json", req.DataContentType)
+		assert.Equal(t, map[string]string{"ttl": "10"}, req.Metadata)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		result, err := handle(newRequestContext("GET", "", "", nil), "/file/oss/dir/a.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("hello world")), result["data"])
+		assert.Equal(t, "dir/a.txt", runtime.req.(*runtimev1pb.GetFileRequest).Name)
+
+		_, err = handle(newRequestContext("GET", "offset=6&length=5", "", nil), "/file/oss/dir/a.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(6), runtime.req.(*runtimev1pb.GetFileRequest).Offset)
+		assert.Equal(t, int64(5), runtime.req.(*runtimev1pb.GetFileRequest).Length)
+
+		_, err = handle(newRequestContext("PUT", "metadata.storageType=Standard&resumable=true&offset=1024&content_md5=abc", "content", nil), "/file/oss/dir/a.txt")
+		assert.Nil(t, err)
+		req := runtime.req.(*runtimev1pb.PutFileRequest)
+		assert.Equal(t, "dir/a.txt", req.Name)
+		assert.Equal(t, []byte("content"), req.Data)
+		assert.Equal(t, map[string]string{"storageType": "Standard"}, req.Metadata)
+		assert.True(t, req.Resumable)
+		assert.Equal(t, int64(1024), req.Offset)
+		assert.Equal(t, "abc", req.ContentMd5)
+
+		result, err = handle(newRequestContext("GET", "", "", nil), "/file_upload_offset/oss/dir/a.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, "1024", result["offset"])
+		assert.Equal(t, "dir/a.txt", runtime.req.(*runtimev1pb.GetFileUploadOffsetRequest).Request.Name)
+
+		_, err = handle(newRequestContext("DELETE", "", "", nil), "/file/oss/dir/a.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, "dir/a.txt", runtime.req.(*runtimev1pb.DelFileRequest).Request.Name)
+
+		_, err = handle(newRequestContext("POST", "", "", nil), "/file_dir/oss/dir/sub")
+		assert.Nil(t, err)
+		assert.Equal(t, "dir/sub", runtime.req.(*runtimev1pb.MkdirRequest).Request.Name)
+
+		_, err = handle(newRequestContext("POST", "target=dir/b.txt", "", nil), "/file_rename/oss/dir/a.txt")
+		assert.Nil(t, err)
+		renameReq := runtime.req.(*runtimev1pb.RenameFileRequest)
+		assert.Equal(t, "dir/a.txt", renameReq.Request.Name)
+		assert.Equal(t, "dir/b.txt", renameReq.Target)
+
+		_, err = handle(newRequestContext("POST", "target=dir/c.txt", "", nil), "/file_copy/oss/dir/b.txt")
+		assert.Nil(t, err)
+		copyReq := runtime.req.(*runtimev1pb.CopyFileRequest)
+		assert.Equal(t, "dir/b.txt", copyReq.Request.Name)
+		assert.Equal(t, "dir/c.txt", copyReq.Target)
+	})
+
+	t.Run("signed file", func(t *testing.T) {
+		result, err := handle(newRequestContext("GET", "method=PUT&expires_in_sec=600", "", nil), "/file_sign_url/local/dir/a.txt")
+		assert.Nil(t, err)
+		assert.Equal(t, "http://127.0.0.1/signed", result["url"])
+		signReq := runtime.req.(*runtimev1pb.SignFileURLRequest)
+		assert.Equal(t, "dir/a.txt", signReq.Request.Name)
+		assert.Equal(t, "PUT", signReq.Method)
+		assert.Equal(t, int64(600), signReq.ExpiresInSec)
+
+		ep, _ := g.GetEndpoint("signed_file")
+		rawEndpoint := ep.(http.RawEndpoint)
+		raw, _, err := rawEndpoint.HandleRaw(newRequestContext("GET", "name=dir/a.txt&method=GET&signature=valid", "", nil), http.NewPathResolver("/local"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("hello world"), raw.Body)
+		assert.Equal(t, "text/plain; charset=utf-8", raw.ContentType)
+		assert.Equal(t, map[string]string{"k": "v"}, runtime.req.(*runtimev1pb.GetFileRequest).Metadata)
+
+		raw, _, err = rawEndpoint.HandleRaw(newRequestContext("PUT", "name=dir/a.txt&method=PUT&signature=valid", "content", nil), http.NewPathResolver("/local"))
+		assert.Nil(t, err)
+		assert.Nil(t, raw)
+		req := runtime.req.(*runtimev1pb.PutFileRequest)
+		assert.Equal(t, "dir/a.txt", req.Name)
+		assert.Equal(t, []byte("content"), req.Data)
+
+		raw, result, err = rawEndpoint.HandleRaw(newRequestContext("GET", "name=dir/a.txt&method=PUT&signature=valid", "", nil), http.NewPathResolver("/local"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, raw)
+		assert.Equal(t, "PermissionDenied", result["code"])
+
+		result, err = handle(newRequestContext("GET", "name=dir/a.txt&method=GET&signature=valid", "", nil), "/signed_file/local")
+		assert.Nil(t, err)
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("hello world")), result["data"])
+		_, err = handle(newRequestContext("GET", "", "", nil), "/signed_file/local/a.txt")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		limit := maxBufferedFileSize
+		maxBufferedFileSize = 4
+		defer func() { maxBufferedFileSize = limit }()
+
+		ep, _ := g.GetEndpoint("signed_file")
+		rawEndpoint := ep.(http.RawEndpoint)
+		_, _, err := rawEndpoint.HandleRaw(newRequestContext("GET", "name=dir/a.txt&method=GET&signature=valid", "", nil), http.NewPathResolver("/local"))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, _, err = rawEndpoint.HandleRaw(newRequestContext("PUT", "name=dir/a.txt&method=PUT&signature=valid", "content", nil), http.NewPathResolver("/local"))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = handle(newRequestContext("GET", "", "", nil), "/file/local/dir/a.txt")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = handle(newRequestContext("PUT", "", "content", nil), "/file/local/dir/a.txt")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := handle(newRequestContext("GET", "", "", nil), "/state/redis/key1/other")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = handle(newRequestContext("PATCH", "", "", nil), "/state/redis/key1")
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		// not implemented by the runtime
+		_, err = handle(newRequestContext("GET", "", "", nil), "/secrets/local/key1")
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("runtime not ready", func(t *testing.T) {
+		g := New(func() runtimev1pb.RuntimeServer { return nil })
+		ep, _ := g.GetEndpoint("state")
+		_, err := ep.Handle(newRequestContext("GET", "", "", nil), http.NewPathResolver("/redis/key1"))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+type guardedRuntime struct {
+	fakeRuntime
+	guard *lifecycle.RequestGuard
+}
+
+func (g *guardedRuntime) RequestGuard() *lifecycle.RequestGuard {
+	return g.guard
+}
+
+func TestGatewayGuard(t *testing.T) {
+	api := &guardedRuntime{guard: lifecycle.NewRequestGuard()}
+	g := New(func() runtimev1pb.RuntimeServer { return api })
+	handle := func(ctx context.Context, endpoint string, path string) error {
+		ep, _ := g.GetEndpoint(endpoint)
+		_, err := ep.Handle(ctx, http.NewPathResolver(path))
+		return err
+	}
+	resume, err := api.guard.Drain(context.Background())
+	assert.Nil(t, err)
+
+	// the unary requests wait for the swapping
+	done := make(chan error, 1)
+	go func() {
+		done <- handle(newRequestContext("GET", "", "", nil), "state", "/redis/key1")
+	}()
+	select {
+	case <-done:
+		t.Fatal("the request is not blocked by the guard")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// the file streams look up the stores under the guard themselves
+	assert.Nil(t, handle(newRequestContext("GET", "", "", nil), "file", "/local/a.txt"))
+
+	resume()
+	assert.Nil(t, <-done)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// lockRoutes are the routes of /gateway/lock
+func lockRoutes() []*route {
+	return []*route{
+		// POST /gateway/lock/{store_name}/{resource_id} with the body {"lock_owner":"owner","expire":10}
+		newRoute(methodPost, "{store_name}/{resource_id}",
+			func() proto.Message { return &runtimev1pb.TryLockRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.TryLock(ctx, req.(*runtimev1pb.TryLockRequest))
+			}),
+		// DELETE /gateway/lock/{store_name}/{resource_id}?lock_owner=owner
+		newRoute(methodDelete, "{store_name}/{resource_id}",
+			func() proto.Message { return &runtimev1pb.UnlockRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.Unlock(ctx, req.(*runtimev1pb.UnlockRequest))
+			}),
+		// POST /gateway/lock/{store_name}/{resource_id}/keepalive with the body {"lock_owner":"owner","expire":10}
+		newRoute(methodPost, "{store_name}/{resource_id}/keepalive",
+			func() proto.Message { return &runtimev1pb.LockKeepAliveRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.LockKeepAlive(ctx, req.(*runtimev1pb.LockKeepAliveRequest))
+			}),
+	}
+}
+
+// sequencerRoutes are the routes of /gateway/sequencer
+func sequencerRoutes() []*route {
+	return []*route{
+		// GET /gateway/sequencer/{store_name}/{key}?options.increment=STRONG
+		newRoute(methodGet, "{store_name}/{key}",
+			func() proto.Message { return &runtimev1pb.GetNextIdRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetNextId(ctx, req.(*runtimev1pb.GetNextIdRequest))
+			}),
+	}
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// publishRoutes are the routes of /gateway/publish
+func publishRoutes() []*route {
+	return []*route{
+		// POST /gateway/publish/{pubsub_name}/{topic}?metadata.key=value
+		// The raw body is published, with the content type of the request
+		newRoute(methodPost, "{pubsub_name}/{topic}",
+			func() proto.Message { return &runtimev1pb.PublishEventRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.PublishEvent(ctx, req.(*runtimev1pb.PublishEventRequest))
+			}).withRawBody("data", "data_content_type"),
+	}
+}
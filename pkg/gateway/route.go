@@ -0,0 +1,268 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+const (
+	methodGet    = "GET"
+	methodPost   = "POST"
+	methodPut    = "PUT"
+	methodDelete = "DELETE"
+)
+
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// route maps a REST-style request to a Runtime API.
+// The request message is decoded from the json body, then the fields in the path and the query string are set.
+type route struct {
+	method string
+	// pattern is the path after the endpoint name, the segments like {store_name} set the fields of the request message.
+	// The nested fields are separated by dots, e.g. {request.store_name},
+	// and the last segment like {name...} takes the rest of the path.
+	pattern  string
+	segments []string
+	// body is the bytes field set by the raw request body, the body is decoded as json if it is empty
+	body string
+	// contentType is the string field set by the Content-Type header
+	contentType string
+	newRequest  func() proto.Message
+	call        func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error)
+	unguarded   bool
+}
+
+// newRoute creates a route whose request message is decoded from json
+func newRoute(method string, pattern string, newRequest func() proto.Message,
+	call func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error)) *route {
+	r := &route{
+		method:     method,
+		pattern:    pattern,
+		newRequest: newRequest,
+		call:       call,
+	}
+	if pattern != "" {
+		r.segments = strings.Split(pattern, "/")
+	}
+	return r
+}
+
+// withRawBody sets the bytes field and the content type field by the raw request body
+func (r *route) withRawBody(body string, contentType string) *route {
+	r.body = body
+	r.contentType = contentType
+	return r
+}
+
+// withoutGuard calls the api without the request guard, it is used by the long-lived streams,
+// which look up the components under the guard themselves
+func (r *route) withoutGuard() *route {
+	r.unguarded = true
+	return r
+}
+
+// match returns the field values in the path if the path segments match the pattern
+func (r *route) match(segments []string) (map[string]string, bool) {
+	values := make(map[string]string)
+	for i, s := range r.segments {
+		if i >= len(segments) {
+			return nil, false
+		}
+		if !strings.HasPrefix(s, "{") {
+			if s != segments[i] {
+				return nil, false
+			}
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+		if strings.HasSuffix(name, "...") {
+			values[strings.TrimSuffix(name, "...")] = strings.Join(segments[i:], "/")
+			return values, true
+		}
+		values[name] = segments[i]
+	}
+	return values, len(segments) == len(r.segments)
+}
+
+// decode creates the request message from the body, the path values and the query string
+func (r *route) decode(ctx context.Context, values map[string]string) (proto.Message, error) {
+	req := r.newRequest()
+	m := req.ProtoReflect()
+	body := http.GetRequestBody(ctx)
+	if r.body != "" {
+		if err := setField(m, r.body, []string{string(body)}); err != nil {
+			return nil, err
+		}
+		if ct := http.GetRequestHeader(ctx, "Content-Type"); ct != "" && r.contentType != "" {
+			if err := setField(m, r.contentType, []string{ct}); err != nil {
+				return nil, err
+			}
+		}
+	} else if len(body) > 0 {
+		if err := protojson.Unmarshal(body, req); err != nil {
+			return nil, fmt.Errorf("invalid request body: %s", err.Error())
+		}
+	}
+	query, err := http.GetRequestQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query string: %s", err.Error())
+	}
+	for k, v := range query {
+		if err := setField(m, k, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range values {
+		if err := setField(m, k, []string{v}); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// encode converts the response message to json
+func encode(resp proto.Message) (map[string]interface{}, error) {
+	if resp == nil {
+		return nil, nil
+	}
+	b, err := marshalOptions.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{})
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// setField sets the field of m named by the path like "request.store_name" to the values.
+// The repeated fields are set to all the values, and the others are set to the last value.
+// The key of a map field follows the field name, e.g. "metadata.content-type".
+func setField(m protoreflect.Message, path string, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fields := m.Descriptor().Fields()
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("unknown field %s", path)
+		}
+		last := i == len(names)-1
+		switch {
+		case fd.IsMap():
+			if last || fd.MapKey().Kind() != protoreflect.StringKind {
+				return fmt.Errorf("field %s is not a map with string keys", path)
+			}
+			v, err := scalarValue(fd.MapValue(), values[len(values)-1])
+			if err != nil {
+				return fmt.Errorf("invalid field %s: %s", path, err.Error())
+			}
+			key := strings.Join(names[i+1:], ".")
+			m.Mutable(fd).Map().Set(protoreflect.ValueOfString(key).MapKey(), v)
+			return nil
+		case fd.IsList():
+			if !last {
+				return fmt.Errorf("field %s is a list", path)
+			}
+			list := m.Mutable(fd).List()
+			for _, s := range values {
+				v, err := scalarValue(fd, s)
+				if err != nil {
+					return fmt.Errorf("invalid field %s: %s", path, err.Error())
+				}
+				list.Append(v)
+			}
+			return nil
+		case fd.Kind() == protoreflect.MessageKind:
+			if last {
+				return fmt.Errorf("field %s is a message", path)
+			}
+			m = m.Mutable(fd).Message()
+		default:
+			if !last {
+				return fmt.Errorf("unknown field %s", path)
+			}
+			v, err := scalarValue(fd, values[len(values)-1])
+			if err != nil {
+				return fmt.Errorf("invalid field %s: %s", path, err.Error())
+			}
+			m.Set(fd, v)
+			return nil
+		}
+	}
+	return nil
+}
+
+// scalarValue parses s as the value of the scalar field
+func scalarValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(s)), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(v)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %s", s)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+	}
+}
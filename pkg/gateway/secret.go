@@ -0,0 +1,43 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// secretRoutes are the routes of /gateway/secrets
+func secretRoutes() []*route {
+	return []*route{
+		// GET /gateway/secrets/{store_name}
+		newRoute(methodGet, "{store_name}",
+			func() proto.Message { return &runtimev1pb.GetBulkSecretRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetBulkSecret(ctx, req.(*runtimev1pb.GetBulkSecretRequest))
+			}),
+		// GET /gateway/secrets/{store_name}/{key}
+		newRoute(methodGet, "{store_name}/{key}",
+			func() proto.Message { return &runtimev1pb.GetSecretRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetSecret(ctx, req.(*runtimev1pb.GetSecretRequest))
+			}),
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// stateRoutes are the routes of /gateway/state
+func stateRoutes() []*route {
+	return []*route{
+		// GET /gateway/state/{store_name}/{key}
+		newRoute(methodGet, "{store_name}/{key}",
+			func() proto.Message { return &runtimev1pb.GetStateRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetState(ctx, req.(*runtimev1pb.GetStateRequest))
+			}),
+		// POST /gateway/state/{store_name} with the body {"states":[{"key":"k","value":"base64 encoded value"}]}
+		newRoute(methodPost, "{store_name}",
+			func() proto.Message { return &runtimev1pb.SaveStateRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.SaveState(ctx, req.(*runtimev1pb.SaveStateRequest))
+			}),
+		// DELETE /gateway/state/{store_name}/{key}
+		newRoute(methodDelete, "{store_name}/{key}",
+			func() proto.Message { return &runtimev1pb.DeleteStateRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.DeleteState(ctx, req.(*runtimev1pb.DeleteStateRequest))
+			}),
+		// POST /gateway/state/{store_name}/bulk with the body {"keys":["k1","k2"]}
+		newRoute(methodPost, "{store_name}/bulk",
+			func() proto.Message { return &runtimev1pb.GetBulkStateRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.GetBulkState(ctx, req.(*runtimev1pb.GetBulkStateRequest))
+			}),
+		// DELETE /gateway/state/{store_name} with the body {"states":[{"key":"k1"}]}
+		newRoute(methodDelete, "{store_name}",
+			func() proto.Message { return &runtimev1pb.DeleteBulkStateRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.DeleteBulkState(ctx, req.(*runtimev1pb.DeleteBulkStateRequest))
+			}),
+		// POST /gateway/state/{store_name}/transaction with the body {"operations":[...]}
+		newRoute(methodPost, "{storeName}/transaction",
+			func() proto.Message { return &runtimev1pb.ExecuteStateTransactionRequest{} },
+			func(ctx context.Context, api runtimev1pb.RuntimeServer, req proto.Message) (proto.Message, error) {
+				return api.ExecuteStateTransaction(ctx, req.(*runtimev1pb.ExecuteStateTransactionRequest))
+			}),
+	}
+}
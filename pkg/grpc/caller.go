@@ -0,0 +1,51 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// CallerAppId returns the app id of the caller of the grpc API, which is checked by the access control of the service invocation.
+// The caller is identified by its client certificate verified by the mutual TLS of the grpc server, e.g. configured by grpc.Creds,
+// so that the apps sharing a runtime are told apart. The identity is the last path segment of the first URI SAN,
+// e.g. "app1" of "spiffe://cluster.local/ns/default/app/app1", or the common name if the certificate has no URI SAN.
+// It is the app id of the runtime if the caller isn't authenticated by a certificate
+func CallerAppId(ctx context.Context, appId string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return appId
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return appId
+	}
+	cert := info.State.VerifiedChains[0][0]
+	for _, uri := range cert.URIs {
+		if id := path.Base(uri.Path); id != "" && id != "/" && id != "." {
+			return id
+		}
+	}
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	return appId
+}
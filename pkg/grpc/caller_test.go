@@ -0,0 +1,50 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCallerAppId(t *testing.T) {
+	withCert := func(cert *x509.Certificate) context.Context {
+		state := tls.ConnectionState{}
+		if cert != nil {
+			state.VerifiedChains = [][]*x509.Certificate{{cert}}
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+	spiffe, err := url.Parse("spiffe://cluster.local/ns/default/app/app1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "runtime", CallerAppId(context.Background(), "runtime"))
+	assert.Equal(t, "runtime", CallerAppId(peer.NewContext(context.Background(), &peer.Peer{}), "runtime"))
+	// the certificate isn't verified
+	assert.Equal(t, "runtime", CallerAppId(withCert(nil), "runtime"))
+	assert.Equal(t, "app1", CallerAppId(withCert(&x509.Certificate{URIs: []*url.URL{spiffe}, Subject: pkix.Name{CommonName: "app2"}}), "runtime"))
+	assert.Equal(t, "app2", CallerAppId(withCert(&x509.Certificate{Subject: pkix.Name{CommonName: "app2"}}), "runtime"))
+	assert.Equal(t, "runtime", CallerAppId(withCert(&x509.Certificate{}), "runtime"))
+}
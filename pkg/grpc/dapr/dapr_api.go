@@ -78,6 +78,11 @@ func (d *daprGrpcAPI) Init(conn *grpc.ClientConn) error {
 	return d.startSubscribing()
 }
 
+// SetTransactionalStateStores implements grpc_api.SetTransactionalStateStores
+func (d *daprGrpcAPI) SetTransactionalStateStores(stores map[string]state.TransactionalStore) {
+	d.transactionalStateStores = stores
+}
+
 func (d *daprGrpcAPI) Register(rawGrpcServer *grpc.Server) error {
 	dapr_v1pb.RegisterDaprServer(rawGrpcServer, d)
 	return nil
@@ -102,6 +107,8 @@ func (d *daprGrpcAPI) InvokeService(ctx context.Context, in *dapr_v1pb.InvokeSer
 		req.Header["verb"] = []string{ext.Verb.String()}
 		req.Header["query_string"] = []string{ext.GetQuerystring()}
 	}
+	// the caller is identified by the runtime, it can't be specified by the application
+	req.Header[rpc.CallerAppId] = []string{grpc_api.CallerAppId(ctx, d.appId)}
 
 	// 2. route to the specific rpc.Invoker component.
 	// Only support mosn component now.
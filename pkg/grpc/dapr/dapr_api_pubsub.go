@@ -183,6 +183,15 @@ func (d *daprGrpcAPI) getInterestedTopics() (map[string]TopicSubscriptions, erro
 	return comp2Topic, nil
 }
 
+// SubscribePubSub implements grpc_api.SubscribePubSub
+func (d *daprGrpcAPI) SubscribePubSub(name string, ps pubsub.PubSub) error {
+	topicRoutes, err := d.getInterestedTopics()
+	if err != nil {
+		return err
+	}
+	return d.beginPubSub(name, ps, topicRoutes)
+}
+
 func (d *daprGrpcAPI) beginPubSub(pubsubName string, ps pubsub.PubSub, topicRoutes map[string]TopicSubscriptions) error {
 	// 1. call app to find topic topic2Details.
 	v, ok := topicRoutes[pubsubName]
@@ -40,6 +40,7 @@ import (
 	"mosn.io/layotto/pkg/grpc/dapr"
 	dapr_common_v1pb "mosn.io/layotto/pkg/grpc/dapr/proto/common/v1"
 	dapr_v1pb "mosn.io/layotto/pkg/grpc/dapr/proto/runtime/v1"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
 	"mosn.io/layotto/spec/proto/runtime/v1"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
@@ -79,10 +80,20 @@ type api struct {
 	stateStores              map[string]state.Store
 	transactionalStateStores map[string]state.TransactionalStore
 	fileOps                  map[string]file.File
-	lockStores               map[string]lock.LockStore
-	sequencers               map[string]sequencer.Store
-	sendToOutputBindingFn    func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	secretStores             map[string]secretstores.SecretStore
+	filePolicies             map[string]*file.Policy
+	fileMultiparts           map[string]*file.MultipartConfig
+	fileUsages               map[string]*fileUsage
+	fileUsagesLock           sync.Mutex
+	scanOnce                 sync.Once
+	closeOnce                sync.Once
+	// stopScans stops the scans of the files started by SetFilePolicies
+	stopScans             chan struct{}
+	lockStores            map[string]lock.LockStore
+	sequencers            map[string]sequencer.Store
+	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	secretStores          map[string]secretstores.SecretStore
+	// guard is held to look up the components outside of the requests tracked by it
+	guard *lifecycle.RequestGuard
 	// app callback
 	AppCallbackConn   *grpc.ClientConn
 	topicPerComponent map[string]TopicSubscriptions
@@ -97,6 +108,24 @@ func (a *api) Init(conn *grpc.ClientConn) error {
 	return a.startSubscribing()
 }
 
+// SetTransactionalStateStores implements grpc_api.SetTransactionalStateStores
+func (a *api) SetTransactionalStateStores(stores map[string]state.TransactionalStore) {
+	a.transactionalStateStores = stores
+	if setter, ok := a.daprAPI.(grpc_api.SetTransactionalStateStores); ok {
+		setter.SetTransactionalStateStores(stores)
+	}
+}
+
+// SetRequestGuard implements lifecycle.SetRequestGuard
+func (a *api) SetRequestGuard(guard *lifecycle.RequestGuard) {
+	a.guard = guard
+}
+
+// RequestGuard returns the guard tracking the requests, the http gateway calls the api through it
+func (a *api) RequestGuard() *lifecycle.RequestGuard {
+	return a.guard
+}
+
 func (a *api) Register(rawGrpcServer *grpc.Server) error {
 	LayottoAPISingleton = a
 	runtimev1pb.RegisterRuntimeServer(rawGrpcServer, a)
@@ -70,7 +70,7 @@ func (a *api) SaveConfiguration(ctx context.Context, req *runtimev1pb.SaveConfig
 			req.Items[index].Group = store.GetDefaultGroup()
 		}
 		if strings.ReplaceAll(item.Label, " ", "") == "" {
-			req.Items[index].Label = store.GetDefaultLabel()
+			req.Items[index].Label = configstores.DefaultWriteLabel(store)
 		}
 		setReq.Items = append(setReq.Items, &configstores.ConfigurationItem{Group: item.Group, Label: item.Label, Key: item.Key, Content: item.Content, Tags: item.Tags, Metadata: item.Metadata})
 	}
@@ -88,7 +88,7 @@ func (a *api) DeleteConfiguration(ctx context.Context, req *runtimev1pb.DeleteCo
 		req.Group = store.GetDefaultGroup()
 	}
 	if strings.ReplaceAll(req.Label, " ", "") == "" {
-		req.Label = store.GetDefaultLabel()
+		req.Label = configstores.DefaultWriteLabel(store)
 	}
 	err := store.Delete(ctx, &configstores.DeleteRequest{AppId: req.AppId, Group: req.Group, Label: req.Label, Keys: req.Keys, Metadata: req.Metadata})
 	return &emptypb.Empty{}, err
@@ -123,7 +123,11 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 				return
 			}
 			// 1.3. else find the component and delegate to it
-			store, ok := a.configStores[req.StoreName]
+			var store configstores.Store
+			var ok bool
+			a.guard.Read(func() {
+				store, ok = a.configStores[req.StoreName]
+			})
 			// 1.3.1. stop if StoreName is not supported
 			if !ok {
 				log.DefaultLogger.Errorf("configure store [%+v] don't support now", req.StoreName)
@@ -77,6 +77,28 @@ func TestSaveConfiguration(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("gray store", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockConfigStore := mock.NewMockStore(ctrl)
+		// the item without a label is saved under the label of the wrapped store, the explicit gray label is kept
+		mockConfigStore.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *configstores.SetRequest) error {
+			assert.Equal(t, "default", req.Items[0].Label)
+			assert.Equal(t, "canary", req.Items[1].Label)
+			return nil
+		})
+		req := &runtimev1pb.SaveConfigurationRequest{
+			StoreName: "mock",
+			Items: []*runtimev1pb.ConfigurationItem{
+				{Key: "a", Content: "a0"},
+				{Key: "b", Content: "b0", Label: "canary"},
+			},
+		}
+		store := configstores.NewGrayStore(mockConfigStore, "canary")
+		api := NewAPI("", nil, map[string]configstores.Store{"mock": store}, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := api.SaveConfiguration(context.Background(), req)
+		assert.Nil(t, err)
+	})
+
 	t.Run("unsupport configstore", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mockConfigStore := mock.NewMockStore(ctrl)
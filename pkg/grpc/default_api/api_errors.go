@@ -24,10 +24,17 @@ import (
 
 var (
 	FileErrMap2GrpcErr = map[error]codes.Code{
-		file.ErrInvalid:    codes.InvalidArgument,
-		file.ErrNotExist:   codes.NotFound,
-		file.ErrExist:      codes.AlreadyExists,
-		file.ErrExpired:    codes.DataLoss,
-		file.ErrPermission: codes.PermissionDenied,
+		file.ErrInvalid:          codes.InvalidArgument,
+		file.ErrNotExist:         codes.NotFound,
+		file.ErrExist:            codes.AlreadyExists,
+		file.ErrExpired:          codes.DataLoss,
+		file.ErrPermission:       codes.PermissionDenied,
+		file.ErrInvalidRange:     codes.OutOfRange,
+		file.ErrOffsetMismatch:   codes.FailedPrecondition,
+		file.ErrChecksumMismatch: codes.DataLoss,
+		file.ErrTooLarge:         codes.InvalidArgument,
+		file.ErrQuotaExceeded:    codes.ResourceExhausted,
+		file.ErrNotAllowed:       codes.PermissionDenied,
+		file.ErrNotSupported:     codes.Unimplemented,
 	}
 )
@@ -19,6 +19,10 @@ package default_api
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -33,16 +37,25 @@ import (
 )
 
 func (a *api) GetFile(req *runtimev1pb.GetFileRequest, stream runtimev1pb.Runtime_GetFileServer) error {
-	if a.fileOps[req.StoreName] == nil {
+	// the stream is not tracked by the request guard, the store is looked up under it
+	var store file.File
+	a.guard.Read(func() {
+		store = a.fileOps[req.StoreName]
+	})
+	if store == nil {
 		return status.Errorf(codes.InvalidArgument, "not supported store type: %+v", req.StoreName)
 	}
 	if req.Metadata == nil {
 		req.Metadata = make(map[string]string)
 	}
-	st := &file.GetFileStu{FileName: req.Name, Metadata: req.Metadata}
-	data, err := a.fileOps[req.StoreName].Get(stream.Context(), st)
+	st := &file.GetFileStu{FileName: req.Name, Metadata: req.Metadata, Offset: req.Offset, Length: req.Length}
+	data, err := file.GetRange(stream.Context(), store, st)
 	if err != nil {
-		return status.Errorf(codes.Internal, "get file fail,err: %+v", err)
+		errCode := codes.Internal
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return status.Errorf(errCode, "get file fail,err: %+v", err)
 	}
 
 	buffsPtr := bytesPool.Get().(*[]byte)
@@ -116,21 +129,281 @@ func (a *api) PutFile(stream runtimev1pb.Runtime_PutFileServer) error {
 		return status.Errorf(codes.Internal, "receive file data fail: err: %+v", err)
 	}
 
-	if a.fileOps[req.StoreName] == nil {
+	// the stream is not tracked by the request guard, the store and its configs are looked up under it
+	var (
+		store     file.File
+		policy    *file.Policy
+		uploader  file.MultipartUploader
+		multipart *file.MultipartConfig
+	)
+	a.guard.Read(func() {
+		store = a.fileOps[req.StoreName]
+		policy = a.filePolicy(req.StoreName)
+		uploader, multipart = a.multipartUploader(req.StoreName, store)
+	})
+	if store == nil {
 		return status.Errorf(codes.InvalidArgument, "not support store type: %+v", req.StoreName)
 	}
-	fileReader := newPutObjectStreamReader(req.Data, stream)
+	if req.Offset < 0 || (req.Offset > 0 && !req.Resumable) {
+		return status.Errorf(codes.InvalidArgument, "invalid offset %d, only the resumable uploads start from a non-zero offset", req.Offset)
+	}
+	var fileReader io.Reader = newPutObjectStreamReader(req.Data, stream)
 	if req.Metadata == nil {
 		req.Metadata = make(map[string]string)
 	}
-	st := &file.PutFileStu{DataStream: fileReader, FileName: req.Name, Metadata: req.Metadata}
-	if err = a.fileOps[req.StoreName].Put(stream.Context(), st); err != nil {
-		return status.Errorf(codes.Internal, err.Error())
+	var limiter *policyReader
+	if policy != nil {
+		if limiter, err = a.enforceFilePolicy(stream.Context(), req, store, policy, fileReader); err != nil {
+			return err
+		}
+		fileReader = limiter
+	}
+	checksum := file.Checksum{MD5: req.ContentMd5, CRC64: req.ContentCrc64}
+	// the data before the offset of a resumed upload is not received by this call,
+	// its file is verified after being stored instead
+	var verifier *file.VerifyingReader
+	if !checksum.IsEmpty() && req.Offset == 0 {
+		verifier = file.NewVerifyingReader(fileReader, checksum)
+		fileReader = verifier
+	}
+	st := &file.PutFileStu{DataStream: fileReader, FileName: req.Name, Metadata: req.Metadata, Offset: req.Offset}
+	// the stores verifying the resumed uploads before committing them keep the previous file on a mismatch
+	verified := false
+	if req.Resumable {
+		uploader, ok := store.(file.ResumableUploader)
+		if !ok {
+			return status.Errorf(codes.Unimplemented, "store %s doesn't support resumable uploads", req.StoreName)
+		}
+		if v, ok := store.(file.ChecksumVerifier); ok && v.VerifiesChecksum() && req.Offset > 0 {
+			st.Checksum = checksum
+			verified = true
+		}
+		err = uploader.ResumePut(stream.Context(), st)
+	} else if uploader != nil {
+		err = putMultipart(stream.Context(), store, uploader, st, multipart)
+	} else {
+		err = store.Put(stream.Context(), st)
+	}
+	if err != nil {
+		// the components may wrap the error of the data stream
+		if verifier != nil && verifier.Err() != nil {
+			err = verifier.Err()
+		}
+		if limiter != nil {
+			limiter.rollback()
+			if limiter.Err() != nil {
+				err = limiter.Err()
+			}
+		}
+		errCode := codes.Internal
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return status.Errorf(errCode, err.Error())
+	}
+	if !checksum.IsEmpty() && req.Offset > 0 && !verified {
+		if err = verifyFile(stream.Context(), store, req.Name, req.Metadata, checksum); err != nil {
+			if limiter != nil {
+				limiter.rollback()
+			}
+			return err
+		}
+	}
+	if limiter != nil {
+		limiter.commit()
 	}
 	stream.SendAndClose(&empty.Empty{})
 	return nil
 }
 
+// verifyFile reads the stored file back to verify its checksum, the file is deleted if it doesn't match
+func verifyFile(ctx context.Context, store file.File, name string, metadata map[string]string, checksum file.Checksum) error {
+	data, err := store.Get(ctx, &file.GetFileStu{FileName: name, Metadata: metadata})
+	if err != nil {
+		return status.Errorf(codes.Internal, "verify file fail,err: %+v", err)
+	}
+	err = checksum.Verify(data)
+	data.Close()
+	if err == file.ErrChecksumMismatch {
+		if delErr := store.Del(ctx, &file.DelRequest{FileName: name, Metadata: metadata}); delErr != nil {
+			log.DefaultLogger.Errorf("delete corrupted file %s fail, err: %+v", name, delErr)
+		}
+		return status.Errorf(codes.DataLoss, err.Error())
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "verify file fail,err: %+v", err)
+	}
+	return nil
+}
+
+// GetFileUploadOffset returns the size of the data persisted by the unfinished resumable upload of a file
+func (a *api) GetFileUploadOffset(ctx context.Context, in *runtimev1pb.GetFileUploadOffsetRequest) (*runtimev1pb.GetFileUploadOffsetResponse, error) {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	if a.fileOps[in.Request.StoreName] == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	uploader, ok := a.fileOps[in.Request.StoreName].(file.ResumableUploader)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't support resumable uploads", in.Request.StoreName)
+	}
+	offset, err := uploader.UploadOffset(ctx, &file.FileMetaRequest{FileName: in.Request.Name, Metadata: in.Request.Metadata})
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return nil, status.Errorf(errCode, err.Error())
+	}
+	return &runtimev1pb.GetFileUploadOffsetResponse{Offset: offset}, nil
+}
+
+// SignFileURL presigns the url of a file, with which the clients get or put the file directly
+func (a *api) SignFileURL(ctx context.Context, in *runtimev1pb.SignFileURLRequest) (*runtimev1pb.SignFileURLResponse, error) {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	method := strings.ToUpper(in.Method)
+	if method != http.MethodGet && method != http.MethodPut {
+		return nil, status.Errorf(codes.InvalidArgument, "method must be GET or PUT")
+	}
+	if in.ExpiresInSec <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_in_sec must be > 0")
+	}
+	if a.fileOps[in.Request.StoreName] == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	signer, ok := a.fileOps[in.Request.StoreName].(file.Signer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't support signing urls", in.Request.StoreName)
+	}
+	// the urls not served by the sidecar bypass the policy of the store
+	if _, served := signer.(file.URLVerifier); method == http.MethodPut && !served && a.filePolicy(in.Request.StoreName) != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "store %s has a policy, its files can't be put by presigned urls", in.Request.StoreName)
+	}
+	st := &file.SignURLStu{
+		FileName: in.Request.Name,
+		Method:   method,
+		Expires:  time.Duration(in.ExpiresInSec) * time.Second,
+		Metadata: in.Request.Metadata,
+	}
+	u, err := signer.SignURL(ctx, st)
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return nil, status.Errorf(errCode, err.Error())
+	}
+	return &runtimev1pb.SignFileURLResponse{Url: u}, nil
+}
+
+// VerifySignedFileURL checks the query of the url signed by a store whose urls are served by the sidecar,
+// and returns the file request allowed by the url if it is used with the method
+// It is called by the http gateway without being tracked, the store is looked up under the request guard.
+func (a *api) VerifySignedFileURL(ctx context.Context, storeName string, method string, query url.Values) (*runtimev1pb.FileRequest, error) {
+	var store file.File
+	a.guard.Read(func() {
+		store = a.fileOps[storeName]
+	})
+	if store == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", storeName)
+	}
+	verifier, ok := store.(file.URLVerifier)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't serve signed urls", storeName)
+	}
+	st, err := verifier.VerifyURL(ctx, query)
+	if err == file.ErrExpired {
+		return nil, status.Errorf(codes.PermissionDenied, "signed url expired")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "verify signed url fail,err: %+v", err)
+	}
+	if st.Method != strings.ToUpper(method) {
+		return nil, status.Errorf(codes.PermissionDenied, "signed url doesn't allow method %s", method)
+	}
+	return &runtimev1pb.FileRequest{StoreName: storeName, Name: st.FileName, Metadata: st.Metadata}, nil
+}
+
+// FileStore returns the file store for the file migrations of the actuator, which run in the background.
+// The returned file looks the component up for each call and runs the call as an in-flight request,
+// so the migrations use the component replacing the store at runtime rather than a closed one.
+func (a *api) FileStore(name string) (file.File, bool) {
+	var ok bool
+	a.guard.Read(func() {
+		_, ok = a.fileOps[name]
+	})
+	if !ok {
+		return nil, false
+	}
+	return &trackedFile{api: a, name: name}, true
+}
+
+// trackedFile calls the current component of a file store under the request guard
+type trackedFile struct {
+	api  *api
+	name string
+}
+
+func (f *trackedFile) call(fn func(store file.File) error) error {
+	err := status.Errorf(codes.Unavailable, "file store %s is removed", f.name)
+	f.api.guard.Track(func() {
+		if store, ok := f.api.fileOps[f.name]; ok {
+			err = fn(store)
+		}
+	})
+	return err
+}
+
+// Init does nothing, the components are initialized by the runtime
+func (f *trackedFile) Init(context.Context, *file.FileConfig) error {
+	return nil
+}
+
+func (f *trackedFile) Put(ctx context.Context, st *file.PutFileStu) error {
+	return f.call(func(store file.File) error {
+		return store.Put(ctx, st)
+	})
+}
+
+func (f *trackedFile) Get(ctx context.Context, st *file.GetFileStu) (data io.ReadCloser, err error) {
+	err = f.call(func(store file.File) error {
+		data, err = store.Get(ctx, st)
+		return err
+	})
+	return data, err
+}
+
+func (f *trackedFile) List(ctx context.Context, st *file.ListRequest) (resp *file.ListResp, err error) {
+	err = f.call(func(store file.File) error {
+		resp, err = store.List(ctx, st)
+		return err
+	})
+	return resp, err
+}
+
+func (f *trackedFile) Del(ctx context.Context, st *file.DelRequest) error {
+	return f.call(func(store file.File) error {
+		return store.Del(ctx, st)
+	})
+}
+
+func (f *trackedFile) Stat(ctx context.Context, st *file.FileMetaRequest) (resp *file.FileMetaResp, err error) {
+	err = f.call(func(store file.File) error {
+		resp, err = store.Stat(ctx, st)
+		return err
+	})
+	return resp, err
+}
+
 // ListFile list all files
 func (a *api) ListFile(ctx context.Context, in *runtimev1pb.ListFileRequest) (*runtimev1pb.ListFileResp, error) {
 	if in.Request == nil {
@@ -171,7 +444,115 @@ func (a *api) DelFile(ctx context.Context, in *runtimev1pb.DelFileRequest) (*emp
 	if a.fileOps[in.Request.StoreName] == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
 	}
-	err := a.fileOps[in.Request.StoreName].Del(ctx, &file.DelRequest{FileName: in.Request.Name, Metadata: in.Request.Metadata})
+	store := a.fileOps[in.Request.StoreName]
+	// the size of the deleted file is released from the counted usage of the quota
+	var size int64
+	usage := a.loadedFileUsage(in.Request.StoreName)
+	if usage != nil {
+		if meta, err := store.Stat(ctx, &file.FileMetaRequest{FileName: in.Request.Name, Metadata: in.Request.Metadata}); err == nil {
+			size = meta.Size
+		}
+	}
+	err := store.Del(ctx, &file.DelRequest{FileName: in.Request.Name, Metadata: in.Request.Metadata})
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return nil, status.Errorf(errCode, err.Error())
+	}
+	if usage != nil {
+		usage.release(size)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Mkdir creates a directory in the stores supporting directories
+func (a *api) Mkdir(ctx context.Context, in *runtimev1pb.MkdirRequest) (*emptypb.Empty, error) {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	if a.fileOps[in.Request.StoreName] == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	maker, ok := a.fileOps[in.Request.StoreName].(file.DirMaker)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't support directories", in.Request.StoreName)
+	}
+	err := maker.Mkdir(ctx, &file.MkdirRequest{DirectoryName: in.Request.Name, Metadata: in.Request.Metadata})
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return nil, status.Errorf(errCode, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RenameFile renames or moves a file in the stores supporting renames
+func (a *api) RenameFile(ctx context.Context, in *runtimev1pb.RenameFileRequest) (*emptypb.Empty, error) {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Target == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "target can't be empty")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	store := a.fileOps[in.Request.StoreName]
+	if store == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	renamer, ok := store.(file.Renamer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't support renaming files", in.Request.StoreName)
+	}
+	done, err := a.enforceTargetPolicy(ctx, in.Request, store, in.Target, false)
+	if err != nil {
+		return nil, err
+	}
+	err = renamer.Rename(ctx, &file.RenameRequest{Source: in.Request.Name, Target: in.Target, Metadata: in.Request.Metadata})
+	done(err)
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return nil, status.Errorf(errCode, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CopyFile copies a file in the stores supporting copies
+func (a *api) CopyFile(ctx context.Context, in *runtimev1pb.CopyFileRequest) (*emptypb.Empty, error) {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Target == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "target can't be empty")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	store := a.fileOps[in.Request.StoreName]
+	if store == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	copier, ok := store.(file.Copier)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store %s doesn't support copying files", in.Request.StoreName)
+	}
+	done, err := a.enforceTargetPolicy(ctx, in.Request, store, in.Target, true)
+	if err != nil {
+		return nil, err
+	}
+	err = copier.Copy(ctx, &file.CopyRequest{Source: in.Request.Name, Target: in.Target, Metadata: in.Request.Metadata})
+	done(err)
 	if err != nil {
 		if code, ok := FileErrMap2GrpcErr[err]; ok {
 			errCode = code
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package default_api
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"mosn.io/layotto/components/file"
+)
+
+const (
+	defaultPartSize        = 8 << 20
+	defaultPartConcurrency = 4
+)
+
+// SetFileMultiparts implements grpc.SetFileMultiparts
+func (a *api) SetFileMultiparts(configs map[string]*file.MultipartConfig) {
+	a.fileMultiparts = configs
+}
+
+// multipartUploader returns the store as a file.MultipartUploader with its config completed by the defaults,
+// or nil if the store doesn't upload the files in parts
+func (a *api) multipartUploader(storeName string, store file.File) (file.MultipartUploader, *file.MultipartConfig) {
+	uploader, ok := store.(file.MultipartUploader)
+	if !ok {
+		return nil, nil
+	}
+	config := &file.MultipartConfig{PartSize: defaultPartSize, Concurrency: defaultPartConcurrency}
+	if c := a.fileMultiparts[storeName]; c != nil {
+		if c.Disabled {
+			return nil, nil
+		}
+		if c.PartSize > 0 {
+			config.PartSize = c.PartSize
+		}
+		if c.Concurrency > 0 {
+			config.Concurrency = c.Concurrency
+		}
+	}
+	return uploader, config
+}
+
+// putMultipart uploads the file in parts if it is larger than a part, the smaller files are put as usual.
+// The head of the data is read into a buffer growing with it, so the small files don't allocate a whole part
+func putMultipart(ctx context.Context, store file.File, uploader file.MultipartUploader, st *file.PutFileStu, config *file.MultipartConfig) error {
+	head := &bytes.Buffer{}
+	_, err := io.CopyN(head, st.DataStream, config.PartSize+1)
+	switch err {
+	case io.EOF:
+		st.DataStream = head
+		return store.Put(ctx, st)
+	case nil:
+		st.DataStream = io.MultiReader(head, st.DataStream)
+		return uploader.PutMultipart(ctx, st, config)
+	default:
+		return err
+	}
+}
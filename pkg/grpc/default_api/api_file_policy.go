@@ -0,0 +1,370 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package default_api
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/file"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+const (
+	defaultExpireInterval = 3600
+	// sniffLen is the length of the data detecting the content type
+	sniffLen = 512
+)
+
+// scanCheckInterval is the interval checking whether the stores are due to scan the files
+var scanCheckInterval = time.Minute
+
+// fileUsage is the total size of the files in the directory of the policy of a store, see file.Policy.Quotas.
+// It is counted by listing the directory, kept by the puts and the deletes of the sidecar,
+// and recounted by the scans of the files
+type fileUsage struct {
+	mu     sync.Mutex
+	loaded bool
+	used   int64
+}
+
+// reserve adds n to the usage if it doesn't exceed the quota
+func (u *fileUsage) reserve(n int64, quota int64) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.used+n > quota {
+		return false
+	}
+	u.used += n
+	return true
+}
+
+// reset sets the usage counted by a scan
+func (u *fileUsage) reset(used int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.used = used
+}
+
+func (u *fileUsage) release(n int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.used -= n
+	if u.used < 0 {
+		u.used = 0
+	}
+}
+
+// SetFilePolicies implements grpc.SetFilePolicies, the files are scanned in the background until the api is closed
+func (a *api) SetFilePolicies(policies map[string]*file.Policy) {
+	a.filePolicies = policies
+	a.scanOnce.Do(func() {
+		a.stopScans = make(chan struct{})
+		go a.scanFiles(scanCheckInterval, a.stopScans)
+	})
+}
+
+// Close implements io.Closer, it stops the scans of the files when the runtime stops
+func (a *api) Close() error {
+	// the scans are not started after the api is closed
+	a.scanOnce.Do(func() {})
+	a.closeOnce.Do(func() {
+		if a.stopScans != nil {
+			close(a.stopScans)
+		}
+	})
+	return nil
+}
+
+// filePolicy returns the policy of the store for the app id of the sidecar
+func (a *api) filePolicy(storeName string) *file.Policy {
+	policy := a.filePolicies[storeName]
+	if policy == nil {
+		return nil
+	}
+	return policy.ForApp(a.appId)
+}
+
+// getFileUsage returns the usage of the store, it is counted by listing the directory of the policy at the first time
+func (a *api) getFileUsage(ctx context.Context, storeName string, store file.File, policy *file.Policy) (*fileUsage, error) {
+	a.fileUsagesLock.Lock()
+	if a.fileUsages == nil {
+		a.fileUsages = make(map[string]*fileUsage)
+	}
+	u, ok := a.fileUsages[storeName]
+	if !ok {
+		u = &fileUsage{}
+		a.fileUsages[storeName] = u
+	}
+	a.fileUsagesLock.Unlock()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.loaded || policy.Directory == "" {
+		u.loaded = true
+		return u, nil
+	}
+	var used int64
+	err := listFiles(ctx, store, policy.Directory, policy.Metadata, func(info *file.FilesInfo) {
+		used += info.Size
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.used += used
+	u.loaded = true
+	return u, nil
+}
+
+// loadedFileUsage returns the usage of the store if it has been counted
+func (a *api) loadedFileUsage(storeName string) *fileUsage {
+	a.fileUsagesLock.Lock()
+	defer a.fileUsagesLock.Unlock()
+	return a.fileUsages[storeName]
+}
+
+// policyReader enforces the max object size and the quota while the data of a put is read
+type policyReader struct {
+	r       io.Reader
+	maxSize int64
+	// size is the size of the file read so far, including the data before the offset of a resumed upload
+	size  int64
+	usage *fileUsage
+	quota int64
+	// reserved is the size added to the usage by the put
+	reserved int64
+	// replaced is the size of the file overwritten by the put
+	replaced int64
+	err      error
+}
+
+func (p *policyReader) Read(b []byte) (int, error) {
+	if p.err != nil {
+		return 0, p.err
+	}
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.size += int64(n)
+		if p.maxSize > 0 && p.size > p.maxSize {
+			p.err = file.ErrTooLarge
+			return 0, p.err
+		}
+		if p.usage != nil {
+			if !p.usage.reserve(int64(n), p.quota) {
+				p.err = file.ErrQuotaExceeded
+				return 0, p.err
+			}
+			p.reserved += int64(n)
+		}
+	}
+	return n, err
+}
+
+// Err returns the error of the policy stopping the put
+func (p *policyReader) Err() error {
+	return p.err
+}
+
+// commit releases the size of the overwritten file once the put succeeds
+func (p *policyReader) commit() {
+	if p.usage != nil {
+		p.usage.release(p.replaced)
+	}
+}
+
+// rollback releases the size reserved by the failed put
+func (p *policyReader) rollback() {
+	if p.usage != nil {
+		p.usage.release(p.reserved)
+		p.reserved = 0
+	}
+}
+
+// enforceFilePolicy checks the name and the content type of the put,
+// and returns the reader limiting the data by the max object size and the quota
+func (a *api) enforceFilePolicy(ctx context.Context, req *runtimev1pb.PutFileRequest, store file.File, policy *file.Policy, data io.Reader) (*policyReader, error) {
+	if !policy.AllowName(req.Name) {
+		return nil, status.Errorf(codes.PermissionDenied, "put file[%s] fail,err: %+v", req.Name, file.ErrNotAllowed)
+	}
+	// the content of a resumed upload is checked by its first part
+	if policy.ChecksContent() && req.Offset == 0 {
+		buffered := bufio.NewReaderSize(data, sniffLen)
+		head, err := buffered.Peek(sniffLen)
+		if err != nil && err != io.EOF {
+			return nil, status.Errorf(codes.Internal, "receive file data fail: err: %+v", err)
+		}
+		if contentType := http.DetectContentType(head); !policy.AllowContentType(contentType) {
+			return nil, status.Errorf(codes.PermissionDenied, "put file[%s] fail,err: %+v: %s", req.Name, file.ErrNotAllowed, contentType)
+		}
+		data = buffered
+	}
+	limiter := &policyReader{r: data, maxSize: policy.MaxObjectSize, size: req.Offset}
+	if policy.MaxObjectSize > 0 && req.Offset > policy.MaxObjectSize {
+		return nil, status.Errorf(codes.InvalidArgument, "put file[%s] fail,err: %+v", req.Name, file.ErrTooLarge)
+	}
+	quota := policy.Quota(a.appId)
+	if quota <= 0 {
+		return limiter, nil
+	}
+	usage, err := a.getFileUsage(ctx, req.StoreName, store, policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "count the usage of store %s fail,err: %+v", req.StoreName, err)
+	}
+	// the data before the offset of a resumed upload is counted too
+	if !usage.reserve(req.Offset, quota) {
+		return nil, status.Errorf(codes.ResourceExhausted, "put file[%s] fail,err: %+v", req.Name, file.ErrQuotaExceeded)
+	}
+	limiter.usage, limiter.quota, limiter.reserved = usage, quota, req.Offset
+	if meta, err := store.Stat(ctx, &file.FileMetaRequest{FileName: req.Name, Metadata: req.Metadata}); err == nil {
+		limiter.replaced = meta.Size
+	}
+	return limiter, nil
+}
+
+// enforceTargetPolicy checks the name of the target of a rename or a copy, and keeps the usage of the quota.
+// The size added by a copy, less the size of the overwritten target, is reserved from the quota,
+// and the size of the target overwritten by a rename is released. The returned function is called with the result
+// of the rename or the copy, the usage is restored if it fails
+func (a *api) enforceTargetPolicy(ctx context.Context, req *runtimev1pb.FileRequest, store file.File, target string, copying bool) (func(err error), error) {
+	done := func(error) {}
+	policy := a.filePolicy(req.StoreName)
+	if policy == nil {
+		return done, nil
+	}
+	if !policy.AllowName(target) {
+		return nil, status.Errorf(codes.PermissionDenied, "rename or copy to file[%s] fail,err: %+v", target, file.ErrNotAllowed)
+	}
+	quota := policy.Quota(a.appId)
+	if quota <= 0 {
+		return done, nil
+	}
+	usage, err := a.getFileUsage(ctx, req.StoreName, store, policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "count the usage of store %s fail,err: %+v", req.StoreName, err)
+	}
+	var size int64
+	if copying {
+		meta, err := store.Stat(ctx, &file.FileMetaRequest{FileName: req.Name, Metadata: req.Metadata})
+		if err != nil {
+			errCode := codes.Internal
+			if code, ok := FileErrMap2GrpcErr[err]; ok {
+				errCode = code
+			}
+			return nil, status.Errorf(errCode, "stat file[%s] fail,err: %+v", req.Name, err)
+		}
+		size = meta.Size
+	}
+	// the target is not overwritten if it doesn't exist
+	if meta, err := store.Stat(ctx, &file.FileMetaRequest{FileName: target, Metadata: req.Metadata}); err == nil {
+		size -= meta.Size
+	}
+	if !usage.reserve(size, quota) {
+		return nil, status.Errorf(codes.ResourceExhausted, "copy file[%s] fail,err: %+v", req.Name, file.ErrQuotaExceeded)
+	}
+	return func(err error) {
+		if err != nil {
+			usage.release(size)
+		}
+	}, nil
+}
+
+// scanFiles deletes the expired files and recounts the usages of the quotas of the stores whose scans are due.
+// The policies are copied under the request guard since the runtime swaps them, and the stores are called
+// through trackedFile, so that a scan uses the component replacing the store rather than a closed one
+func (a *api) scanFiles(checkInterval time.Duration, stop <-chan struct{}) {
+	lastScans := make(map[string]time.Time)
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		var now time.Time
+		select {
+		case <-stop:
+			return
+		case now = <-ticker.C:
+		}
+		policies := make(map[string]*file.Policy)
+		a.guard.Read(func() {
+			for name := range a.filePolicies {
+				if _, ok := a.fileOps[name]; !ok {
+					continue
+				}
+				if policy := a.filePolicy(name); policy.ExpireDays > 0 || policy.Quota(a.appId) > 0 {
+					policies[name] = policy
+				}
+			}
+		})
+		for name, policy := range policies {
+			store := &trackedFile{api: a, name: name}
+			interval := time.Duration(policy.ExpireInterval) * time.Second
+			if interval <= 0 {
+				interval = defaultExpireInterval * time.Second
+			}
+			if now.Sub(lastScans[name]) < interval {
+				continue
+			}
+			lastScans[name] = now
+			deleted, err := a.scanStore(context.Background(), name, store, policy, now)
+			if err != nil {
+				log.DefaultLogger.Errorf("[runtime] scan the files of store %s fail, err: %+v", name, err)
+			}
+			if deleted > 0 {
+				log.DefaultLogger.Infof("[runtime] %d expired files of store %s are deleted", deleted, name)
+			}
+		}
+	}
+}
+
+// scanStore lists the files, deletes the expired ones and recounts the usage of the store if it has been counted.
+// The failed deletes are retried by the next scan. The puts in progress during the listing may be missed by the count,
+// they are counted by the next scan
+func (a *api) scanStore(ctx context.Context, storeName string, store file.File, policy *file.Policy, now time.Time) (int, error) {
+	var expired []*file.FilesInfo
+	var used int64
+	// the files are deleted after the listing, so that the markers are not affected
+	err := listFiles(ctx, store, policy.Directory, policy.Metadata, func(info *file.FilesInfo) {
+		if policy.Expired(info.LastModified, now) {
+			expired = append(expired, info)
+			return
+		}
+		used += info.Size
+	})
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, info := range expired {
+		name := policy.NamePrefix + info.FileName
+		if err = store.Del(ctx, &file.DelRequest{FileName: name, Metadata: policy.Metadata}); err != nil {
+			log.DefaultLogger.Warnf("[runtime] delete the expired file %s of store %s fail, err: %+v", name, storeName, err)
+			used += info.Size
+			continue
+		}
+		deleted++
+	}
+	if usage := a.loadedFileUsage(storeName); usage != nil {
+		usage.reset(used)
+	}
+	return deleted, nil
+}
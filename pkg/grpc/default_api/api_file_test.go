@@ -17,20 +17,31 @@
 package default_api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/local"
 	"mosn.io/layotto/pkg/mock"
 	"mosn.io/layotto/pkg/mock/runtime"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
 
@@ -151,3 +162,786 @@ func TestGetFileMeta(t *testing.T) {
 	assert.Equal(t, resp.LastModified, "123")
 	assert.Equal(t, int(resp.Size), 10)
 }
+
+func TestGetFileRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockFile := mock.NewMockFile(ctrl)
+	mockStream := runtime.NewMockRuntime_GetFileServer(ctrl)
+	api := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": mockFile}, nil, nil, nil, nil)
+	metadata := make(map[string]string)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+	// the store doesn't read ranges natively, the data before the offset is skipped
+	mockFile.EXPECT().Get(context.Background(), &file.GetFileStu{FileName: "a.txt", Metadata: metadata, Offset: 4, Length: 3}).
+		Return(io.NopCloser(strings.NewReader("testFile")), nil).Times(1)
+	mockStream.EXPECT().Send(&runtimev1pb.GetFileResponse{Data: []byte("Fil")}).Times(1)
+	err := api.GetFile(&runtimev1pb.GetFileRequest{StoreName: "mock", Name: "a.txt", Offset: 4, Length: 3}, mockStream)
+	assert.Nil(t, err)
+
+	mockFile.EXPECT().Get(context.Background(), &file.GetFileStu{FileName: "a.txt", Metadata: metadata, Offset: 10}).
+		Return(io.NopCloser(strings.NewReader("testFile")), nil).Times(1)
+	err = api.GetFile(&runtimev1pb.GetFileRequest{StoreName: "mock", Name: "a.txt", Offset: 10}, mockStream)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+// fakePutFileStream sends the requests to PutFile and then fails with err, or io.EOF if err is nil
+type fakePutFileStream struct {
+	grpc.ServerStream
+	reqs []*runtimev1pb.PutFileRequest
+	err  error
+}
+
+func (s *fakePutFileStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakePutFileStream) Recv() (*runtimev1pb.PutFileRequest, error) {
+	if len(s.reqs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *fakePutFileStream) SendAndClose(*emptypb.Empty) error {
+	return nil
+}
+
+// resumableStore keeps the files and the unfinished uploads in memory
+type resumableStore struct {
+	file.File
+	files   map[string][]byte
+	uploads map[string][]byte
+}
+
+func newResumableStore() *resumableStore {
+	return &resumableStore{files: map[string][]byte{}, uploads: map[string][]byte{}}
+}
+
+func (s *resumableStore) Put(ctx context.Context, st *file.PutFileStu) error {
+	data, err := io.ReadAll(st.DataStream)
+	if err != nil {
+		return err
+	}
+	s.files[st.FileName] = data
+	return nil
+}
+
+func (s *resumableStore) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, error) {
+	data, ok := s.files[st.FileName]
+	if !ok {
+		return nil, file.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *resumableStore) Del(ctx context.Context, st *file.DelRequest) error {
+	delete(s.files, st.FileName)
+	return nil
+}
+
+func (s *resumableStore) UploadOffset(ctx context.Context, st *file.FileMetaRequest) (int64, error) {
+	return int64(len(s.uploads[st.FileName])), nil
+}
+
+func (s *resumableStore) ResumePut(ctx context.Context, st *file.PutFileStu) error {
+	if int64(len(s.uploads[st.FileName])) != st.Offset {
+		return file.ErrOffsetMismatch
+	}
+	data, err := io.ReadAll(st.DataStream)
+	s.uploads[st.FileName] = append(s.uploads[st.FileName][:st.Offset], data...)
+	if err != nil {
+		return err
+	}
+	s.files[st.FileName] = s.uploads[st.FileName]
+	delete(s.uploads, st.FileName)
+	return nil
+}
+
+func TestPutFileChecksum(t *testing.T) {
+	store := newResumableStore()
+	api := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	// md5 of "hello"
+	md5 := "5d41402abc4b2a76b9719d911017c592"
+
+	err := api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("hel"), ContentMd5: md5},
+		{Data: []byte("lo")},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), store.files["a.txt"])
+
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "b.txt", Data: []byte("hellO"), ContentMd5: md5},
+	}})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	assert.Nil(t, store.files["b.txt"])
+
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "b.txt", Data: []byte("lo"), Offset: 3},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPutFileResumable(t *testing.T) {
+	store := newResumableStore()
+	api := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": store, "plain": mock.NewMockFile(gomock.NewController(t))}, nil, nil, nil, nil)
+	md5 := "5d41402abc4b2a76b9719d911017c592"
+	offsetRequest := &runtimev1pb.GetFileUploadOffsetRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "a.txt"}}
+
+	// the stream breaks after "hel"
+	err := api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("hel"), Resumable: true, ContentMd5: md5},
+	}, err: errors.New("broken")})
+	assert.NotNil(t, err)
+	resp, err := api.GetFileUploadOffset(context.Background(), offsetRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), resp.Offset)
+
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("llo"), Resumable: true, Offset: 2},
+	}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("lo"), Resumable: true, Offset: 3, ContentMd5: md5},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), store.files["a.txt"])
+
+	// the resumed file is verified after being stored, and deleted if it doesn't match
+	store.uploads["b.txt"] = []byte("hel")
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "b.txt", Data: []byte("lO"), Resumable: true, Offset: 3, ContentMd5: md5},
+	}})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	_, ok := store.files["b.txt"]
+	assert.False(t, ok)
+
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "plain", Name: "a.txt", Data: []byte("hello"), Resumable: true},
+	}})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	offsetRequest.Request.StoreName = "plain"
+	_, err = api.GetFileUploadOffset(context.Background(), offsetRequest)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// verifyingStore verifies the resumed uploads before committing them
+type verifyingStore struct {
+	*resumableStore
+}
+
+func (s *verifyingStore) VerifiesChecksum() bool {
+	return true
+}
+
+func (s *verifyingStore) ResumePut(ctx context.Context, st *file.PutFileStu) error {
+	if int64(len(s.uploads[st.FileName])) != st.Offset {
+		return file.ErrOffsetMismatch
+	}
+	data, err := io.ReadAll(st.DataStream)
+	s.uploads[st.FileName] = append(s.uploads[st.FileName][:st.Offset], data...)
+	if err != nil {
+		return err
+	}
+	if err = st.Checksum.Verify(bytes.NewReader(s.uploads[st.FileName])); err != nil {
+		delete(s.uploads, st.FileName)
+		return err
+	}
+	s.files[st.FileName] = s.uploads[st.FileName]
+	delete(s.uploads, st.FileName)
+	return nil
+}
+
+func TestPutFileResumableVerifiedBeforeCommit(t *testing.T) {
+	store := &verifyingStore{newResumableStore()}
+	api := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	md5 := "5d41402abc4b2a76b9719d911017c592"
+
+	// the previous file is kept if the resumed upload doesn't match
+	store.files["a.txt"] = []byte("previous")
+	store.uploads["a.txt"] = []byte("hel")
+	err := api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("lO"), Resumable: true, Offset: 3, ContentMd5: md5},
+	}})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	assert.Equal(t, []byte("previous"), store.files["a.txt"])
+
+	store.uploads["a.txt"] = []byte("hel")
+	err = api.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "a.txt", Data: []byte("lo"), Resumable: true, Offset: 3, ContentMd5: md5},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), store.files["a.txt"])
+}
+
+func TestSignFileURL(t *testing.T) {
+	store := local.NewLocalStore()
+	err := store.Init(context.Background(), &file.FileConfig{Metadata: []byte(`{"signKey":"key","signURL":"http://127.0.0.1:34999/gateway/signed_file/local"}`)})
+	assert.Nil(t, err)
+	api := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"local": store, "plain": mock.NewMockFile(gomock.NewController(t))}, nil, nil, nil, nil)
+	req := &runtimev1pb.SignFileURLRequest{Request: &runtimev1pb.FileRequest{StoreName: "local", Name: "a.txt"}, Method: "get", ExpiresInSec: 60}
+
+	resp, err := api.SignFileURL(context.Background(), req)
+	assert.Nil(t, err)
+	u, err := url.Parse(resp.Url)
+	assert.Nil(t, err)
+	assert.Equal(t, "/gateway/signed_file/local", u.Path)
+
+	verifier := api.(interface {
+		VerifySignedFileURL(ctx context.Context, storeName string, method string, query url.Values) (*runtimev1pb.FileRequest, error)
+	})
+	fileReq, err := verifier.VerifySignedFileURL(context.Background(), "local", "GET", u.Query())
+	assert.Nil(t, err)
+	assert.Equal(t, "a.txt", fileReq.Name)
+	_, err = verifier.VerifySignedFileURL(context.Background(), "local", "PUT", u.Query())
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	query := u.Query()
+	query.Set("name", "b.txt")
+	_, err = verifier.VerifySignedFileURL(context.Background(), "local", "GET", query)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = verifier.VerifySignedFileURL(context.Background(), "plain", "GET", u.Query())
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	req.Method = "DELETE"
+	_, err = api.SignFileURL(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	req.Method = "PUT"
+	req.ExpiresInSec = 0
+	_, err = api.SignFileURL(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	req.ExpiresInSec = 60
+	req.Request.StoreName = "plain"
+	_, err = api.SignFileURL(context.Background(), req)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// listableStore lists the files of resumableStore with their modified time
+type listableStore struct {
+	*resumableStore
+	modified map[string]string
+}
+
+func (s *listableStore) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.FileMetaResp, error) {
+	data, ok := s.files[st.FileName]
+	if !ok {
+		return nil, file.ErrNotExist
+	}
+	return &file.FileMetaResp{Size: int64(len(data))}, nil
+}
+
+// List lists the files in the directory by the names relative to it, "/" is the root
+func (s *listableStore) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	resp := &file.ListResp{}
+	prefix := strings.Trim(st.DirectoryName, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	for name, data := range s.files {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		name = strings.TrimPrefix(name, prefix)
+		resp.Files = append(resp.Files, &file.FilesInfo{FileName: name, Size: int64(len(data)), LastModified: s.modified[name]})
+	}
+	return resp, nil
+}
+
+func TestPutFilePolicy(t *testing.T) {
+	store := &listableStore{resumableStore: newResumableStore()}
+	store.files["old.txt"] = []byte("12345")
+	a := NewAPI("app", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	a.(*api).SetFilePolicies(map[string]*file.Policy{"mock": {
+		MaxObjectSize:       8,
+		Quotas:              map[string]int64{"app": 12},
+		AllowedExtensions:   []string{".txt"},
+		AllowedContentTypes: []string{"text/*"},
+		Directory:           "/",
+	}})
+	put := func(name string, data ...string) error {
+		var reqs []*runtimev1pb.PutFileRequest
+		for _, d := range data {
+			reqs = append(reqs, &runtimev1pb.PutFileRequest{StoreName: "mock", Name: name, Data: []byte(d)})
+		}
+		return a.PutFile(&fakePutFileStream{reqs: reqs})
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(put("a.png", "hello")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(put("a.txt", "\x89PNG\r\n\x1a\n")))
+	assert.Equal(t, codes.InvalidArgument, status.Code(put("a.txt", "hello", "world")))
+	_, ok := store.files["a.txt"]
+	assert.False(t, ok)
+
+	// 5 bytes of old.txt are counted when the usage is loaded
+	assert.Nil(t, put("a.txt", "hello"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put("b.txt", "hel", "lo")))
+	// the size of the overwritten file is released
+	assert.Nil(t, put("a.txt", "hi"))
+	assert.Nil(t, put("b.txt", "hello"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put("c.txt", "x")))
+
+	_, err := a.DelFile(context.Background(), &runtimev1pb.DelFileRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "old.txt"}})
+	assert.Nil(t, err)
+	assert.Nil(t, put("c.txt", "hello"))
+
+	// the puts bypassing the sidecar are not allowed
+	a.(*api).fileOps["mock"] = &signingStore{store}
+	req := &runtimev1pb.SignFileURLRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "a.txt"}, Method: "PUT", ExpiresInSec: 60}
+	_, err = a.SignFileURL(context.Background(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	req.Method = "GET"
+	_, err = a.SignFileURL(context.Background(), req)
+	assert.Nil(t, err)
+}
+
+// signingStore signs the urls served by the backend
+type signingStore struct {
+	*listableStore
+}
+
+func (s *signingStore) SignURL(ctx context.Context, st *file.SignURLStu) (string, error) {
+	return "http://backend/" + st.FileName, nil
+}
+
+func TestScanStore(t *testing.T) {
+	now := time.Now()
+	store := &listableStore{resumableStore: newResumableStore(), modified: map[string]string{
+		"a.txt": now.Add(-72 * time.Hour).Format(time.RFC3339),
+		"b.txt": now.Add(-time.Hour).Format(time.RFC3339),
+		"c.txt": "",
+	}}
+	for name := range store.modified {
+		store.files[name] = []byte("hello")
+	}
+	a := NewAPI("app", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil).(*api)
+	policy := &file.Policy{ExpireDays: 2, Quotas: map[string]int64{"*": 100}, Directory: "/"}
+	a.SetFilePolicies(map[string]*file.Policy{"mock": policy})
+	usage, err := a.getFileUsage(context.Background(), "mock", store, policy)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), usage.used)
+
+	// the file written by another sidecar is counted by the scan
+	store.files["d.txt"] = []byte("hello")
+	deleted, err := a.scanStore(context.Background(), "mock", store, policy, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+	_, ok := store.files["a.txt"]
+	assert.False(t, ok)
+	assert.Len(t, store.files, 3)
+	assert.Equal(t, int64(15), usage.used)
+}
+
+func TestFileUsagePerApp(t *testing.T) {
+	store := &listableStore{resumableStore: newResumableStore()}
+	store.files["app1/old.txt"] = []byte("12345")
+	store.files["app2/old.txt"] = []byte("1234567890")
+	policies := map[string]*file.Policy{"mock": {
+		Quotas:     map[string]int64{"*": 12},
+		Directory:  "{app_id}",
+		NamePrefix: "{app_id}/",
+	}}
+	put := func(a API, name string, data string) error {
+		req := &runtimev1pb.PutFileRequest{StoreName: "mock", Name: name, Data: []byte(data)}
+		return a.(*api).PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{req}})
+	}
+
+	// the usage of app1 doesn't count the files of app2
+	a1 := NewAPI("app1", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	a1.(*api).SetFilePolicies(policies)
+	defer a1.(*api).Close()
+	assert.Nil(t, put(a1, "app1/a.txt", "hello"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(put(a1, "app2/a.txt", "hello")))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put(a1, "app1/b.txt", "hello")))
+
+	a2 := NewAPI("app2", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	a2.(*api).SetFilePolicies(policies)
+	defer a2.(*api).Close()
+	assert.Nil(t, put(a2, "app2/a.txt", "x"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put(a2, "app2/b.txt", "hello")))
+}
+
+func TestScanFilesStop(t *testing.T) {
+	store := &listableStore{resumableStore: newResumableStore(), modified: map[string]string{
+		"a.txt": time.Now().Add(-72 * time.Hour).Format(time.RFC3339),
+	}}
+	store.files["a.txt"] = []byte("hello")
+	a := NewAPI("app", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil).(*api)
+	a.SetRequestGuard(lifecycle.NewRequestGuard())
+	a.filePolicies = map[string]*file.Policy{"mock": {ExpireDays: 2, Directory: "/"}}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		a.scanFiles(time.Hour, stop)
+		close(done)
+	}()
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the scans are not stopped")
+	}
+
+	// the scans are not started after the api is closed
+	assert.Nil(t, a.Close())
+	a.SetFilePolicies(a.filePolicies)
+	assert.Nil(t, a.stopScans)
+	assert.Len(t, store.files, 1)
+}
+
+func TestExpireFilesSwap(t *testing.T) {
+	interval := scanCheckInterval
+	scanCheckInterval = 5 * time.Millisecond
+	defer func() { scanCheckInterval = interval }()
+
+	newStore := func() *listableStore {
+		store := &listableStore{resumableStore: newResumableStore(), modified: map[string]string{
+			"a.txt": time.Now().Add(-72 * time.Hour).Format(time.RFC3339),
+		}}
+		store.files["a.txt"] = []byte("hello")
+		return store
+	}
+	stores := map[string]file.File{"mock": newStore()}
+	policies := map[string]*file.Policy{"mock": {ExpireDays: 2, ExpireInterval: 1, Directory: "/"}}
+	a := NewAPI("app", nil, nil, nil, nil, nil, stores, nil, nil, nil, nil).(*api)
+	guard := lifecycle.NewRequestGuard()
+	a.SetRequestGuard(guard)
+	a.SetFilePolicies(policies)
+	defer a.Close()
+
+	// the runtime swaps the store and its policy while the scans run,
+	// the scans call the current store, so the file of the last one is deleted
+	var swapped *listableStore
+	for i := 0; i < 10; i++ {
+		resume, err := guard.Drain(context.Background())
+		assert.Nil(t, err)
+		swapped = newStore()
+		stores["mock"] = swapped
+		policies["mock"] = &file.Policy{ExpireDays: 2, ExpireInterval: 1, Directory: "/"}
+		resume()
+		time.Sleep(time.Millisecond)
+	}
+	assert.Eventually(t, func() bool {
+		resume, err := guard.Drain(context.Background())
+		assert.Nil(t, err)
+		defer resume()
+		return len(swapped.files) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// fakeFileEventsStream collects the events sent by SubscribeFileEvents
+type fakeFileEventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *runtimev1pb.SubscribeFileEventsResponse
+}
+
+func (s *fakeFileEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeFileEventsStream) Send(resp *runtimev1pb.SubscribeFileEventsResponse) error {
+	s.events <- resp
+	return nil
+}
+
+// namesStore lists the names of the files, which are changed by the tests concurrently
+type namesStore struct {
+	file.File
+	sync.Mutex
+	names []string
+	err   error
+}
+
+func (s *namesStore) set(err error, names ...string) {
+	s.Lock()
+	defer s.Unlock()
+	s.names, s.err = names, err
+}
+
+func (s *namesStore) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	resp := &file.ListResp{}
+	for _, name := range s.names {
+		resp.Files = append(resp.Files, &file.FilesInfo{FileName: name})
+	}
+	return resp, nil
+}
+
+func TestSubscribeFileEvents(t *testing.T) {
+	interval := minWatchInterval
+	minWatchInterval = time.Millisecond
+	defer func() { minWatchInterval = interval }()
+
+	polled := &namesStore{names: []string{"a.txt"}}
+	dir := t.TempDir()
+	stores := map[string]file.File{"mock": polled, "local": local.NewLocalStore()}
+	a := NewAPI("", nil, nil, nil, nil, nil, stores, nil, nil, nil, nil)
+	guard := lifecycle.NewRequestGuard()
+	a.(*api).SetRequestGuard(guard)
+	subscribe := func(storeName, name string) (*fakeFileEventsStream, context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &fakeFileEventsStream{ctx: ctx, events: make(chan *runtimev1pb.SubscribeFileEventsResponse, 10)}
+		done := make(chan error, 1)
+		go func() {
+			done <- a.SubscribeFileEvents(&runtimev1pb.SubscribeFileEventsRequest{Request: &runtimev1pb.FileRequest{StoreName: storeName, Name: name}, IntervalMs: 10}, stream)
+		}()
+		return stream, cancel, done
+	}
+	next := func(stream *fakeFileEventsStream) *runtimev1pb.SubscribeFileEventsResponse {
+		select {
+		case event := <-stream.events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event")
+			return nil
+		}
+	}
+
+	stream, cancel, done := subscribe("mock", "dir")
+	// wait for the first listing
+	time.Sleep(50 * time.Millisecond)
+	polled.set(errors.New("unavailable"))
+	time.Sleep(50 * time.Millisecond)
+	polled.set(nil, "b.txt")
+	assert.Equal(t, &runtimev1pb.SubscribeFileEventsResponse{Type: runtimev1pb.SubscribeFileEventsResponse_CREATE, FileName: "b.txt"}, next(stream))
+	assert.Equal(t, &runtimev1pb.SubscribeFileEventsResponse{Type: runtimev1pb.SubscribeFileEventsResponse_DELETE, FileName: "a.txt"}, next(stream))
+	cancel()
+	assert.Nil(t, <-done)
+
+	stream, cancel, done = subscribe("local", dir)
+	time.Sleep(50 * time.Millisecond)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644))
+	assert.Equal(t, &runtimev1pb.SubscribeFileEventsResponse{Type: runtimev1pb.SubscribeFileEventsResponse_CREATE, FileName: "a.txt"}, next(stream))
+	cancel()
+	assert.Nil(t, <-done)
+
+	_, cancel, done = subscribe("local", filepath.Join(dir, "not_exist"))
+	assert.Equal(t, codes.Internal, status.Code(<-done))
+	cancel()
+	_, cancel, done = subscribe("not_exist", dir)
+	assert.Equal(t, codes.InvalidArgument, status.Code(<-done))
+	cancel()
+
+	// the stream ends once the store is replaced, but not when the other stores are
+	_, cancel, done = subscribe("mock", "dir")
+	defer cancel()
+	time.Sleep(50 * time.Millisecond)
+	swap := func(name string, store file.File) {
+		resume, err := guard.Drain(context.Background())
+		assert.Nil(t, err)
+		stores[name] = store
+		resume()
+	}
+	swap("local", local.NewLocalStore())
+	select {
+	case err := <-done:
+		t.Fatalf("stream ended by swapping another store: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	swap("mock", &namesStore{})
+	select {
+	case err := <-done:
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream not ended by swapping the store")
+	}
+}
+
+func TestDiffFiles(t *testing.T) {
+	events := diffFiles(map[string]bool{"a": true, "b": true}, map[string]bool{"b": true, "d": true, "c": true})
+	assert.Equal(t, []*file.FileEvent{
+		{Type: file.EventCreate, FileName: "c"},
+		{Type: file.EventCreate, FileName: "d"},
+		{Type: file.EventDelete, FileName: "a"},
+	}, events)
+}
+
+// multipartStore records the configs of the files put in parts
+type multipartStore struct {
+	*resumableStore
+	configs map[string]*file.MultipartConfig
+}
+
+func (s *multipartStore) PutMultipart(ctx context.Context, st *file.PutFileStu, config *file.MultipartConfig) error {
+	s.configs[st.FileName] = config
+	return s.Put(ctx, st)
+}
+
+func TestPutFileMultipart(t *testing.T) {
+	store := &multipartStore{resumableStore: newResumableStore(), configs: map[string]*file.MultipartConfig{}}
+	a := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": store, "disabled": store, "default": store}, nil, nil, nil, nil)
+	a.(*api).SetFileMultiparts(map[string]*file.MultipartConfig{
+		"mock":     {PartSize: 4},
+		"disabled": {PartSize: 4, Disabled: true},
+	})
+	put := func(storeName, name string, data ...string) error {
+		var reqs []*runtimev1pb.PutFileRequest
+		for _, d := range data {
+			reqs = append(reqs, &runtimev1pb.PutFileRequest{StoreName: storeName, Name: name, Data: []byte(d)})
+		}
+		return a.PutFile(&fakePutFileStream{reqs: reqs})
+	}
+
+	// the files not larger than a part are put as usual
+	assert.Nil(t, put("mock", "small.txt", "he", "ll"))
+	assert.Equal(t, []byte("hell"), store.files["small.txt"])
+	assert.Nil(t, store.configs["small.txt"])
+
+	assert.Nil(t, put("mock", "large.txt", "hel", "lo"))
+	assert.Equal(t, []byte("hello"), store.files["large.txt"])
+	assert.Equal(t, &file.MultipartConfig{PartSize: 4, Concurrency: defaultPartConcurrency}, store.configs["large.txt"])
+
+	assert.Nil(t, put("disabled", "disabled.txt", "hello"))
+	assert.Equal(t, []byte("hello"), store.files["disabled.txt"])
+	assert.Nil(t, store.configs["disabled.txt"])
+	assert.Nil(t, put("default", "default.txt", "hello"))
+	assert.Nil(t, store.configs["default.txt"])
+
+	// the errors of the stream are returned before the upload
+	err := a.PutFile(&fakePutFileStream{reqs: []*runtimev1pb.PutFileRequest{
+		{StoreName: "mock", Name: "broken.txt", Data: []byte("hel")},
+	}, err: errors.New("broken")})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	_, ok := store.files["broken.txt"]
+	assert.False(t, ok)
+}
+
+func TestMkdirRenameCopy(t *testing.T) {
+	dir := t.TempDir()
+	a := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"local": local.NewLocalStore(), "plain": mock.NewMockFile(gomock.NewController(t))}, nil, nil, nil, nil)
+	ctx := context.Background()
+	fileReq := func(storeName, name string) *runtimev1pb.FileRequest {
+		return &runtimev1pb.FileRequest{StoreName: storeName, Name: name}
+	}
+
+	_, err := a.Mkdir(ctx, &runtimev1pb.MkdirRequest{Request: fileReq("local", filepath.Join(dir, "a/b"))})
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "a/b/c.txt"), []byte("hello"), 0644))
+	_, err = a.CopyFile(ctx, &runtimev1pb.CopyFileRequest{Request: fileReq("local", filepath.Join(dir, "a/b/c.txt")), Target: filepath.Join(dir, "d.txt")})
+	assert.Nil(t, err)
+	_, err = a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: fileReq("local", filepath.Join(dir, "a/b/c.txt")), Target: filepath.Join(dir, "a/e.txt")})
+	assert.Nil(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "d.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	data, err = os.ReadFile(filepath.Join(dir, "a/e.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	_, err = os.Stat(filepath.Join(dir, "a/b/c.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: fileReq("local", filepath.Join(dir, "a/b/c.txt")), Target: filepath.Join(dir, "f.txt")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = a.CopyFile(ctx, &runtimev1pb.CopyFileRequest{Request: fileReq("local", filepath.Join(dir, "d.txt"))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = a.CopyFile(ctx, &runtimev1pb.CopyFileRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = a.Mkdir(ctx, &runtimev1pb.MkdirRequest{Request: fileReq("not_exist", "a")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = a.Mkdir(ctx, &runtimev1pb.MkdirRequest{Request: fileReq("plain", "a")})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: fileReq("plain", "a"), Target: "b"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = a.CopyFile(ctx, &runtimev1pb.CopyFileRequest{Request: fileReq("plain", "a"), Target: "b"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// copyingStore copies the files of listableStore
+type copyingStore struct {
+	*listableStore
+}
+
+func (s *copyingStore) Copy(ctx context.Context, st *file.CopyRequest) error {
+	data, ok := s.files[st.Source]
+	if !ok {
+		return file.ErrNotExist
+	}
+	if st.Target == "broken.txt" {
+		return errors.New("broken")
+	}
+	s.files[st.Target] = data
+	return nil
+}
+
+func (s *copyingStore) Rename(ctx context.Context, st *file.RenameRequest) error {
+	if err := s.Copy(ctx, &file.CopyRequest{Source: st.Source, Target: st.Target}); err != nil {
+		return err
+	}
+	delete(s.files, st.Source)
+	return nil
+}
+
+func TestCopyFilePolicy(t *testing.T) {
+	store := &copyingStore{&listableStore{resumableStore: newResumableStore()}}
+	store.files["a.txt"] = []byte("hello")
+	a := NewAPI("app", nil, nil, nil, nil, nil, map[string]file.File{"mock": store}, nil, nil, nil, nil)
+	a.(*api).SetFilePolicies(map[string]*file.Policy{"mock": {
+		Quotas:            map[string]int64{"app": 12},
+		AllowedExtensions: []string{".txt"},
+		Directory:         "/",
+	}})
+	ctx := context.Background()
+	copyFile := func(source, target string) error {
+		_, err := a.CopyFile(ctx, &runtimev1pb.CopyFileRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: source}, Target: target})
+		return err
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(copyFile("a.txt", "a.png")))
+	_, err := a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "a.txt"}, Target: "a.png"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, codes.NotFound, status.Code(copyFile("c.txt", "d.txt")))
+	// the size reserved by the failed copy is released
+	assert.Equal(t, codes.Internal, status.Code(copyFile("a.txt", "broken.txt")))
+	assert.Nil(t, copyFile("a.txt", "b.txt"))
+	// overwriting a target only counts the difference of the sizes
+	assert.Nil(t, copyFile("a.txt", "b.txt"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(copyFile("a.txt", "c.txt")))
+	usage := a.(*api).loadedFileUsage("mock")
+	assert.Equal(t, int64(10), usage.used)
+	// renames don't change the usage
+	_, err = a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "b.txt"}, Target: "c.txt"})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), store.files["c.txt"])
+	assert.Equal(t, int64(10), usage.used)
+	// unless they overwrite the target
+	_, err = a.RenameFile(ctx, &runtimev1pb.RenameFileRequest{Request: &runtimev1pb.FileRequest{StoreName: "mock", Name: "c.txt"}, Target: "a.txt"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), usage.used)
+	assert.Nil(t, copyFile("a.txt", "d.txt"))
+}
+
+func TestFileStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	oldFile, newFile := mock.NewMockFile(ctrl), mock.NewMockFile(ctrl)
+	stores := map[string]file.File{"mock": oldFile}
+	a := NewAPI("", nil, nil, nil, nil, nil, stores, nil, nil, nil, nil)
+	fileStores := a.(interface {
+		FileStore(name string) (file.File, bool)
+	})
+
+	_, ok := fileStores.FileStore("missing")
+	assert.False(t, ok)
+	store, ok := fileStores.FileStore("mock")
+	assert.True(t, ok)
+
+	// the component replacing the store is used by the following calls
+	req := &file.DelRequest{FileName: "a.txt"}
+	oldFile.EXPECT().Del(gomock.Any(), req).Return(nil)
+	assert.Nil(t, store.Del(context.Background(), req))
+	stores["mock"] = newFile
+	newFile.EXPECT().Del(gomock.Any(), req).Return(nil)
+	assert.Nil(t, store.Del(context.Background(), req))
+
+	delete(stores, "mock")
+	assert.Equal(t, codes.Unavailable, status.Code(store.Del(context.Background(), req)))
+}
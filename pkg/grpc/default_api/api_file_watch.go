@@ -0,0 +1,211 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package default_api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/file"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+const (
+	defaultWatchInterval = 10 * time.Second
+	listPageSize         = 1000
+)
+
+// minWatchInterval is the min interval of listing a directory to watch, the shorter intervals are raised to it
+var minWatchInterval = time.Second
+
+// SubscribeFileEvents sends the events of the files created or deleted in the directory.
+// They are notified by the store if it is a file.Watcher, or found by listing the directory periodically.
+// The stream ends with Unavailable once the component of the store is swapped, and the client should subscribe again
+func (a *api) SubscribeFileEvents(in *runtimev1pb.SubscribeFileEventsRequest, stream runtimev1pb.Runtime_SubscribeFileEventsServer) error {
+	errCode := codes.Internal
+	if in.Request == nil {
+		return status.Errorf(codes.InvalidArgument, "request can't be nil")
+	}
+	if in.Request.Metadata == nil {
+		in.Request.Metadata = make(map[string]string)
+	}
+	swapped := a.guard.Swapped()
+	var store file.File
+	a.guard.Read(func() {
+		store = a.fileOps[in.Request.StoreName]
+	})
+	if store == nil {
+		return status.Errorf(codes.InvalidArgument, "not support store type: %+v", in.Request.StoreName)
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	replaced := make(chan struct{})
+	go a.watchFileStore(ctx, in.Request.StoreName, store, swapped, func() {
+		close(replaced)
+		cancel()
+	})
+	req := &file.WatchRequest{DirectoryName: in.Request.Name, Metadata: in.Request.Metadata}
+	var events <-chan *file.FileEvent
+	var err error
+	if watcher, ok := store.(file.Watcher); ok {
+		events, err = watcher.Watch(ctx, req)
+	} else {
+		interval := defaultWatchInterval
+		if in.IntervalMs > 0 {
+			interval = time.Duration(in.IntervalMs) * time.Millisecond
+		}
+		if interval < minWatchInterval {
+			interval = minWatchInterval
+		}
+		events, err = pollFileEvents(ctx, store, req, interval)
+	}
+	if err != nil {
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return status.Errorf(errCode, "watch directory %s fail,err: %+v", in.Request.Name, err)
+	}
+	for event := range events {
+		resp := &runtimev1pb.SubscribeFileEventsResponse{FileName: event.FileName, Type: runtimev1pb.SubscribeFileEventsResponse_CREATE}
+		if event.Type == file.EventDelete {
+			resp.Type = runtimev1pb.SubscribeFileEventsResponse_DELETE
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	select {
+	case <-replaced:
+		return status.Errorf(codes.Unavailable, "store %s is replaced", in.Request.StoreName)
+	default:
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	// the events may be lost, the client should subscribe again and check the files
+	return status.Errorf(codes.Unavailable, "watch directory %s of store %s stopped", in.Request.Name, in.Request.StoreName)
+}
+
+// watchFileStore looks up the store again each time the components may have been swapped,
+// and calls replaced once the store is no longer the watched component, until ctx is done
+func (a *api) watchFileStore(ctx context.Context, storeName string, store file.File, swapped <-chan struct{}, replaced func()) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-swapped:
+		}
+		swapped = a.guard.Swapped()
+		var current file.File
+		a.guard.Read(func() {
+			current = a.fileOps[storeName]
+		})
+		if current != store {
+			replaced()
+			return
+		}
+	}
+}
+
+// pollFileEvents lists the directory every interval, and sends the events of the files found created or deleted
+// since the last listing. The directory is listed once before returning to check the request
+func pollFileEvents(ctx context.Context, store file.File, req *file.WatchRequest, interval time.Duration) (<-chan *file.FileEvent, error) {
+	list := func() (map[string]bool, error) {
+		files := make(map[string]bool)
+		err := listFiles(ctx, store, req.DirectoryName, req.Metadata, func(info *file.FilesInfo) {
+			files[info.FileName] = true
+		})
+		return files, err
+	}
+	files, err := list()
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan *file.FileEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			current, err := list()
+			if err != nil {
+				// the changes are found by the next listing
+				log.DefaultLogger.Warnf("[runtime] list directory %s to watch fail, err: %+v", req.DirectoryName, err)
+				continue
+			}
+			for _, event := range diffFiles(files, current) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			files = current
+		}
+	}()
+	return events, nil
+}
+
+// diffFiles returns the events of the files created or deleted from the last listing to the current one
+func diffFiles(last, current map[string]bool) []*file.FileEvent {
+	var events []*file.FileEvent
+	for name := range current {
+		if !last[name] {
+			events = append(events, &file.FileEvent{Type: file.EventCreate, FileName: name})
+		}
+	}
+	for name := range last {
+		if !current[name] {
+			events = append(events, &file.FileEvent{Type: file.EventDelete, FileName: name})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Type != events[j].Type {
+			return events[i].Type < events[j].Type
+		}
+		return events[i].FileName < events[j].FileName
+	})
+	return events
+}
+
+// listFiles lists all the files in the directory page by page
+func listFiles(ctx context.Context, store file.File, directory string, metadata map[string]string, fn func(info *file.FilesInfo)) error {
+	marker := ""
+	for {
+		resp, err := store.List(ctx, &file.ListRequest{DirectoryName: directory, PageSize: listPageSize, Marker: marker, Metadata: metadata})
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.Files {
+			fn(info)
+		}
+		if !resp.IsTruncated || resp.Marker == "" || resp.Marker == marker {
+			return nil
+		}
+		marker = resp.Marker
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package default_api
+
+import (
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	runtime_common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	grpc_api "mosn.io/layotto/pkg/grpc"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// InvokeServiceStream forwards the request messages to the service and the response messages back,
+// until the service ends the stream.
+func (a *api) InvokeServiceStream(stream runtimev1pb.Runtime_InvokeServiceStreamServer) error {
+	// 1. the first request tells which service to call
+	in, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return status.Errorf(codes.Internal, "receive request fail, err: %+v", err)
+	}
+	if in.Id == "" || in.Method == "" {
+		return status.Errorf(codes.InvalidArgument, "id and method are required in the first request")
+	}
+	// the stream is not tracked by the request guard, the invoker is looked up under it
+	var invoker rpc.Invoker
+	var ok bool
+	a.guard.Read(func() {
+		invoker, ok = a.rpcs[mosninvoker.Name]
+	})
+	if !ok {
+		return status.Errorf(codes.Internal, "invoker not init")
+	}
+	streamInvoker, ok := invoker.(rpc.StreamInvoker)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "invoker does not support streaming")
+	}
+
+	// 2. start a stream with the service
+	ctx := stream.Context()
+	req := &rpc.RPCRequest{
+		Ctx:         ctx,
+		Id:          in.Id,
+		Method:      in.Method,
+		ContentType: in.ContentType,
+		Data:        in.Data,
+		Header:      rpc.RPCHeader{},
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range md {
+			req.Header[k] = v
+		}
+	}
+	if ext := in.HttpExtension; ext != nil {
+		req.Header["verb"] = []string{ext.Verb.String()}
+		req.Header["query_string"] = []string{ext.Querystring}
+	}
+	// the caller is identified by the runtime, it can't be specified by the application
+	req.Header[rpc.CallerAppId] = []string{grpc_api.CallerAppId(ctx, a.appId)}
+	rpcStream, err := streamInvoker.InvokeStream(ctx, req)
+	if err != nil {
+		return runtime_common.ToGrpcError(err)
+	}
+	defer rpcStream.Close()
+
+	// 3. forward the requests
+	if in.CloseSend {
+		rpcStream.CloseSend()
+	} else {
+		utils.GoWithRecover(func() {
+			forwardStreamRequests(stream, rpcStream)
+		}, nil)
+	}
+
+	// 4. forward the responses
+	header, err := rpcStream.Header()
+	if err != nil {
+		return runtime_common.ToGrpcError(err)
+	}
+	if err := stream.SendHeader(toStreamMetadata(header)); err != nil {
+		return err
+	}
+	contentType := header.Get("content-type")
+	if contentType == "" {
+		contentType = header.Get("Content-Type")
+	}
+	for {
+		data, err := rpcStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return runtime_common.ToGrpcError(err)
+		}
+		resp := &runtimev1pb.InvokeServiceStreamResponse{Data: data, ContentType: contentType}
+		contentType = ""
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	stream.SetTrailer(toStreamMetadata(rpcStream.Trailer()))
+	return nil
+}
+
+// forwardStreamRequests sends the data of the requests to the service until the client closes the sending direction
+func forwardStreamRequests(stream runtimev1pb.Runtime_InvokeServiceStreamServer, rpcStream rpc.RPCStream) {
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			rpcStream.CloseSend()
+			return
+		}
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime][rpc]receive stream request fail, err: %+v", err)
+			rpcStream.Close()
+			return
+		}
+		if len(in.Data) > 0 {
+			if err := rpcStream.Send(in.Data); err != nil {
+				log.DefaultLogger.Errorf("[runtime][rpc]send stream request fail, err: %+v", err)
+				return
+			}
+		}
+		if in.CloseSend {
+			rpcStream.CloseSend()
+			return
+		}
+	}
+}
+
+func toStreamMetadata(header rpc.RPCHeader) metadata.MD {
+	md := metadata.MD{}
+	for k, v := range header {
+		// fix https://github.com/mosn/layotto/issues/285
+		if strings.EqualFold("content-length", k) {
+			continue
+		}
+		md.Append(k, v...)
+	}
+	return md
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package default_api
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"mosn.io/layotto/components/rpc"
+	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// echoStream echoes the messages sent to it
+type echoStream struct {
+	ch chan []byte
+}
+
+func (s *echoStream) Header() (rpc.RPCHeader, error) {
+	return rpc.RPCHeader{"content-type": {"text/plain"}, "content-length": {"1"}}, nil
+}
+
+func (s *echoStream) Send(data []byte) error {
+	s.ch <- data
+	return nil
+}
+
+func (s *echoStream) CloseSend() error {
+	close(s.ch)
+	return nil
+}
+
+func (s *echoStream) Recv() ([]byte, error) {
+	data, ok := <-s.ch
+	if !ok {
+		return nil, io.EOF
+	}
+	return data, nil
+}
+
+func (s *echoStream) Trailer() rpc.RPCHeader {
+	return rpc.RPCHeader{"count": {"done"}}
+}
+
+func (s *echoStream) Close() error {
+	return nil
+}
+
+type echoStreamInvoker struct {
+	req *rpc.RPCRequest
+}
+
+func (i *echoStreamInvoker) Init(config rpc.RpcConfig) error {
+	return nil
+}
+
+func (i *echoStreamInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	return nil, nil
+}
+
+func (i *echoStreamInvoker) InvokeStream(ctx context.Context, req *rpc.RPCRequest) (rpc.RPCStream, error) {
+	i.req = req
+	s := &echoStream{ch: make(chan []byte, 10)}
+	if len(req.Data) > 0 {
+		s.ch <- req.Data
+	}
+	return s, nil
+}
+
+func TestInvokeServiceStream(t *testing.T) {
+	invoker := &echoStreamInvoker{}
+	a := NewAPI("", nil, nil, map[string]rpc.Invoker{mosninvoker.Name: invoker}, nil, nil, nil, nil, nil, nil, nil)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	srv := grpc.NewServer()
+	runtimev1pb.RegisterRuntimeServer(srv, a.(runtimev1pb.RuntimeServer))
+	go srv.Serve(lis)
+	defer srv.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := runtimev1pb.NewRuntimeClient(conn)
+
+	t.Run("bidirectional", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "user", "layotto")
+		stream, err := client.InvokeServiceStream(ctx)
+		assert.Nil(t, err)
+		assert.Nil(t, stream.Send(&runtimev1pb.InvokeServiceStreamRequest{Id: "echo", Method: "Echo", Data: []byte("hello")}))
+		assert.Nil(t, stream.Send(&runtimev1pb.InvokeServiceStreamRequest{Data: []byte("world"), CloseSend: true}))
+
+		var data []string
+		var contentTypes []string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			data = append(data, string(resp.Data))
+			contentTypes = append(contentTypes, resp.ContentType)
+		}
+		assert.Equal(t, []string{"hello", "world"}, data)
+		assert.Equal(t, []string{"text/plain", ""}, contentTypes)
+		assert.Equal(t, []string{"done"}, stream.Trailer().Get("count"))
+		assert.Equal(t, "layotto", invoker.req.Header.Get("user"))
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		stream, err := client.InvokeServiceStream(context.Background())
+		assert.Nil(t, err)
+		assert.Nil(t, stream.Send(&runtimev1pb.InvokeServiceStreamRequest{Method: "Echo"}))
+		_, err = stream.Recv()
+		assert.Error(t, err)
+	})
+}
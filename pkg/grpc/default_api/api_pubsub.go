@@ -71,6 +71,15 @@ func (a *api) startSubscribing() error {
 	return nil
 }
 
+// SubscribePubSub implements grpc_api.SubscribePubSub
+func (a *api) SubscribePubSub(name string, ps pubsub.PubSub) error {
+	topicRoutes, err := a.getInterestedTopics()
+	if err != nil {
+		return err
+	}
+	return a.beginPubSub(name, ps, topicRoutes)
+}
+
 func (a *api) beginPubSub(pubsubName string, ps pubsub.PubSub, topicRoutes map[string]TopicSubscriptions) error {
 	// 1. call app to find topic topic2Details.
 	v, ok := topicRoutes[pubsubName]
@@ -100,6 +100,19 @@ func TestPublishEvent(t *testing.T) {
 	})
 }
 
+func TestSubscribePubSub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPubSub := mock_pubsub.NewMockPubSub(ctrl)
+	mockPubSub.EXPECT().Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, gomock.Any()).Return(nil)
+	a := NewAPI("", nil, nil, nil, map[string]pubsub.PubSub{}, nil, nil, nil, nil, nil, nil).(*api)
+	a.topicPerComponent = map[string]TopicSubscriptions{
+		"events": {topic2Details: map[string]Details{"orders": {}}},
+	}
+	assert.Nil(t, a.SubscribePubSub("events", mockPubSub))
+	// the app is not interested in the topics of it
+	assert.Nil(t, a.SubscribePubSub("others", mockPubSub))
+}
+
 func TestMosnRuntime_publishMessageGRPC(t *testing.T) {
 	t.Run("publish success", func(t *testing.T) {
 		subResp := &runtimev1pb.TopicEventResponse{
@@ -111,7 +111,11 @@ func (a *api) streamSubscribe(stream runtimev1pb.Runtime_SubscribeTopicEventsSer
 		}
 	}
 
-	if a.pubSubs[initialRequest.PubsubName] == nil {
+	var ps pubsub.PubSub
+	a.guard.Read(func() {
+		ps = a.pubSubs[initialRequest.PubsubName]
+	})
+	if ps == nil {
 		return errors.New("pubsub " + initialRequest.PubsubName + " is not initialized.")
 	}
 
@@ -123,7 +127,7 @@ func (a *api) streamSubscribe(stream runtimev1pb.Runtime_SubscribeTopicEventsSer
 		return err
 	}
 
-	if err = a.pubSubs[initialRequest.PubsubName].Subscribe(pubsub.SubscribeRequest{
+	if err = ps.Subscribe(pubsub.SubscribeRequest{
 		Topic:    initialRequest.Topic,
 		Metadata: a.topicPerComponent[initialRequest.PubsubName].topic2Details[initialRequest.Topic].metadata,
 	}, func(ctx context.Context, msg *pubsub.NewMessage) error {
@@ -38,6 +38,7 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	tmock "github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
 )
@@ -154,6 +155,7 @@ func TestInvokeService(t *testing.T) {
 				assert.Equal(t, "id1", req.Id)
 				assert.Equal(t, "POST", req.Method)
 				assert.Equal(t, "application/json", req.ContentType)
+				assert.Equal(t, []string{"app1"}, req.Header[rpc.CallerAppId])
 				return resp, nil
 			})
 		httpMethod := int32(runtimev1pb.HTTPExtension_POST)
@@ -171,7 +173,7 @@ func TestInvokeService(t *testing.T) {
 		}
 
 		a := NewAPI(
-			"",
+			"app1",
 			nil,
 			nil,
 			map[string]rpc.Invoker{
@@ -186,7 +188,9 @@ func TestInvokeService(t *testing.T) {
 			nil,
 		)
 
-		_, err := a.InvokeService(context.Background(), in)
+		// the caller app id specified by the application is ignored
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(rpc.CallerAppId, "app2"))
+		_, err := a.InvokeService(ctx, in)
 		assert.Nil(t, err)
 	})
 }
@@ -17,12 +17,14 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"testing"
 
 	"mosn.io/layotto/spec/proto/extension/v1/s3"
 
 	l8s3 "mosn.io/layotto/components/oss"
+	local_oss "mosn.io/layotto/components/oss/local"
 
 	mockoss "mosn.io/layotto/pkg/mock/components/oss"
 
@@ -888,3 +890,53 @@ func TestListParts(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "layotto", resp.Bucket)
 }
+
+// TestWithInMemoryOss runs the server against the in-memory oss component instead of the mocks
+func TestWithInMemoryOss(t *testing.T) {
+	ctx := context.TODO()
+	store := local_oss.NewInMemoryOss()
+	err := store.Init(ctx, &l8s3.Config{Metadata: map[string]json.RawMessage{
+		l8s3.BasicConfiguration: json.RawMessage(`{"buckets":["layotto"]}`),
+	}})
+	assert.Nil(t, err)
+	s3Server := &S3Server{appId: "test", ossInstance: map[string]l8s3.Oss{"local": store}}
+	ctrl := gomock.NewController(t)
+
+	putStream := mocks3.NewMockObjectStorageService_PutObjectServer(ctrl)
+	putStream.EXPECT().Context().Return(ctx).AnyTimes()
+	gomock.InOrder(
+		putStream.EXPECT().Recv().Return(&s3.PutObjectInput{StoreName: "local", Bucket: "layotto", Key: "object", Body: []byte("hello")}, nil),
+		putStream.EXPECT().Recv().Return(&s3.PutObjectInput{Body: []byte(" world")}, nil),
+		putStream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	var putOutput *s3.PutObjectOutput
+	putStream.EXPECT().SendAndClose(gomock.Any()).DoAndReturn(func(out *s3.PutObjectOutput) error {
+		putOutput = out
+		return nil
+	})
+	assert.Nil(t, s3Server.PutObject(putStream))
+	assert.NotEmpty(t, putOutput.Etag)
+
+	getStream := mocks3.NewMockObjectStorageService_GetObjectServer(ctrl)
+	getStream.EXPECT().Context().Return(ctx).AnyTimes()
+	var body []byte
+	getStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(out *s3.GetObjectOutput) error {
+		body = append(body, out.Body...)
+		return nil
+	}).AnyTimes()
+	err = s3Server.GetObject(&s3.GetObjectInput{StoreName: "local", Bucket: "layotto", Key: "object"}, getStream)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(body))
+
+	list, err := s3Server.ListObjects(ctx, &s3.ListObjectsInput{StoreName: "local", Bucket: "layotto"})
+	assert.Nil(t, err)
+	assert.Len(t, list.Contents, 1)
+	assert.Equal(t, "object", list.Contents[0].Key)
+	assert.Equal(t, int64(11), list.Contents[0].Size)
+
+	_, err = s3Server.DeleteObject(ctx, &s3.DeleteObjectInput{StoreName: "local", Bucket: "layotto", Key: "object"})
+	assert.Nil(t, err)
+	exist, err := s3Server.IsObjectExist(ctx, &s3.IsObjectExistInput{StoreName: "local", Bucket: "layotto", Key: "object"})
+	assert.Nil(t, err)
+	assert.False(t, exist.FileExist)
+}
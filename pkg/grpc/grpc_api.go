@@ -17,7 +17,11 @@
 package grpc
 
 import (
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
 	"google.golang.org/grpc"
+
+	"mosn.io/layotto/components/file"
 )
 
 // GrpcAPI is the interface of API plugin. It has lifecycle related methods
@@ -32,3 +36,32 @@ type GrpcAPI interface {
 
 // NewGrpcAPI is the constructor of GrpcAPI
 type NewGrpcAPI func(applicationContext *ApplicationContext) GrpcAPI
+
+// SetFilePolicies is implemented by the GrpcAPI enforcing the policies of the file stores
+type SetFilePolicies interface {
+	// SetFilePolicies sets the policies indexed by the store names,
+	// the map is updated by the runtime when the stores are applied or removed at runtime
+	SetFilePolicies(policies map[string]*file.Policy)
+}
+
+// SetFileMultiparts is implemented by the GrpcAPI uploading the large files in parts
+type SetFileMultiparts interface {
+	// SetFileMultiparts sets the multipart configs indexed by the store names,
+	// the map is updated by the runtime when the stores are applied or removed at runtime
+	SetFileMultiparts(configs map[string]*file.MultipartConfig)
+}
+
+// SetTransactionalStateStores is implemented by the GrpcAPI executing the state transactions
+type SetTransactionalStateStores interface {
+	// SetTransactionalStateStores sets the state stores supporting transactions indexed by the store names,
+	// the map is updated by the runtime when the state stores are applied or removed at runtime
+	SetTransactionalStateStores(stores map[string]state.TransactionalStore)
+}
+
+// SubscribePubSub is implemented by the GrpcAPI subscribing to the topics the app is interested in
+type SubscribePubSub interface {
+	// SubscribePubSub subscribes to the topics on the pubsub component,
+	// it is called by the runtime when the pubsub component is applied at runtime,
+	// since the subscriptions end with the old component
+	SubscribePubSub(name string, ps pubsub.PubSub) error
+}
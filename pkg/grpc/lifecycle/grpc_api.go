@@ -41,6 +41,12 @@ func NewLifecycleAPI(ac *grpc_api.ApplicationContext) grpc.GrpcAPI {
 // server implements runtimev1pb.LifecycleServer
 type server struct {
 	components map[lifecycle.ComponentKey]common.DynamicComponent
+	manager    lifecycle.ComponentManager
+}
+
+// SetComponentManager implements lifecycle.SetComponentManager
+func (s *server) SetComponentManager(manager lifecycle.ComponentManager) {
+	s.manager = manager
 }
 
 func (s *server) ApplyConfiguration(ctx context.Context, in *runtimev1pb.DynamicConfiguration) (*runtimev1pb.ApplyConfigurationResponse, error) {
@@ -71,6 +77,47 @@ func (s *server) ApplyConfiguration(ctx context.Context, in *runtimev1pb.Dynamic
 	return &runtimev1pb.ApplyConfigurationResponse{}, err
 }
 
+func (s *server) ApplyComponent(ctx context.Context, in *runtimev1pb.ApplyComponentRequest) (*runtimev1pb.ApplyComponentResponse, error) {
+	// 1. validate parameters
+	if in.Kind == "" {
+		return &runtimev1pb.ApplyComponentResponse{}, invalidArgumentError(grpc_api.ErrNoField, "kind")
+	}
+	if in.Name == "" {
+		return &runtimev1pb.ApplyComponentResponse{}, invalidArgumentError(grpc_api.ErrNoField, "name")
+	}
+	if len(in.Config) == 0 {
+		return &runtimev1pb.ApplyComponentResponse{}, invalidArgumentError(grpc_api.ErrNoField, "config")
+	}
+	if s.manager == nil {
+		return &runtimev1pb.ApplyComponentResponse{}, status.Error(codes.Unimplemented, "component manager is not available")
+	}
+	// 2. delegate to the component manager
+	if err := s.manager.ApplyComponent(ctx, in.Kind, in.Name, in.Config); err != nil {
+		log.DefaultLogger.Errorf("ApplyComponent fail: %+v", err)
+		return &runtimev1pb.ApplyComponentResponse{}, status.Errorf(codes.Internal, "failed to apply component %s %s: %v", in.Kind, in.Name, err)
+	}
+	return &runtimev1pb.ApplyComponentResponse{}, nil
+}
+
+func (s *server) RemoveComponent(ctx context.Context, in *runtimev1pb.RemoveComponentRequest) (*runtimev1pb.RemoveComponentResponse, error) {
+	// 1. validate parameters
+	if in.Kind == "" {
+		return &runtimev1pb.RemoveComponentResponse{}, invalidArgumentError(grpc_api.ErrNoField, "kind")
+	}
+	if in.Name == "" {
+		return &runtimev1pb.RemoveComponentResponse{}, invalidArgumentError(grpc_api.ErrNoField, "name")
+	}
+	if s.manager == nil {
+		return &runtimev1pb.RemoveComponentResponse{}, status.Error(codes.Unimplemented, "component manager is not available")
+	}
+	// 2. delegate to the component manager
+	if err := s.manager.RemoveComponent(ctx, in.Kind, in.Name); err != nil {
+		log.DefaultLogger.Errorf("RemoveComponent fail: %+v", err)
+		return &runtimev1pb.RemoveComponentResponse{}, status.Errorf(codes.Internal, "failed to remove component %s %s: %v", in.Kind, in.Name, err)
+	}
+	return &runtimev1pb.RemoveComponentResponse{}, nil
+}
+
 func invalidArgumentError(format string, a ...interface{}) error {
 	err := status.Errorf(codes.InvalidArgument, format, a...)
 	log.DefaultLogger.Errorf("ApplyConfiguration fail: %+v", err)
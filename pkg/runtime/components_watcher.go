@@ -0,0 +1,314 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+)
+
+const (
+	// reloadDelay merges the events of one file modification
+	reloadDelay = 200 * time.Millisecond
+	// componentOperationTimeout limits the time of creating or removing a component during reloading
+	componentOperationTimeout = time.Minute
+)
+
+// componentsWatcher watches a source of the component sections
+type componentsWatcher interface {
+	close()
+}
+
+// watchComponents loads the components which can be changed at runtime, from either a file or a config store
+func (m *MosnRuntime) watchComponents() error {
+	if m.runtimeConfig == nil {
+		return nil
+	}
+	file, store := m.runtimeConfig.ComponentsFile, m.runtimeConfig.ComponentsStore
+	if file != "" && store != nil {
+		return errors.New("[runtime] components_file and components_store can not be used together")
+	}
+	if file != "" {
+		return m.watchComponentsFile(file)
+	}
+	if store != nil {
+		return m.watchComponentsStore(store)
+	}
+	return nil
+}
+
+// componentsReloader applies the component sections of a runtime config, and keeps the configs which have been applied,
+// so only the changed components are recreated on next reloading.
+type componentsReloader struct {
+	mu      sync.Mutex
+	manager lifecycle.ComponentManager
+	applied map[lifecycle.ComponentKey][]byte
+}
+
+func newComponentsReloader(manager lifecycle.ComponentManager) *componentsReloader {
+	return &componentsReloader{
+		manager: manager,
+		applied: make(map[lifecycle.ComponentKey][]byte),
+	}
+}
+
+// reload creates or replaces the changed components and removes the deleted ones.
+// It returns the last error, and the failed components will be retried on next reloading.
+func (r *componentsReloader) reload(data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	configs, err := lifecycle.ParseComponentConfigs(data)
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	applied, removed := lifecycle.DiffComponentConfigs(r.applied, configs)
+	for _, key := range removed {
+		ctx, cancel := context.WithTimeout(context.Background(), componentOperationTimeout)
+		err := r.manager.RemoveComponent(ctx, key.Kind, key.Name)
+		cancel()
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] failed to remove component %s %s: %v", key.Kind, key.Name, err)
+			lastErr = err
+			continue
+		}
+		delete(r.applied, key)
+	}
+	for _, key := range applied {
+		ctx, cancel := context.WithTimeout(context.Background(), componentOperationTimeout)
+		err := r.manager.ApplyComponent(ctx, key.Kind, key.Name, configs[key])
+		cancel()
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] failed to apply component %s %s: %v", key.Kind, key.Name, err)
+			lastErr = err
+			continue
+		}
+		r.applied[key] = configs[key]
+	}
+	return lastErr
+}
+
+// componentsFileWatcher reloads the components when the file changes
+type componentsFileWatcher struct {
+	path     string
+	reloader *componentsReloader
+	watcher  *fsnotify.Watcher
+}
+
+// watchComponentsFile applies the components in the file, and watches it for changes
+func (m *MosnRuntime) watchComponentsFile(path string) error {
+	w := &componentsFileWatcher{
+		path:     filepath.Clean(path),
+		reloader: newComponentsReloader(m),
+	}
+	// fail fast if the components can not be created at startup
+	if err := w.reload(); err != nil {
+		return err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// watch the directory, so the file can be replaced by renaming, e.g. a kubernetes configmap
+	if err := watcher.Add(filepath.Dir(w.path)); err != nil {
+		watcher.Close()
+		return err
+	}
+	w.watcher = watcher
+	m.componentsWatcher = w
+	utils.GoWithRecover(w.run, nil)
+	log.DefaultLogger.Infof("[runtime] start to watch components file %s", w.path)
+	return nil
+}
+
+func (w *componentsFileWatcher) reload() error {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	return w.reloader.reload(data)
+}
+
+func (w *componentsFileWatcher) run() {
+	var timer *time.Timer
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&fsnotify.Chmod == fsnotify.Chmod {
+				continue
+			}
+			if _, err := os.Stat(w.path); err != nil {
+				continue
+			}
+			// merge the events of one modification
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(reloadDelay, func() {
+				if err := w.reload(); err != nil {
+					log.DefaultLogger.Errorf("[runtime] failed to reload components file %s: %v", w.path, err)
+				}
+			})
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.DefaultLogger.Errorf("[runtime] components file watcher got error: %v", err)
+		}
+	}
+}
+
+func (w *componentsFileWatcher) close() {
+	if w.watcher != nil {
+		w.watcher.Close()
+	}
+}
+
+// componentsStoreWatcher reloads the components when the configuration item in the store changes
+type componentsStoreWatcher struct {
+	store    configstores.Store
+	appId    string
+	group    string
+	label    string
+	key      string
+	reloader *componentsReloader
+	ch       chan *configstores.SubscribeResp
+	done     chan struct{}
+}
+
+// watchComponentsStore applies the components in the config store, and subscribes the changes
+func (m *MosnRuntime) watchComponentsStore(config *ComponentsStoreConfig) error {
+	if config.Key == "" {
+		return errors.New("[runtime] components_store: key is required")
+	}
+	// 1. create the bootstrap store
+	store, err := m.configStoreRegistry.Create(config.Store.Type)
+	if err != nil {
+		return err
+	}
+	storeConfig := config.Store
+	if err := store.Init(&storeConfig); err != nil {
+		return err
+	}
+	w := &componentsStoreWatcher{
+		store:    store,
+		appId:    storeConfig.AppId,
+		group:    config.Group,
+		label:    config.Label,
+		key:      config.Key,
+		reloader: newComponentsReloader(m),
+		ch:       make(chan *configstores.SubscribeResp, 16),
+		done:     make(chan struct{}),
+	}
+	if w.appId == "" {
+		w.appId = m.runtimeConfig.AppManagement.AppId
+	}
+	if w.group == "" {
+		w.group = store.GetDefaultGroup()
+	}
+	if w.label == "" {
+		w.label = store.GetDefaultLabel()
+	}
+	// 2. fail fast if the components can not be created at startup
+	if err := w.load(); err != nil {
+		w.closeStore()
+		return err
+	}
+	// 3. subscribe the changes
+	if err := store.Subscribe(&configstores.SubscribeReq{
+		AppId: w.appId,
+		Group: w.group,
+		Label: w.label,
+		Keys:  []string{w.key},
+	}, w.ch); err != nil {
+		w.closeStore()
+		return err
+	}
+	m.componentsWatcher = w
+	utils.GoWithRecover(w.run, nil)
+	log.DefaultLogger.Infof("[runtime] start to watch components in config store %s, group: %s, key: %s", config.Store.Type, w.group, w.key)
+	return nil
+}
+
+func (w *componentsStoreWatcher) load() error {
+	ctx, cancel := context.WithTimeout(context.Background(), componentOperationTimeout)
+	defer cancel()
+	items, err := w.store.Get(ctx, &configstores.GetRequest{
+		AppId: w.appId,
+		Group: w.group,
+		Label: w.label,
+		Keys:  []string{w.key},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.Key == w.key {
+			return w.reloader.reload([]byte(item.Content))
+		}
+	}
+	return fmt.Errorf("[runtime] components not found in config store, group: %s, key: %s", w.group, w.key)
+}
+
+func (w *componentsStoreWatcher) run() {
+	for {
+		select {
+		case resp := <-w.ch:
+			if resp == nil {
+				continue
+			}
+			for _, item := range resp.Items {
+				if item == nil || item.Key != w.key {
+					continue
+				}
+				if err := w.reloader.reload([]byte(item.Content)); err != nil {
+					log.DefaultLogger.Errorf("[runtime] failed to reload components from config store, key %s: %v", w.key, err)
+				}
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *componentsStoreWatcher) close() {
+	w.store.StopSubscribe()
+	close(w.done)
+	w.closeStore()
+}
+
+func (w *componentsStoreWatcher) closeStore() {
+	if closer, ok := w.store.(io.Closer); ok {
+		closer.Close()
+	}
+}
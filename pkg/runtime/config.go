@@ -43,6 +43,9 @@ type AppConfig struct {
 	AppId            string `json:"app_id"`
 	GrpcCallbackPort int    `json:"grpc_callback_port"`
 	GrpcCallbackHost string `json:"grpc_callback_host"`
+	AppVersion       string `json:"app_version,omitempty"`
+	// PodLabelsFile is the labels file mounted by the kubernetes downward API
+	PodLabelsFile string `json:"pod_labels_file,omitempty"`
 }
 
 type MosnRuntimeConfig struct {
@@ -62,9 +65,29 @@ type MosnRuntimeConfig struct {
 	// e.g. <"super_pubsub","etcd",config>
 	CustomComponent map[string]map[string]custom.Config `json:"custom_component,omitempty"`
 	Extends         map[string]json.RawMessage          `json:"extends,omitempty"` // extend config
+	// ComponentsFile is a json file with the same component sections as this config.
+	// The components in it are created at startup, and recreated or torn down when the file changes.
+	ComponentsFile string `json:"components_file,omitempty"`
+	// ComponentsStore sources the component sections from a config store instead of a file.
+	// The components are created at startup, and recreated or torn down when the configuration changes.
+	ComponentsStore *ComponentsStoreConfig `json:"components_store,omitempty"`
+	// SecretRefreshInterval is the interval of resolving the secret refs of the components again, e.g. "5m".
+	// The rotated secrets are applied to the components implementing common.DynamicComponent.
+	// The secrets are not refreshed if it is empty.
+	SecretRefreshInterval string `json:"secret_refresh_interval,omitempty"`
 	ExtensionComponentConfig
 }
 
+// ComponentsStoreConfig locates the component sections in a config store
+type ComponentsStoreConfig struct {
+	// Store is the bootstrap config store, which is created apart from the config_store section
+	Store configstores.StoreConfig `json:"store"`
+	Group string                   `json:"group,omitempty"`
+	Label string                   `json:"label,omitempty"`
+	// Key is the configuration item whose content is a json with the same component sections as this config
+	Key string `json:"key"`
+}
+
 func ParseRuntimeConfig(data json.RawMessage) (*MosnRuntimeConfig, error) {
 	cfg := &MosnRuntimeConfig{}
 	if err := json.Unmarshal(data, cfg); err != nil {
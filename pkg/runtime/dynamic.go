@@ -0,0 +1,447 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/custom"
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/hello"
+	"mosn.io/layotto/components/lock"
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/sequencer"
+	mbindings "mosn.io/layotto/pkg/runtime/bindings"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+	runtime_lock "mosn.io/layotto/pkg/runtime/lock"
+	runtime_pubsub "mosn.io/layotto/pkg/runtime/pubsub"
+	msecretstores "mosn.io/layotto/pkg/runtime/secretstores"
+	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
+	runtime_state "mosn.io/layotto/pkg/runtime/state"
+)
+
+// defaultDrainTimeout is used when the context of a component operation has no deadline
+const defaultDrainTimeout = 30 * time.Second
+
+// swapFunc puts the new component into the component pool and returns the old one.
+// It is invoked after the in-flight requests are drained.
+type swapFunc func() (old interface{}, err error)
+
+// ApplyComponent creates the component, or replaces the existing one with the same kind and name.
+// The new component is initialized before draining, so requests are only blocked while the in-flight ones finish.
+// A pubsub component is subscribed to the topics the app is interested in before it is swapped in.
+// Only output bindings can be applied, the input bindings are rejected.
+func (m *MosnRuntime) ApplyComponent(ctx context.Context, kind string, name string, config []byte) error {
+	m.componentsMu.Lock()
+	defer m.componentsMu.Unlock()
+	// 1. create and init the new component
+	defer m.discardPendingComponents()
+	comp, swap, err := m.prepareComponent(kind, name, config)
+	if err != nil {
+		return err
+	}
+	// 2. drain the in-flight requests and swap
+	old, err := m.drainAndSwap(ctx, swap)
+	if err != nil {
+		closeComponent(kind, name, comp)
+		return err
+	}
+	// 3. tear down the old one
+	closeComponent(kind, name, old)
+	log.DefaultLogger.Infof("[runtime] component %s %s is applied", kind, name)
+	return nil
+}
+
+// RemoveComponent tears down the component after the in-flight requests are drained
+func (m *MosnRuntime) RemoveComponent(ctx context.Context, kind string, name string) error {
+	m.componentsMu.Lock()
+	defer m.componentsMu.Unlock()
+	swap, err := m.prepareRemoval(kind, name)
+	if err != nil {
+		return err
+	}
+	old, err := m.drainAndSwap(ctx, swap)
+	if err != nil {
+		return err
+	}
+	closeComponent(kind, name, old)
+	log.DefaultLogger.Infof("[runtime] component %s %s is removed", kind, name)
+	return nil
+}
+
+func (m *MosnRuntime) drainAndSwap(ctx context.Context, swap swapFunc) (interface{}, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultDrainTimeout)
+		defer cancel()
+	}
+	resume, err := m.requestGuard.Drain(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drain in-flight requests: %v", err)
+	}
+	defer resume()
+	return swap()
+}
+
+func (m *MosnRuntime) prepareComponent(kind string, name string, data []byte) (interface{}, swapFunc, error) {
+	switch kind {
+	case lifecycle.KindHello:
+		var config hello.HelloConfig
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createHello(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.hellos[name]
+			m.hellos[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindConfig:
+		var config configstores.StoreConfig
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createConfigStore(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.configStores[name]
+			m.configStores[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindRPC:
+		var config rpc.RpcConfig
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createRpc(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.rpcs[name]
+			m.rpcs[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindPubsub:
+		var config runtime_pubsub.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createPubSub(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			// the subscriptions end with the old component
+			for _, subscriber := range m.pubSubSubscribers {
+				if err := subscriber.SubscribePubSub(name, comp); err != nil {
+					return nil, fmt.Errorf("failed to subscribe to pubsub %s: %v", name, err)
+				}
+			}
+			old := m.pubSubs[name]
+			m.pubSubs[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindState:
+		var config runtime_state.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createState(name, &config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			if err := runtime_state.SaveStateConfiguration(name, config.Metadata); err != nil {
+				return nil, err
+			}
+			old := m.states[name]
+			m.states[name] = comp
+			m.setTransactionalState(name, comp)
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindFile:
+		var config file.FileConfig
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createFile(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.files[name]
+			m.files[name] = comp
+			m.setFilePolicy(name, config.Policy)
+			m.setFileMultipart(name, config.Multipart)
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindOss:
+		var config oss.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createOss(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.oss[name]
+			m.oss[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindLock:
+		var config lock.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createLock(name, &config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			if err := runtime_lock.SaveLockConfiguration(name, config.Metadata); err != nil {
+				return nil, err
+			}
+			old := m.locks[name]
+			m.locks[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindSequencer:
+		var config sequencer.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createSequencer(name, &config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			if err := runtime_sequencer.SaveSeqConfiguration(name, config.Metadata); err != nil {
+				return nil, err
+			}
+			old := m.sequencers[name]
+			m.sequencers[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindSecret:
+		var config msecretstores.Metadata
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createSecretStore(name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.secretStores[name]
+			m.secretStores[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	case lifecycle.KindBinding:
+		var config mbindings.Metadata
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createOutputBinding(name, config)
+		if err != nil {
+			if _, inputErr := m.bindingsRegistry.CreateInputBinding(config.Type); inputErr == nil {
+				return nil, nil, fmt.Errorf("input binding %s of type %s can not be applied at runtime", name, config.Type)
+			}
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.outputBindings[name]
+			m.outputBindings[name] = comp
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	}
+	if customKind, ok := lifecycle.ParseCustomKind(kind); ok {
+		var config custom.Config
+		if err := unmarshalComponentConfig(kind, name, data, &config); err != nil {
+			return nil, nil, err
+		}
+		comp, err := m.createCustomComponent(customKind, name, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comp, func() (interface{}, error) {
+			old := m.customComponent[customKind][name]
+			m.SetCustomComponent(customKind, name, comp)
+			m.replaceDynamicComponent(kind, name, comp)
+			return old, nil
+		}, nil
+	}
+	return nil, nil, fmt.Errorf("component kind %s is not supported", kind)
+}
+
+func (m *MosnRuntime) prepareRemoval(kind string, name string) (swapFunc, error) {
+	var (
+		old    interface{}
+		ok     bool
+		remove func()
+	)
+	switch kind {
+	case lifecycle.KindHello:
+		old, ok = m.hellos[name]
+		remove = func() { delete(m.hellos, name) }
+	case lifecycle.KindConfig:
+		old, ok = m.configStores[name]
+		remove = func() { delete(m.configStores, name) }
+	case lifecycle.KindRPC:
+		old, ok = m.rpcs[name]
+		remove = func() { delete(m.rpcs, name) }
+	case lifecycle.KindPubsub:
+		old, ok = m.pubSubs[name]
+		remove = func() { delete(m.pubSubs, name) }
+	case lifecycle.KindState:
+		old, ok = m.states[name]
+		remove = func() {
+			delete(m.states, name)
+			delete(m.transactionalStates, name)
+		}
+	case lifecycle.KindFile:
+		old, ok = m.files[name]
+		remove = func() {
+			delete(m.files, name)
+			delete(m.filePolicies, name)
+			delete(m.fileMultiparts, name)
+		}
+	case lifecycle.KindOss:
+		old, ok = m.oss[name]
+		remove = func() { delete(m.oss, name) }
+	case lifecycle.KindLock:
+		old, ok = m.locks[name]
+		remove = func() { delete(m.locks, name) }
+	case lifecycle.KindSequencer:
+		old, ok = m.sequencers[name]
+		remove = func() { delete(m.sequencers, name) }
+	case lifecycle.KindSecret:
+		old, ok = m.secretStores[name]
+		remove = func() { delete(m.secretStores, name) }
+	case lifecycle.KindBinding:
+		old, ok = m.outputBindings[name]
+		remove = func() { delete(m.outputBindings, name) }
+	default:
+		customKind, isCustom := lifecycle.ParseCustomKind(kind)
+		if !isCustom {
+			return nil, fmt.Errorf("component kind %s is not supported", kind)
+		}
+		old, ok = m.customComponent[customKind][name]
+		remove = func() { delete(m.customComponent[customKind], name) }
+	}
+	if !ok {
+		return nil, fmt.Errorf("component %s %s is not found", kind, name)
+	}
+	return func() (interface{}, error) {
+		remove()
+		delete(m.dynamicComponents, lifecycle.ComponentKey{Kind: kind, Name: name})
+		delete(m.secretRefs, lifecycle.ComponentKey{Kind: kind, Name: name})
+		for key := range m.dynamicComponents {
+			if isNestedComponent(key, kind, name) {
+				delete(m.dynamicComponents, key)
+			}
+		}
+		for key := range m.secretRefs {
+			if isNestedComponent(key, kind, name) {
+				delete(m.secretRefs, key)
+			}
+		}
+		return old, nil
+	}, nil
+}
+
+// replaceDynamicComponent registers the component and the nested components created along with it,
+// and commits their secret refs. The nested components of the old one are unregistered.
+func (m *MosnRuntime) replaceDynamicComponent(kind string, name string, comp interface{}) {
+	delete(m.dynamicComponents, lifecycle.ComponentKey{Kind: kind, Name: name})
+	for key := range m.dynamicComponents {
+		if isNestedComponent(key, kind, name) {
+			delete(m.dynamicComponents, key)
+		}
+	}
+	m.commitSecretRefs(kind, name)
+	m.storeDynamicComponent(kind, name, comp)
+	for key, nested := range m.nestedComponents {
+		if isNestedComponent(key, kind, name) {
+			m.storeDynamicComponent(key.Kind, key.Name, nested)
+			delete(m.nestedComponents, key)
+		}
+	}
+}
+
+// discardPendingComponents drops the nested components and the secret refs which are not put into service
+func (m *MosnRuntime) discardPendingComponents() {
+	for key := range m.nestedComponents {
+		delete(m.nestedComponents, key)
+	}
+	for key := range m.pendingSecretRefs {
+		delete(m.pendingSecretRefs, key)
+	}
+}
+
+// nestedComponentName is the name of the component nested in the named component with the role
+func nestedComponentName(name string, role string) string {
+	return name + "/" + role
+}
+
+func isNestedComponent(key lifecycle.ComponentKey, kind string, name string) bool {
+	return key.Kind == kind && strings.HasPrefix(key.Name, name+"/")
+}
+
+func unmarshalComponentConfig(kind string, name string, data []byte, config interface{}) error {
+	if err := json.Unmarshal(data, config); err != nil {
+		return fmt.Errorf("invalid config of component %s %s: %v", kind, name, err)
+	}
+	return nil
+}
+
+// closeComponent tears down the component if it can be closed
+func closeComponent(kind string, name string, comp interface{}) {
+	closer, ok := comp.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		log.DefaultLogger.Errorf("[runtime] failed to close component %s %s: %v", kind, name, err)
+	}
+}
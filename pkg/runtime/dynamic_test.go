@@ -0,0 +1,298 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/hello"
+	"mosn.io/layotto/components/hello/helloworld"
+	"mosn.io/layotto/pkg/grpc"
+	"mosn.io/layotto/pkg/mock"
+	mock_pubsub "mosn.io/layotto/pkg/mock/components/pubsub"
+	mock_state "mosn.io/layotto/pkg/mock/components/state"
+	mbindings "mosn.io/layotto/pkg/runtime/bindings"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+	runtime_pubsub "mosn.io/layotto/pkg/runtime/pubsub"
+	"mosn.io/layotto/pkg/runtime/ref"
+	runtime_state "mosn.io/layotto/pkg/runtime/state"
+)
+
+func newDynamicTestRuntime(t *testing.T) *MosnRuntime {
+	m := NewMosnRuntime(&MosnRuntimeConfig{})
+	m.errInt = func(err error, format string, args ...interface{}) {
+		log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+	}
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	assert.Nil(t, m.initHellos(hello.NewHelloFactory("helloworld", helloworld.NewHelloWorld)))
+	return m
+}
+
+func sayHello(t *testing.T, m *MosnRuntime, name string) string {
+	resp, err := m.hellos[name].Hello(context.Background(), &hello.HelloRequest{})
+	assert.Nil(t, err)
+	return resp.HelloString
+}
+
+func TestMosnRuntime_ApplyComponent(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	ctx := context.Background()
+
+	t.Run("create", func(t *testing.T) {
+		err := m.ApplyComponent(ctx, lifecycle.KindHello, "quick_start", []byte(`{"type":"helloworld","hello":"greetings"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, "greetings", sayHello(t, m, "quick_start"))
+		assert.NotNil(t, m.dynamicComponents[lifecycle.ComponentKey{Kind: lifecycle.KindHello, Name: "quick_start"}])
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		old := m.hellos["quick_start"]
+		err := m.ApplyComponent(ctx, lifecycle.KindHello, "quick_start", []byte(`{"type":"helloworld","hello":"hi"}`))
+		assert.Nil(t, err)
+		assert.NotEqual(t, old, m.hellos["quick_start"])
+		assert.Equal(t, "hi", sayHello(t, m, "quick_start"))
+	})
+
+	t.Run("invalid config keeps the old one", func(t *testing.T) {
+		err := m.ApplyComponent(ctx, lifecycle.KindHello, "quick_start", []byte(`{"type":"not_exist"}`))
+		assert.Error(t, err)
+		err = m.ApplyComponent(ctx, lifecycle.KindHello, "quick_start", []byte(`[]`))
+		assert.Error(t, err)
+		assert.Equal(t, "hi", sayHello(t, m, "quick_start"))
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		err := m.ApplyComponent(ctx, "unknown", "quick_start", []byte(`{}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := m.RemoveComponent(ctx, lifecycle.KindHello, "quick_start")
+		assert.Nil(t, err)
+		assert.Nil(t, m.hellos["quick_start"])
+		assert.Nil(t, m.dynamicComponents[lifecycle.ComponentKey{Kind: lifecycle.KindHello, Name: "quick_start"}])
+
+		err = m.RemoveComponent(ctx, lifecycle.KindHello, "quick_start")
+		assert.Error(t, err)
+	})
+}
+
+func TestMosnRuntime_ApplyStateComponent(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	m.stateRegistry.Register(runtime_state.NewFactory("in-memory", func() state.Store {
+		return mock_state.New(nil)
+	}))
+	ctx := context.Background()
+
+	// the state stores supporting transactions are indexed when they are applied
+	err := m.ApplyComponent(ctx, lifecycle.KindState, "memory", []byte(`{"type":"in-memory"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, m.states["memory"], m.transactionalStates["memory"])
+
+	old := m.transactionalStates["memory"]
+	err = m.ApplyComponent(ctx, lifecycle.KindState, "memory", []byte(`{"type":"in-memory"}`))
+	assert.Nil(t, err)
+	assert.NotSame(t, old, m.transactionalStates["memory"])
+	assert.Equal(t, m.states["memory"], m.transactionalStates["memory"])
+
+	err = m.RemoveComponent(ctx, lifecycle.KindState, "memory")
+	assert.Nil(t, err)
+	assert.Empty(t, m.transactionalStates)
+}
+
+// recordingSubscriber records the pubsub components subscribed to
+type recordingSubscriber struct {
+	err        error
+	subscribed map[string]pubsub.PubSub
+}
+
+func (s *recordingSubscriber) SubscribePubSub(name string, ps pubsub.PubSub) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.subscribed[name] = ps
+	return nil
+}
+
+func TestMosnRuntime_ApplyPubSubComponent(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	ctrl := gomock.NewController(t)
+	m.pubSubRegistry.Register(runtime_pubsub.NewFactory("mock", func() pubsub.PubSub {
+		ps := mock_pubsub.NewMockPubSub(ctrl)
+		ps.EXPECT().Init(gomock.Any()).Return(nil)
+		ps.EXPECT().Close().Return(nil).AnyTimes()
+		return ps
+	}))
+	subscriber := &recordingSubscriber{subscribed: make(map[string]pubsub.PubSub)}
+	m.pubSubSubscribers = []grpc.SubscribePubSub{subscriber}
+	ctx := context.Background()
+
+	// the new component is subscribed to before it is swapped in
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindPubsub, "events", []byte(`{"type":"mock"}`)))
+	assert.Same(t, m.pubSubs["events"], subscriber.subscribed["events"])
+	old := m.pubSubs["events"]
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindPubsub, "events", []byte(`{"type":"mock"}`)))
+	assert.NotSame(t, old, m.pubSubs["events"])
+	assert.Same(t, m.pubSubs["events"], subscriber.subscribed["events"])
+
+	// the old one is kept if the new one fails to subscribe
+	old = m.pubSubs["events"]
+	subscriber.err = errors.New("unavailable")
+	assert.Error(t, m.ApplyComponent(ctx, lifecycle.KindPubsub, "events", []byte(`{"type":"mock"}`)))
+	assert.Same(t, old, m.pubSubs["events"])
+}
+
+func TestMosnRuntime_ApplyInputBinding(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	m.bindingsRegistry.RegisterInputBinding(mbindings.NewInputBindingFactory("cron", func() bindings.InputBinding {
+		return nil
+	}))
+	err := m.ApplyComponent(context.Background(), lifecycle.KindBinding, "cron", []byte(`{"type":"cron"}`))
+	assert.EqualError(t, err, "input binding cron of type cron can not be applied at runtime")
+	assert.NotContains(t, m.outputBindings, "cron")
+}
+
+func TestMosnRuntime_ApplyComponentDrain(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	// an in-flight request
+	resume, err := m.requestGuard.Drain(context.Background())
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = m.ApplyComponent(ctx, lifecycle.KindHello, "quick_start", []byte(`{"type":"helloworld","hello":"greetings"}`))
+	assert.Error(t, err)
+	assert.Nil(t, m.hellos["quick_start"])
+
+	resume()
+	err = m.ApplyComponent(context.Background(), lifecycle.KindHello, "quick_start", []byte(`{"type":"helloworld","hello":"greetings"}`))
+	assert.Nil(t, err)
+}
+
+func TestMosnRuntime_watchComponentsFile(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	path := filepath.Join(t.TempDir(), "components.json")
+	err := os.WriteFile(path, []byte(`{"hellos":{"a":{"type":"helloworld","hello":"a"},"b":{"type":"helloworld","hello":"b"}}}`), 0644)
+	assert.Nil(t, err)
+
+	err = m.watchComponentsFile(path)
+	assert.Nil(t, err)
+	defer m.Stop()
+	assert.Equal(t, "a", sayHello(t, m, "a"))
+	assert.Equal(t, "b", sayHello(t, m, "b"))
+
+	// replace a, remove b and create c
+	err = os.WriteFile(path, []byte(`{"hellos":{"a":{"type":"helloworld","hello":"aa"},"c":{"type":"helloworld","hello":"c"}}}`), 0644)
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		m.componentsMu.Lock()
+		defer m.componentsMu.Unlock()
+		_, hasB := m.hellos["b"]
+		_, hasC := m.hellos["c"]
+		return !hasB && hasC
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, "aa", sayHello(t, m, "a"))
+}
+
+func TestMosnRuntime_watchComponentsFileNotExist(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	err := m.watchComponentsFile(filepath.Join(t.TempDir(), "not_exist.json"))
+	assert.Error(t, err)
+}
+
+func TestMosnRuntime_watchComponentsStore(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	config := &ComponentsStoreConfig{
+		Store: configstores.StoreConfig{Type: "mock", AppId: "app"},
+		Key:   "components",
+	}
+	mockStore := mock.NewMockStore(gomock.NewController(t))
+	m.configStoreRegistry.Register(configstores.NewStoreFactory("mock", func() configstores.Store {
+		return mockStore
+	}))
+	mockStore.EXPECT().Init(gomock.Any()).Return(nil)
+	mockStore.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *configstores.GetRequest) ([]*configstores.ConfigurationItem, error) {
+		assert.Equal(t, "app", req.AppId)
+		assert.Equal(t, "default", req.Group)
+		assert.Equal(t, []string{"components"}, req.Keys)
+		return []*configstores.ConfigurationItem{
+			{Key: "components", Content: `{"hellos":{"a":{"type":"helloworld","hello":"a"},"b":{"type":"helloworld","hello":"b"}}}`},
+		}, nil
+	})
+	var ch chan *configstores.SubscribeResp
+	mockStore.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(func(req *configstores.SubscribeReq, c chan *configstores.SubscribeResp) error {
+		ch = c
+		return nil
+	})
+	mockStore.EXPECT().StopSubscribe()
+
+	err := m.watchComponentsStore(config)
+	assert.Nil(t, err)
+	defer m.Stop()
+	assert.Equal(t, "a", sayHello(t, m, "a"))
+	assert.Equal(t, "b", sayHello(t, m, "b"))
+
+	// replace a, remove b and create c
+	ch <- &configstores.SubscribeResp{Items: []*configstores.ConfigurationItem{
+		{Key: "components", Content: `{"hellos":{"a":{"type":"helloworld","hello":"aa"},"c":{"type":"helloworld","hello":"c"}}}`},
+	}}
+	assert.Eventually(t, func() bool {
+		m.componentsMu.Lock()
+		defer m.componentsMu.Unlock()
+		_, hasB := m.hellos["b"]
+		_, hasC := m.hellos["c"]
+		return !hasB && hasC
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, "aa", sayHello(t, m, "a"))
+}
+
+func TestMosnRuntime_watchComponentsStoreNotFound(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	mockStore := mock.NewMockStore(gomock.NewController(t))
+	m.configStoreRegistry.Register(configstores.NewStoreFactory("mock", func() configstores.Store {
+		return mockStore
+	}))
+	mockStore.EXPECT().Init(gomock.Any()).Return(nil)
+	mockStore.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	err := m.watchComponentsStore(&ComponentsStoreConfig{
+		Store: configstores.StoreConfig{Type: "mock"},
+		Key:   "components",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, m.componentsWatcher)
+}
+
+func TestMosnRuntime_watchComponents(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	m.runtimeConfig.ComponentsFile = "components.json"
+	m.runtimeConfig.ComponentsStore = &ComponentsStoreConfig{Key: "components"}
+	assert.Error(t, m.watchComponents())
+}
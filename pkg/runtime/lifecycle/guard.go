@@ -0,0 +1,141 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	methodApplyComponent  = "/spec.proto.runtime.v1.Lifecycle/ApplyComponent"
+	methodRemoveComponent = "/spec.proto.runtime.v1.Lifecycle/RemoveComponent"
+)
+
+// longLivedStreams last as long as the callers keep them, like the subscriptions
+var longLivedStreams = map[string]bool{
+	"/spec.proto.runtime.v1.Runtime/InvokeServiceStream": true,
+	"/spec.proto.runtime.v1.Runtime/GetFile":             true,
+	"/spec.proto.runtime.v1.Runtime/PutFile":             true,
+}
+
+// RequestGuard tracks the in-flight gRPC requests, so that components can be swapped after all the requests using them are drained.
+// Long-lived streams, like the subscriptions and the file transfers, are not tracked, otherwise they would block the swapping
+// until they end. They look up the components by Read instead, and by Swapped again.
+type RequestGuard struct {
+	mu sync.RWMutex
+
+	swappedMu sync.Mutex
+	// swapped is closed and replaced each time a drain resumes
+	swapped chan struct{}
+}
+
+func NewRequestGuard() *RequestGuard {
+	return &RequestGuard{swapped: make(chan struct{})}
+}
+
+// UnaryServerInterceptor holds the guard while the request is in flight
+func (g *RequestGuard) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	// the component management requests drain other requests themselves
+	if info.FullMethod == methodApplyComponent || info.FullMethod == methodRemoveComponent {
+		return handler(ctx, req)
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor holds the guard while the stream is in flight
+func (g *RequestGuard) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isLongLived(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return handler(srv, ss)
+}
+
+// Read runs fn while holding the guard. The requests not tracked by the guard, like the long-lived streams,
+// and the background tasks look up the components by it, so that the lookups don't race with the swapping.
+// It must not be called by the tracked requests, whose nested read would deadlock with a pending Drain.
+// A nil guard runs fn directly.
+func (g *RequestGuard) Read(fn func()) {
+	if g == nil {
+		fn()
+		return
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	fn()
+}
+
+// Track runs fn as an in-flight request, it is used by the requests not served by the grpc server, like the http gateway.
+// A nil guard runs fn directly.
+func (g *RequestGuard) Track(fn func()) {
+	g.Read(fn)
+}
+
+// Drain blocks new requests and waits for the in-flight requests to finish.
+// The returned function must be called to resume the requests.
+func (g *RequestGuard) Drain(ctx context.Context) (resume func(), err error) {
+	locked := make(chan struct{})
+	go func() {
+		g.mu.Lock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		return func() {
+			g.mu.Unlock()
+			g.notifySwapped()
+		}, nil
+	case <-ctx.Done():
+		// release the lock as soon as it is acquired
+		go func() {
+			<-locked
+			g.mu.Unlock()
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// Swapped returns a channel closed once the next drain resumes, after which the components may have been swapped.
+// The long-lived streams take it before looking up their components, and look them up again once it is closed.
+// A nil guard returns a nil channel, which is never closed.
+func (g *RequestGuard) Swapped() <-chan struct{} {
+	if g == nil {
+		return nil
+	}
+	g.swappedMu.Lock()
+	defer g.swappedMu.Unlock()
+	return g.swapped
+}
+
+func (g *RequestGuard) notifySwapped() {
+	g.swappedMu.Lock()
+	defer g.swappedMu.Unlock()
+	close(g.swapped)
+	g.swapped = make(chan struct{})
+}
+
+func isLongLived(fullMethod string) bool {
+	if longLivedStreams[fullMethod] {
+		return true
+	}
+	idx := strings.LastIndex(fullMethod, "/")
+	return strings.HasPrefix(fullMethod[idx+1:], "Subscribe")
+}
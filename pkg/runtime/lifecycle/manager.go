@@ -0,0 +1,134 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ComponentManager creates, replaces and tears down components at runtime
+type ComponentManager interface {
+	// ApplyComponent creates the component, or replaces the existing one with the same kind and name.
+	// config is the json configuration of the component, the same as the one in the config file.
+	ApplyComponent(ctx context.Context, kind string, name string, config []byte) error
+	// RemoveComponent tears down the component
+	RemoveComponent(ctx context.Context, kind string, name string) error
+}
+
+// SetComponentManager is implemented by the GrpcAPI which needs to manage components at runtime
+type SetComponentManager interface {
+	SetComponentManager(manager ComponentManager)
+}
+
+// SetRequestGuard is implemented by the GrpcAPI which looks up the components outside of the requests tracked by the guard
+type SetRequestGuard interface {
+	SetRequestGuard(guard *RequestGuard)
+}
+
+// componentSections are the kinds of components in the runtime config
+var componentSections = []string{
+	KindHello,
+	KindConfig,
+	KindRPC,
+	KindPubsub,
+	KindState,
+	KindFile,
+	KindOss,
+	KindLock,
+	KindSequencer,
+	KindSecret,
+	KindBinding,
+}
+
+// ParseComponentConfigs parses the component sections of a runtime config into json configs indexed by kind and name.
+// Custom components are indexed by the kind "custom_component.<custom kind>"
+func ParseComponentConfigs(data []byte) (map[ComponentKey][]byte, error) {
+	sections := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return nil, err
+	}
+	result := make(map[ComponentKey][]byte)
+	for _, kind := range componentSections {
+		if err := parseSection(result, kind, sections[kind]); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := sections[KindCustom]; ok && !isNull(raw) {
+		kinds := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(raw, &kinds); err != nil {
+			return nil, fmt.Errorf("invalid %s section: %v", KindCustom, err)
+		}
+		for kind, raw := range kinds {
+			if err := parseSection(result, CustomKind(kind), raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// DiffComponentConfigs returns the components which are created or changed in current, and the ones removed from previous
+func DiffComponentConfigs(previous, current map[ComponentKey][]byte) (applied []ComponentKey, removed []ComponentKey) {
+	for key, config := range current {
+		if old, ok := previous[key]; !ok || !bytes.Equal(old, config) {
+			applied = append(applied, key)
+		}
+	}
+	for key := range previous {
+		if _, ok := current[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	return applied, removed
+}
+
+// CustomKind returns the component kind of a custom component
+func CustomKind(kind string) string {
+	return KindCustom + "." + kind
+}
+
+// ParseCustomKind returns the custom kind of a component kind, e.g. "super_pubsub" for "custom_component.super_pubsub"
+func ParseCustomKind(kind string) (string, bool) {
+	if !strings.HasPrefix(kind, KindCustom+".") {
+		return "", false
+	}
+	return strings.TrimPrefix(kind, KindCustom+"."), true
+}
+
+func parseSection(result map[ComponentKey][]byte, kind string, raw json.RawMessage) error {
+	if len(raw) == 0 || isNull(raw) {
+		return nil
+	}
+	components := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &components); err != nil {
+		return fmt.Errorf("invalid %s section: %v", kind, err)
+	}
+	for name, config := range components {
+		// compact the config so that the formatting changes are ignored
+		buf := &bytes.Buffer{}
+		if err := json.Compact(buf, config); err != nil {
+			return err
+		}
+		result[ComponentKey{Kind: kind, Name: name}] = buf.Bytes()
+	}
+	return nil
+}
+
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestParseComponentConfigs(t *testing.T) {
+	data := []byte(`{
+		"app": {"app_id": "app"},
+		"state": {"redis": {"type": "redis", "metadata": {"redisHost": "localhost:6379"}}},
+		"lock": null,
+		"custom_component": {"super_pubsub": {"etcd": {"type": "etcd"}}}
+	}`)
+	configs, err := ParseComponentConfigs(data)
+	assert.Nil(t, err)
+	assert.Len(t, configs, 2)
+	assert.Equal(t, `{"type":"redis","metadata":{"redisHost":"localhost:6379"}}`, string(configs[ComponentKey{Kind: KindState, Name: "redis"}]))
+	assert.Equal(t, `{"type":"etcd"}`, string(configs[ComponentKey{Kind: "custom_component.super_pubsub", Name: "etcd"}]))
+
+	_, err = ParseComponentConfigs([]byte(`{"state": []}`))
+	assert.Error(t, err)
+}
+
+func TestDiffComponentConfigs(t *testing.T) {
+	a := ComponentKey{Kind: KindState, Name: "a"}
+	b := ComponentKey{Kind: KindState, Name: "b"}
+	c := ComponentKey{Kind: KindLock, Name: "c"}
+	previous := map[ComponentKey][]byte{a: []byte("1"), b: []byte("2")}
+	current := map[ComponentKey][]byte{a: []byte("1"), b: []byte("3"), c: []byte("4")}
+	applied, removed := DiffComponentConfigs(previous, current)
+	sort.Slice(applied, func(i, j int) bool { return applied[i].Name < applied[j].Name })
+	assert.Equal(t, []ComponentKey{b, c}, applied)
+	assert.Empty(t, removed)
+
+	applied, removed = DiffComponentConfigs(current, previous)
+	sort.Slice(applied, func(i, j int) bool { return applied[i].Name < applied[j].Name })
+	assert.Equal(t, []ComponentKey{b}, applied)
+	assert.Equal(t, []ComponentKey{c}, removed)
+}
+
+func TestParseCustomKind(t *testing.T) {
+	kind, ok := ParseCustomKind(CustomKind("super_pubsub"))
+	assert.True(t, ok)
+	assert.Equal(t, "super_pubsub", kind)
+	_, ok = ParseCustomKind(KindState)
+	assert.False(t, ok)
+}
+
+func TestRequestGuard(t *testing.T) {
+	g := NewRequestGuard()
+	inFlight := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		_, _ = g.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/spec.proto.runtime.v1.Runtime/GetState"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				close(inFlight)
+				<-done
+				return nil, nil
+			})
+	}()
+	<-inFlight
+
+	// drain times out while the request is in flight
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := g.Drain(ctx)
+	assert.Error(t, err)
+
+	// the component management requests are not blocked by themselves
+	_, err = g.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: methodApplyComponent},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	assert.Nil(t, err)
+
+	close(done)
+	resume, err := g.Drain(context.Background())
+	assert.Nil(t, err)
+	resume()
+}
+
+func TestRequestGuard_Read(t *testing.T) {
+	g := NewRequestGuard()
+	resume, err := g.Drain(context.Background())
+	assert.Nil(t, err)
+	read := make(chan struct{})
+	go g.Read(func() {
+		close(read)
+	})
+	// the lookups wait for the swapping
+	select {
+	case <-read:
+		t.Fatal("read while draining")
+	case <-time.After(50 * time.Millisecond):
+	}
+	resume()
+	<-read
+
+	var nilGuard *RequestGuard
+	called := false
+	nilGuard.Read(func() {
+		called = true
+	})
+	assert.True(t, called)
+}
+
+func TestRequestGuard_Swapped(t *testing.T) {
+	g := NewRequestGuard()
+	swapped := g.Swapped()
+	resume, err := g.Drain(context.Background())
+	assert.Nil(t, err)
+	select {
+	case <-swapped:
+		t.Fatal("swapped before resuming")
+	default:
+	}
+	resume()
+	<-swapped
+	// the next swapping is notified by a new channel
+	select {
+	case <-g.Swapped():
+		t.Fatal("swapped without draining")
+	default:
+	}
+
+	var nilGuard *RequestGuard
+	assert.Nil(t, nilGuard.Swapped())
+}
+
+func TestIsLongLived(t *testing.T) {
+	assert.True(t, isLongLived("/spec.proto.runtime.v1.Runtime/SubscribeConfiguration"))
+	assert.True(t, isLongLived("/spec.proto.runtime.v1.Runtime/GetFile"))
+	assert.True(t, isLongLived("/spec.proto.runtime.v1.Runtime/InvokeServiceStream"))
+	assert.False(t, isLongLived("/spec.proto.runtime.v1.Runtime/ListFile"))
+}
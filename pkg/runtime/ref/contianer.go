@@ -20,19 +20,23 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 
 	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/cryption"
 )
 
 // RefContainer  hold all secret&config store
 type RefContainer struct {
 	SecretRef map[string]secretstores.SecretStore
 	ConfigRef map[string]configstores.Store
+	// CryptionRef is set once the cryption services are initialized
+	CryptionRef map[string]cryption.CryptionService
 }
 
 // NewRefContainer return a new container
 func NewRefContainer() *RefContainer {
 	return &RefContainer{
-		SecretRef: make(map[string]secretstores.SecretStore),
-		ConfigRef: make(map[string]configstores.Store),
+		SecretRef:   make(map[string]secretstores.SecretStore),
+		ConfigRef:   make(map[string]configstores.Store),
+		CryptionRef: make(map[string]cryption.CryptionService),
 	}
 }
 
@@ -43,3 +47,7 @@ func (r *RefContainer) getSecretStore(key string) secretstores.SecretStore {
 func (r *RefContainer) getConfigStore(key string) configstores.Store {
 	return r.ConfigRef[key]
 }
+
+func (r *RefContainer) getCryptionService(key string) cryption.CryptionService {
+	return r.CryptionRef[key]
+}
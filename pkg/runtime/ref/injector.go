@@ -17,11 +17,16 @@
 package ref
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/dapr/components-contrib/secretstores"
 
 	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/cryption"
 	"mosn.io/layotto/components/ref"
 )
 
@@ -50,14 +55,30 @@ func (i *DefaultInjector) InjectSecretRef(items []*ref.SecretRefConfig, metaData
 		return metaData, nil
 	}
 
+	meta, err := i.ResolveSecretRef(items)
+	if err != nil {
+		return metaData, err
+	}
+	//avoid part of assign because of err
+	for k, v := range meta {
+		metaData[k] = v
+	}
+	return metaData, nil
+}
+
+// ResolveSecretRef gets the referenced secrets from the secret stores, keyed by the names they are injected as
+func (i *DefaultInjector) ResolveSecretRef(items []*ref.SecretRefConfig) (map[string]string, error) {
 	meta := make(map[string]string)
 	for _, item := range items {
 		store := i.Container.getSecretStore(item.StoreName)
+		if store == nil {
+			return nil, fmt.Errorf("fail to get secretStore:%v", item.StoreName)
+		}
 		secret, err := store.GetSecret(secretstores.GetSecretRequest{
 			Name: item.Key,
 		})
 		if err != nil {
-			return metaData, err
+			return nil, err
 		}
 		for k, v := range secret.Data {
 			if k != item.SubKey {
@@ -70,11 +91,71 @@ func (i *DefaultInjector) InjectSecretRef(items []*ref.SecretRefConfig, metaData
 			}
 		}
 	}
-	//avoid part of assign because of err
-	for k, v := range meta {
-		metaData[k] = v
+	return meta, nil
+}
+
+// InjectSecretsToJSON sets the secrets into a json object.
+// The name of a secret can be a path separated by dots, e.g. "basic_config.accessKeySecret",
+// and the elements of arrays are referred to by the indexes, e.g. "before_invoke.0.config.secret".
+func InjectSecretsToJSON(data json.RawMessage, secrets map[string]string) (json.RawMessage, error) {
+	if len(secrets) == 0 {
+		return data, nil
 	}
-	return metaData, nil
+	obj := make(map[string]interface{})
+	if len(data) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		// keep the numbers as they are
+		decoder.UseNumber()
+		if err := decoder.Decode(&obj); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range secrets {
+		if err := setByPath(obj, strings.Split(k, "."), v); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(obj)
+}
+
+func setByPath(obj map[string]interface{}, path []string, value string) error {
+	var node interface{} = obj
+	for i, field := range path[:len(path)-1] {
+		var next interface{}
+		switch n := node.(type) {
+		case map[string]interface{}:
+			child, ok := n[field]
+			if !ok {
+				child = make(map[string]interface{})
+				n[field] = child
+			}
+			next = child
+		case []interface{}:
+			// the elements of an array are referred to by the indexes
+			idx, err := strconv.Atoi(field)
+			if err != nil || idx < 0 || idx >= len(n) {
+				return fmt.Errorf("can not inject secret into %s: index out of range", strings.Join(path[:i+1], "."))
+			}
+			next = n[idx]
+		default:
+			return fmt.Errorf("can not inject secret into %s: not an object", strings.Join(path[:i], "."))
+		}
+		node = next
+	}
+	last := path[len(path)-1]
+	switch n := node.(type) {
+	case map[string]interface{}:
+		n[last] = value
+	case []interface{}:
+		idx, err := strconv.Atoi(last)
+		if err != nil || idx < 0 || idx >= len(n) {
+			return fmt.Errorf("can not inject secret into %s: index out of range", strings.Join(path, "."))
+		}
+		n[idx] = value
+	default:
+		return fmt.Errorf("can not inject secret into %s: not an object", strings.Join(path[:len(path)-1], "."))
+	}
+	return nil
 }
 
 func (i *DefaultInjector) GetConfigStore(cf *ref.ComponentRefConfig) (configstores.Store, error) {
@@ -98,3 +179,14 @@ func (i *DefaultInjector) GetSecretStore(cf *ref.ComponentRefConfig) (secretstor
 	}
 	return secretStore, nil
 }
+
+func (i *DefaultInjector) GetCryptionService(cf *ref.ComponentRefConfig) (cryption.CryptionService, error) {
+	if cf == nil || cf.CryptionService == "" {
+		return nil, nil
+	}
+	cryptionService := i.Container.getCryptionService(cf.CryptionService)
+	if cryptionService == nil {
+		return nil, fmt.Errorf("fail to get cryptionService:%v", cf.CryptionService)
+	}
+	return cryptionService, nil
+}
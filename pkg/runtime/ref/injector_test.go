@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"mosn.io/layotto/components/cryption"
 	"mosn.io/layotto/components/ref"
 	"mosn.io/layotto/pkg/mock"
 	"mosn.io/layotto/pkg/mock/components/secret"
@@ -81,3 +82,70 @@ func TestInject(t *testing.T) {
 	})
 	assert.NotNil(t, err)
 }
+
+type fakeCryption struct {
+	cryption.CryptionService
+}
+
+func TestGetCryptionService(t *testing.T) {
+	injector := NewDefaultInjector(nil, nil)
+	cs, err := injector.GetCryptionService(&ref.ComponentRefConfig{})
+	assert.Nil(t, err)
+	assert.Nil(t, cs)
+	_, err = injector.GetCryptionService(&ref.ComponentRefConfig{CryptionService: "kms"})
+	assert.Error(t, err)
+
+	kms := &fakeCryption{}
+	injector.Container.CryptionRef = map[string]cryption.CryptionService{"kms": kms}
+	cs, err = injector.GetCryptionService(&ref.ComponentRefConfig{CryptionService: "kms"})
+	assert.Nil(t, err)
+	assert.Equal(t, kms, cs)
+}
+
+func TestResolveSecretRef(t *testing.T) {
+	container := NewRefContainer()
+	container.SecretRef["fake_secret_store"] = &secret.FakeSecretStore{}
+	injector := NewDefaultInjector(container.SecretRef, container.ConfigRef)
+
+	secrets, err := injector.ResolveSecretRef([]*ref.SecretRefConfig{
+		{StoreName: "fake_secret_store", Key: "good-key", SubKey: "good-key", InjectAs: "password"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"password": "life is good"}, secrets)
+
+	_, err = injector.ResolveSecretRef([]*ref.SecretRefConfig{
+		{StoreName: "not_exist", Key: "good-key", SubKey: "good-key"},
+	})
+	assert.Error(t, err)
+	_, err = injector.ResolveSecretRef([]*ref.SecretRefConfig{
+		{StoreName: "fake_secret_store", Key: "error-key", SubKey: "error-key"},
+	})
+	assert.Error(t, err)
+}
+
+func TestInjectSecretsToJSON(t *testing.T) {
+	data, err := InjectSecretsToJSON([]byte(`{"basic_config":{"endpoint":"e","port":8080}}`), map[string]string{
+		"basic_config.accessKeySecret": "secret",
+		"token":                        "token",
+	})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"basic_config":{"endpoint":"e","port":8080,"accessKeySecret":"secret"},"token":"token"}`, string(data))
+
+	data, err = InjectSecretsToJSON(nil, map[string]string{"a.b": "c"})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"a":{"b":"c"}}`, string(data))
+
+	_, err = InjectSecretsToJSON([]byte(`{"a":"b"}`), map[string]string{"a.b": "c"})
+	assert.Error(t, err)
+
+	// arrays
+	data, err = InjectSecretsToJSON([]byte(`{"before_invoke":[{"name":"jwt_auth","config":{}}],"keys":["a"]}`), map[string]string{
+		"before_invoke.0.config.secret": "secret",
+		"keys.0":                        "b",
+	})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"before_invoke":[{"name":"jwt_auth","config":{"secret":"secret"}}],"keys":["b"]}`, string(data))
+
+	_, err = InjectSecretsToJSON([]byte(`{"before_invoke":[]}`), map[string]string{"before_invoke.0.config.secret": "c"})
+	assert.Error(t, err)
+}
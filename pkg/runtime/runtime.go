@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/credentials/insecure"
@@ -75,6 +77,8 @@ type MosnRuntime struct {
 	runtimeConfig *MosnRuntimeConfig
 	info          *info.RuntimeInfo
 	srv           mgrpc.RegisteredServer
+	// attributes of this instance used to resolve gray labels
+	instanceInfo *configstores.InstanceInfo
 	// component registry
 	helloRegistry           hello.Registry
 	configStoreRegistry     configstores.Registry
@@ -96,16 +100,36 @@ type MosnRuntime struct {
 	rpcs         map[string]rpc.Invoker
 	pubSubs      map[string]pubsub.PubSub
 	// state implementations store here are already initialized
-	states            map[string]state.Store
-	files             map[string]file.File
-	oss               map[string]oss.Oss
-	locks             map[string]lock.LockStore
-	sequencers        map[string]sequencer.Store
-	outputBindings    map[string]bindings.OutputBinding
-	secretStores      map[string]secretstores.SecretStore
-	customComponent   map[string]map[string]custom.Component
-	dynamicComponents map[lifecycle.ComponentKey]common.DynamicComponent
+	states              map[string]state.Store
+	transactionalStates map[string]state.TransactionalStore
+	files               map[string]file.File
+	filePolicies        map[string]*file.Policy // enforced by the GrpcAPI
+	fileMultiparts      map[string]*file.MultipartConfig
+	oss                 map[string]oss.Oss
+	locks               map[string]lock.LockStore
+	sequencers          map[string]sequencer.Store
+	outputBindings      map[string]bindings.OutputBinding
+	secretStores        map[string]secretstores.SecretStore
+	customComponent     map[string]map[string]custom.Component
+	dynamicComponents   map[lifecycle.ComponentKey]common.DynamicComponent
 	extensionComponents
+	// componentsMu serializes the component changes at runtime
+	componentsMu sync.Mutex
+	// requestGuard drains in-flight requests before components are swapped
+	requestGuard      *lifecycle.RequestGuard
+	componentsWatcher componentsWatcher
+	// secretRefs are the secret refs of the components, which are refreshed by the secret refresher
+	secretRefs map[lifecycle.ComponentKey]*componentSecretRef
+	// pendingSecretRefs are the secret refs of the components being created, a nil ref removes the current one
+	pendingSecretRefs   map[lifecycle.ComponentKey]*componentSecretRef
+	stopSecretRefresher chan struct{}
+	// pubSubSubscribers subscribe to the topics on the pubsub components applied at runtime
+	pubSubSubscribers []grpc.SubscribePubSub
+	// apiClosers are the GrpcAPIs closed when the runtime stops, e.g. to stop their background goroutines
+	apiClosers []io.Closer
+	// nestedComponents are the nested components created along with the component being created,
+	// they are registered as dynamic components once the outer component is put into service
+	nestedComponents map[lifecycle.ComponentKey]interface{}
 	// app callback
 	AppCallbackConn *rawGRPC.ClientConn
 	// extend
@@ -142,7 +166,10 @@ func NewMosnRuntime(runtimeConfig *MosnRuntimeConfig) *MosnRuntime {
 		rpcs:                    make(map[string]rpc.Invoker),
 		pubSubs:                 make(map[string]pubsub.PubSub),
 		states:                  make(map[string]state.Store),
+		transactionalStates:     make(map[string]state.TransactionalStore),
 		files:                   make(map[string]file.File),
+		filePolicies:            make(map[string]*file.Policy),
+		fileMultiparts:          make(map[string]*file.MultipartConfig),
 		oss:                     make(map[string]oss.Oss),
 		locks:                   make(map[string]lock.LockStore),
 		sequencers:              make(map[string]sequencer.Store),
@@ -151,6 +178,10 @@ func NewMosnRuntime(runtimeConfig *MosnRuntimeConfig) *MosnRuntime {
 		customComponent:         make(map[string]map[string]custom.Component),
 		dynamicComponents:       make(map[lifecycle.ComponentKey]common.DynamicComponent),
 		extensionComponents:     *newExtensionComponents(),
+		requestGuard:            lifecycle.NewRequestGuard(),
+		secretRefs:              make(map[lifecycle.ComponentKey]*componentSecretRef),
+		pendingSecretRefs:       make(map[lifecycle.ComponentKey]*componentSecretRef),
+		nestedComponents:        make(map[lifecycle.ComponentKey]interface{}),
 		started:                 false,
 	}
 }
@@ -201,41 +232,105 @@ func (m *MosnRuntime) Run(opts ...Option) (mgrpc.RegisteredServer, error) {
 	if err := m.initRuntime(o); err != nil {
 		return nil, err
 	}
+	// load the components which can be changed at runtime
+	if err := m.watchComponents(); err != nil {
+		return nil, err
+	}
+	if err := m.startSecretRefresher(); err != nil {
+		return nil, err
+	}
 	// prepare grpcOpts
 	var grpcOpts []grpc.Option
 	if o.srvMaker != nil {
 		grpcOpts = append(grpcOpts, grpc.WithNewServer(o.srvMaker))
 	}
 	// 2. init GrpcAPI stage
-	var apis []grpc.GrpcAPI
-	ac := newApplicationContext(m)
-
-	for _, apiFactory := range o.apiFactorys {
-		api := apiFactory(ac)
-		// init the GrpcAPI
-		if err := api.Init(m.AppCallbackConn); err != nil {
-			return nil, err
-		}
-		apis = append(apis, api)
+	apis, err := m.initGrpcAPIs(o.apiFactorys)
+	if err != nil {
+		return nil, err
 	}
 	// put them into grpc options
 	grpcOpts = append(grpcOpts,
 		grpc.WithGrpcOptions(o.options...),
+		grpc.WithGrpcOptions(
+			rawGRPC.ChainUnaryInterceptor(m.requestGuard.UnaryServerInterceptor),
+			rawGRPC.ChainStreamInterceptor(m.requestGuard.StreamServerInterceptor),
+		),
 		grpc.WithGrpcAPIs(apis),
 	)
 	// 3. create grpc server
-	var err error
 	m.srv, err = grpc.NewGrpcServer(grpcOpts...)
 	return m.srv, err
 }
 
+// initGrpcAPIs creates and initializes the GrpcAPIs. The components are not changed at runtime meanwhile,
+// so the pubsub components applied later are subscribed by the GrpcAPIs kept here.
+func (m *MosnRuntime) initGrpcAPIs(apiFactorys []grpc.NewGrpcAPI) ([]grpc.GrpcAPI, error) {
+	m.componentsMu.Lock()
+	defer m.componentsMu.Unlock()
+	var apis []grpc.GrpcAPI
+	ac := newApplicationContext(m)
+	for _, apiFactory := range apiFactorys {
+		api := apiFactory(ac)
+		// inject the component manager
+		if setter, ok := api.(lifecycle.SetComponentManager); ok {
+			setter.SetComponentManager(m)
+		}
+		// inject the guard and the state stores supporting transactions
+		if setter, ok := api.(lifecycle.SetRequestGuard); ok {
+			setter.SetRequestGuard(m.requestGuard)
+		}
+		if setter, ok := api.(grpc.SetTransactionalStateStores); ok {
+			setter.SetTransactionalStateStores(m.transactionalStates)
+		}
+		// inject the file policies
+		if setter, ok := api.(grpc.SetFilePolicies); ok {
+			setter.SetFilePolicies(m.filePolicies)
+		}
+		// inject the multipart configs of the file stores
+		if setter, ok := api.(grpc.SetFileMultiparts); ok {
+			setter.SetFileMultiparts(m.fileMultiparts)
+		}
+		// init the GrpcAPI
+		if err := api.Init(m.AppCallbackConn); err != nil {
+			return nil, err
+		}
+		// keep it to subscribe to the pubsub components applied at runtime
+		if subscriber, ok := api.(grpc.SubscribePubSub); ok {
+			m.pubSubSubscribers = append(m.pubSubSubscribers, subscriber)
+		}
+		if closer, ok := api.(io.Closer); ok {
+			m.apiClosers = append(m.apiClosers, closer)
+		}
+		apis = append(apis, api)
+	}
+	return apis, nil
+}
+
 func (m *MosnRuntime) Stop() {
+	if m.stopSecretRefresher != nil {
+		close(m.stopSecretRefresher)
+		m.stopSecretRefresher = nil
+	}
+	if m.componentsWatcher != nil {
+		m.componentsWatcher.close()
+	}
 	if m.srv != nil {
 		m.srv.Stop()
 	}
+	for _, closer := range m.apiClosers {
+		if err := closer.Close(); err != nil {
+			log.DefaultLogger.Errorf("[runtime] close the grpc api error: %v", err)
+		}
+	}
+	m.apiClosers = nil
 }
 
 func (m *MosnRuntime) storeDynamicComponent(kind string, name string, store interface{}) {
+	// unwrap the decorators, e.g. the gray config store
+	if wrapper, ok := store.(interface{ Unwrap() configstores.Store }); ok {
+		store = wrapper.Unwrap()
+	}
 	comp, ok := store.(common.DynamicComponent)
 	if !ok {
 		return
@@ -258,13 +353,14 @@ func DefaultInitRuntimeStage(o *runtimeOptions, m *MosnRuntime) error {
 
 	// init all kinds of components with config
 	//init secret & config first
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	m.Injector.Container.CryptionRef = m.cryptionService
 	if err := m.initSecretStores(o.services.secretStores...); err != nil {
 		return err
 	}
 	if err := m.initConfigStores(o.services.configStores...); err != nil {
 		return err
 	}
-	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
 	if err := m.initCustomComponents(o.services.custom); err != nil {
 		return err
 	}
@@ -283,6 +379,10 @@ func DefaultInitRuntimeStage(o *runtimeOptions, m *MosnRuntime) error {
 	if err := m.initPubSubs(o.services.pubSubs...); err != nil {
 		return err
 	}
+	// the file and oss components can refer to the cryption services
+	if err := m.initExtensionComponent(o.services); err != nil {
+		return err
+	}
 	if err := m.initFiles(o.services.files...); err != nil {
 		return err
 	}
@@ -295,9 +395,6 @@ func DefaultInitRuntimeStage(o *runtimeOptions, m *MosnRuntime) error {
 	if err := m.initSequencers(o.services.sequencers...); err != nil {
 		return err
 	}
-	if err := m.initExtensionComponent(o.services); err != nil {
-		return err
-	}
 	return m.initInputBinding(o.services.inputBinding...)
 }
 
@@ -306,110 +403,183 @@ func (m *MosnRuntime) initHellos(hellos ...*hello.HelloFactory) error {
 	// register all hello services implementation
 	m.helloRegistry.Register(hellos...)
 	for name, config := range m.runtimeConfig.HelloServiceManagement {
-		h, err := m.helloRegistry.Create(config.Type)
+		h, err := m.createHello(name, config)
 		if err != nil {
-			m.errInt(err, "create hello's component %s failed", name)
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(h, config.ComponentRef); err != nil {
-			return err
-		}
-		if err := h.Init(&config); err != nil {
-			m.errInt(err, "init hello's component %s failed", name)
 			return err
 		}
 		// register this component
 		m.hellos[name] = h
-		m.storeDynamicComponent(lifecycle.KindHello, name, h)
+		m.replaceDynamicComponent(lifecycle.KindHello, name, h)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createHello(name string, config hello.HelloConfig) (hello.HelloService, error) {
+	h, err := m.helloRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create hello's component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindHello, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(h, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	if err := h.Init(&config); err != nil {
+		m.errInt(err, "init hello's component %s failed", name)
+		return nil, err
+	}
+	return h, nil
+}
+
 func (m *MosnRuntime) initConfigStores(configStores ...*configstores.StoreFactory) error {
 	log.DefaultLogger.Infof("[runtime] init config service")
 	// register all config store services implementation
 	m.configStoreRegistry.Register(configStores...)
 	for name, config := range m.runtimeConfig.ConfigStoreManagement {
-		c, err := m.configStoreRegistry.Create(config.Type)
+		c, err := m.createConfigStore(name, config)
 		if err != nil {
-			m.errInt(err, "create configstore's component %s failed", name)
-			return err
-		}
-		config.AppId = m.runtimeConfig.AppManagement.AppId
-		config.StoreName = name
-		if err := c.Init(&config); err != nil {
-			m.errInt(err, "init configstore's component %s failed", name)
 			return err
 		}
 		// register this component
 		m.configStores[name] = c
-		m.storeDynamicComponent(lifecycle.KindConfig, name, c)
+		m.replaceDynamicComponent(lifecycle.KindConfig, name, c)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createConfigStore(name string, config configstores.StoreConfig) (configstores.Store, error) {
+	c, err := m.configStoreRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create configstore's component %s failed", name)
+		return nil, err
+	}
+	config.AppId = m.runtimeConfig.AppManagement.AppId
+	config.StoreName = name
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindConfig, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	if err := c.Init(&config); err != nil {
+		m.errInt(err, "init configstore's component %s failed", name)
+		return nil, err
+	}
+	// resolve gray label
+	if len(config.GrayRules) > 0 {
+		if c, err = m.wrapGrayStore(c, config.GrayRules); err != nil {
+			m.errInt(err, "init gray rules of configstore's component %s failed", name)
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (m *MosnRuntime) wrapGrayStore(store configstores.Store, rules []*configstores.GrayRule) (configstores.Store, error) {
+	if err := configstores.ValidateGrayRules(rules); err != nil {
+		return nil, err
+	}
+	if m.instanceInfo == nil {
+		var labels map[string]string
+		if file := m.runtimeConfig.AppManagement.PodLabelsFile; file != "" {
+			var err error
+			if labels, err = configstores.ReadPodLabels(file); err != nil {
+				return nil, err
+			}
+		}
+		m.instanceInfo = configstores.NewInstanceInfo(m.runtimeConfig.AppManagement.AppId, m.runtimeConfig.AppManagement.AppVersion, labels)
+	}
+	label, ok := configstores.ResolveGrayLabel(rules, m.instanceInfo)
+	if !ok {
+		return store, nil
+	}
+	log.DefaultLogger.Infof("[runtime] gray label %s is resolved for configstore", label)
+	return configstores.NewGrayStore(store, label), nil
+}
+
 func (m *MosnRuntime) initRpcs(rpcs ...*rpc.Factory) error {
 	log.DefaultLogger.Infof("[runtime] init rpc service")
 	// register all rpc components
 	m.rpcRegistry.Register(rpcs...)
 	for name, config := range m.runtimeConfig.RpcManagement {
-		c, err := m.rpcRegistry.Create(name)
+		c, err := m.createRpc(name, config)
 		if err != nil {
-			m.errInt(err, "create rpc's component %s failed", name)
-			return err
-		}
-		if err := c.Init(config); err != nil {
-			m.errInt(err, "init rpc's component %s failed", name)
 			return err
 		}
 		// register this component
 		m.rpcs[name] = c
-		m.storeDynamicComponent(lifecycle.KindRPC, name, c)
+		m.replaceDynamicComponent(lifecycle.KindRPC, name, c)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createRpc(name string, config rpc.RpcConfig) (rpc.Invoker, error) {
+	c, err := m.rpcRegistry.Create(name)
+	if err != nil {
+		m.errInt(err, "create rpc's component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Config, err = m.injectSecretRefToJSON(lifecycle.KindRPC, name, config.SecretRef, config.Config); err != nil {
+		return nil, err
+	}
+	if err := c.Init(config); err != nil {
+		m.errInt(err, "init rpc's component %s failed", name)
+		return nil, err
+	}
+	return c, nil
+}
+
 func (m *MosnRuntime) initPubSubs(factorys ...*runtime_pubsub.Factory) error {
 	// 1. init components
 	log.DefaultLogger.Infof("[runtime] start initializing pubsub components")
 	// register all components implementation
 	m.pubSubRegistry.Register(factorys...)
 	for name, config := range m.runtimeConfig.PubSubManagement {
-		// create component
-		comp, err := m.pubSubRegistry.Create(config.Type)
+		comp, err := m.createPubSub(name, config)
 		if err != nil {
-			m.errInt(err, "create pubsub component %s failed", name)
-			return err
-		}
-		// check consumerID
-		consumerID := strings.TrimSpace(config.Metadata["consumerID"])
-		if consumerID == "" {
-			if config.Metadata == nil {
-				config.Metadata = make(map[string]string)
-			}
-			config.Metadata["consumerID"] = m.runtimeConfig.AppManagement.AppId
-		}
-		//inject secret to component
-		if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-			return err
-		}
-		// init this component with the config
-		if err := comp.Init(pubsub.Metadata{Properties: config.Metadata}); err != nil {
-			m.errInt(err, "init pubsub component %s failed", name)
 			return err
 		}
 		// register this component
 		m.pubSubs[name] = comp
-		m.storeDynamicComponent(lifecycle.KindPubsub, name, comp)
+		m.replaceDynamicComponent(lifecycle.KindPubsub, name, comp)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createPubSub(name string, config runtime_pubsub.Config) (pubsub.PubSub, error) {
+	// create component
+	comp, err := m.pubSubRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create pubsub component %s failed", name)
+		return nil, err
+	}
+	// check consumerID
+	consumerID := strings.TrimSpace(config.Metadata["consumerID"])
+	if consumerID == "" {
+		if config.Metadata == nil {
+			config.Metadata = make(map[string]string)
+		}
+		config.Metadata["consumerID"] = m.runtimeConfig.AppManagement.AppId
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindPubsub, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	// init this component with the config
+	if err := comp.Init(pubsub.Metadata{Properties: config.Metadata}); err != nil {
+		m.errInt(err, "init pubsub component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 func (m *MosnRuntime) initStates(factorys ...*runtime_state.Factory) error {
 	log.DefaultLogger.Infof("[runtime] start initializing state components")
 	// 1. register all the implementation
@@ -417,25 +587,13 @@ func (m *MosnRuntime) initStates(factorys ...*runtime_state.Factory) error {
 	// 2. loop initializing
 	for name, config := range m.runtimeConfig.StateManagement {
 		// 2.1. create and store the component
-		comp, err := m.stateRegistry.Create(config.Type)
+		comp, err := m.createState(name, &config)
 		if err != nil {
-			m.errInt(err, "create state component %s failed", name)
-			return err
-		}
-		//inject secret to component
-		if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-			return err
-		}
-		if err := comp.Init(state.Metadata{Properties: config.Metadata}); err != nil {
-			m.errInt(err, "init state component %s failed", name)
 			return err
 		}
 		m.states[name] = comp
-		m.storeDynamicComponent(lifecycle.KindState, name, comp)
+		m.setTransactionalState(name, comp)
+		m.replaceDynamicComponent(lifecycle.KindState, name, comp)
 
 		// 2.2. save prefix strategy
 		err = runtime_state.SaveStateConfiguration(name, config.Metadata)
@@ -447,6 +605,39 @@ func (m *MosnRuntime) initStates(factorys ...*runtime_state.Factory) error {
 	return nil
 }
 
+// createState creates and initializes a state component. The metadata of config will be injected with secrets.
+func (m *MosnRuntime) createState(name string, config *runtime_state.Config) (state.Store, error) {
+	comp, err := m.stateRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create state component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindState, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	if err := comp.Init(state.Metadata{Properties: config.Metadata}); err != nil {
+		m.errInt(err, "init state component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
+// setTransactionalState indexes the state store if it supports transactions, or removes the index otherwise
+func (m *MosnRuntime) setTransactionalState(name string, comp state.Store) {
+	if state.FeatureTransactional.IsPresent(comp.Features()) {
+		if txStore, ok := comp.(state.TransactionalStore); ok {
+			m.transactionalStates[name] = txStore
+			return
+		}
+	}
+	delete(m.transactionalStates, name)
+}
+
 func (m *MosnRuntime) initOss(factorys ...*oss.Factory) error {
 	log.DefaultLogger.Infof("[runtime] init oss service")
 
@@ -454,28 +645,46 @@ func (m *MosnRuntime) initOss(factorys ...*oss.Factory) error {
 	m.ossRegistry.Register(factorys...)
 	// 2. loop initializing
 	for name, config := range m.runtimeConfig.Oss {
-		// 2.1. create the component
-		c, err := m.ossRegistry.Create(config.Type)
+		c, err := m.createOss(name, config)
 		if err != nil {
-			m.errInt(err, "create oss component %s failed", name)
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(c, config.ComponentRef); err != nil {
-			return err
-		}
-		// 2.2. init
-		if err := c.Init(context.TODO(), &config); err != nil {
-			m.errInt(err, "init oss component %s failed", name)
 			return err
 		}
 		// register this component
 		m.oss[name] = c
-		m.storeDynamicComponent(lifecycle.KindOss, name, c)
+		m.replaceDynamicComponent(lifecycle.KindOss, name, c)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createOss(name string, config oss.Config) (oss.Oss, error) {
+	// 1. create the component
+	c, err := m.ossRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create oss component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRefToOssMetadata(name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(c, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	//create the wrapped component
+	if w, ok := c.(oss.Wrapper); ok {
+		if err := createNested(m, lifecycle.KindOss, name, "wrapped", &config, w.WrappedConfig, m.createOss, w.Wrap); err != nil {
+			return nil, err
+		}
+	}
+	// 2. init
+	if err := c.Init(context.TODO(), &config); err != nil {
+		m.errInt(err, "init oss component %s failed", name)
+		return nil, err
+	}
+	return c, nil
+}
+
 func (m *MosnRuntime) initFiles(files ...*file.Factory) error {
 	log.DefaultLogger.Infof("[runtime] init file service")
 
@@ -483,91 +692,147 @@ func (m *MosnRuntime) initFiles(files ...*file.Factory) error {
 	m.fileRegistry.Register(files...)
 
 	for name, config := range m.runtimeConfig.Files {
-		c, err := m.fileRegistry.Create(config.Type)
+		c, err := m.createFile(name, config)
 		if err != nil {
-			m.errInt(err, "create files component %s failed", name)
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(c, config.ComponentRef); err != nil {
-			return err
-		}
-		if err := c.Init(context.TODO(), &config); err != nil {
-			m.errInt(err, "init files component %s failed", name)
 			return err
 		}
 		m.files[name] = c
-		m.storeDynamicComponent(lifecycle.KindFile, name, c)
+		m.setFilePolicy(name, config.Policy)
+		m.setFileMultipart(name, config.Multipart)
+		m.replaceDynamicComponent(lifecycle.KindFile, name, c)
 	}
 	return nil
 }
 
+// setFilePolicy sets or removes the policy of the file store
+func (m *MosnRuntime) setFilePolicy(name string, policy *file.Policy) {
+	if policy == nil {
+		delete(m.filePolicies, name)
+		return
+	}
+	m.filePolicies[name] = policy
+}
+
+// setFileMultipart sets or removes the multipart config of the file store
+func (m *MosnRuntime) setFileMultipart(name string, config *file.MultipartConfig) {
+	if config == nil {
+		delete(m.fileMultiparts, name)
+		return
+	}
+	m.fileMultiparts[name] = config
+}
+
+// createNested creates the component whose config is nested in the config of another component,
+// like the wrapped component of a wrapper, and hands it to the outer component by set.
+// The nested component is keyed by the name of the outer one and the role, e.g. "artifacts/wrapped",
+// so its secret refs are kept apart from the outer ones, and the rotated secrets are applied to it.
+func createNested[C any, T any](m *MosnRuntime, kind string, name string, role string, config *C,
+	nestedConfig func(*C) (*C, error), create func(string, C) (T, error), set func(T)) error {
+	nested, err := nestedConfig(config)
+	if err != nil {
+		m.errInt(err, "init %s component %s failed", kind, name)
+		return err
+	}
+	nestedName := nestedComponentName(name, role)
+	c, err := create(nestedName, *nested)
+	if err != nil {
+		return err
+	}
+	m.nestedComponents[lifecycle.ComponentKey{Kind: kind, Name: nestedName}] = c
+	set(c)
+	return nil
+}
+
+func (m *MosnRuntime) createFile(name string, config file.FileConfig) (file.File, error) {
+	c, err := m.fileRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create files component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRefToJSON(lifecycle.KindFile, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(c, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	//create the wrapped component
+	if w, ok := c.(file.Wrapper); ok {
+		if err := createNested(m, lifecycle.KindFile, name, "wrapped", &config, w.WrappedConfig, m.createFile, w.Wrap); err != nil {
+			return nil, err
+		}
+	}
+	//create the replica
+	if r, ok := c.(file.Replicator); ok {
+		if err := createNested(m, lifecycle.KindFile, name, "replica", &config, r.ReplicaConfig, m.createFile, r.SetReplica); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Init(context.TODO(), &config); err != nil {
+		m.errInt(err, "init files component %s failed", name)
+		return nil, err
+	}
+	return c, nil
+}
+
 func (m *MosnRuntime) initLocks(factorys ...*runtime_lock.Factory) error {
 	log.DefaultLogger.Infof("[runtime] start initializing lock components")
 	// 1. register all the implementation
 	m.lockRegistry.Register(factorys...)
 	// 2. loop initializing
 	for name, config := range m.runtimeConfig.LockManagement {
-		// 2.1. create the component
-		comp, err := m.lockRegistry.Create(config.Type)
+		// 2.1. create and init the component
+		comp, err := m.createLock(name, &config)
 		if err != nil {
-			m.errInt(err, "create lock component %s failed", name)
-			return err
-		}
-		//inject secret to component
-		if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
 			return err
 		}
-		// 2.2. init
-		if err := comp.Init(lock.Metadata{Properties: config.Metadata}); err != nil {
-			m.errInt(err, "init lock component %s failed", name)
-			return err
-		}
-		// 2.3. save runtime related configs
+		// 2.2. save runtime related configs
 		err = runtime_lock.SaveLockConfiguration(name, config.Metadata)
 		if err != nil {
 			m.errInt(err, "save lock configuration %s failed", name)
 			return err
 		}
 		m.locks[name] = comp
-		m.storeDynamicComponent(lifecycle.KindLock, name, comp)
+		m.replaceDynamicComponent(lifecycle.KindLock, name, comp)
 	}
 	return nil
 }
 
+// createLock creates and initializes a lock component. The metadata of config will be injected with secrets.
+func (m *MosnRuntime) createLock(name string, config *lock.Config) (lock.LockStore, error) {
+	comp, err := m.lockRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create lock component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindLock, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	if err := comp.Init(lock.Metadata{Properties: config.Metadata}); err != nil {
+		m.errInt(err, "init lock component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 func (m *MosnRuntime) initSequencers(factorys ...*runtime_sequencer.Factory) error {
 	log.DefaultLogger.Infof("[runtime] start initializing sequencer components")
 	// 1. register all the implementation
 	m.sequencerRegistry.Register(factorys...)
 	// 2. loop initializing
 	for name, config := range m.runtimeConfig.SequencerManagement {
-		// 2.1. create the component
-		comp, err := m.sequencerRegistry.Create(config.Type)
+		// 2.1. create and init the component
+		comp, err := m.createSequencer(name, &config)
 		if err != nil {
-			m.errInt(err, "create sequencer component %s failed", name)
 			return err
 		}
-		//inject secret to component
-		if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-			return err
-		}
-		// 2.2. init
-		if err = comp.Init(sequencer.Configuration{
-			Properties: config.Metadata,
-			BiggerThan: config.BiggerThan,
-		}); err != nil {
-			m.errInt(err, "init sequencer component %s failed", name)
-			return err
-		}
-		// 2.3. save runtime related configs
+		// 2.2. save runtime related configs
 		err = runtime_sequencer.SaveSeqConfiguration(name, config.Metadata)
 		if err != nil {
 			m.errInt(err, "save sequencer configuration %s failed", name)
@@ -575,11 +840,36 @@ func (m *MosnRuntime) initSequencers(factorys ...*runtime_sequencer.Factory) err
 		}
 		// register this component
 		m.sequencers[name] = comp
-		m.storeDynamicComponent(lifecycle.KindSequencer, name, comp)
+		m.replaceDynamicComponent(lifecycle.KindSequencer, name, comp)
 	}
 	return nil
 }
 
+// createSequencer creates and initializes a sequencer component. The metadata of config will be injected with secrets.
+func (m *MosnRuntime) createSequencer(name string, config *sequencer.Config) (sequencer.Store, error) {
+	comp, err := m.sequencerRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create sequencer component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindSequencer, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	if err = comp.Init(sequencer.Configuration{
+		Properties: config.Metadata,
+		BiggerThan: config.BiggerThan,
+	}); err != nil {
+		m.errInt(err, "init sequencer component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 func (m *MosnRuntime) initAppCallbackConnection() error {
 	// init the client connection for calling app
 	if m.runtimeConfig == nil || m.runtimeConfig.AppManagement.GrpcCallbackPort == 0 {
@@ -613,32 +903,40 @@ func (m *MosnRuntime) initOutputBinding(factorys ...*mbindings.OutputBindingFact
 	m.bindingsRegistry.RegisterOutputBinding(factorys...)
 	// 2. loop initializing
 	for name, config := range m.runtimeConfig.Bindings {
-		// 2.1. create the component
-		comp, err := m.bindingsRegistry.CreateOutputBinding(config.Type)
+		comp, err := m.createOutputBinding(name, config)
 		if err != nil {
-			m.errInt(err, "create outbinding component %s failed", name)
-			return err
-		}
-		//inject secret to component
-		if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-			return err
-		}
-		// 2.2. init
-		if err := comp.Init(bindings.Metadata{Name: name, Properties: config.Metadata}); err != nil {
-			m.errInt(err, "init outbinding component %s failed", name)
 			return err
 		}
-		// 2.3. put it into the runtime component pool
+		// put it into the runtime component pool
 		m.outputBindings[name] = comp
-		m.storeDynamicComponent(lifecycle.KindBinding, name, comp)
+		m.replaceDynamicComponent(lifecycle.KindBinding, name, comp)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createOutputBinding(name string, config mbindings.Metadata) (bindings.OutputBinding, error) {
+	// 1. create the component
+	comp, err := m.bindingsRegistry.CreateOutputBinding(config.Type)
+	if err != nil {
+		m.errInt(err, "create outbinding component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindBinding, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	// 2. init
+	if err := comp.Init(bindings.Metadata{Name: name, Properties: config.Metadata}); err != nil {
+		m.errInt(err, "init outbinding component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 // TODO: implement initInputBinding
 func (m *MosnRuntime) initInputBinding(factorys ...*mbindings.InputBindingFactory) error {
 	return nil
@@ -648,31 +946,47 @@ func (m *MosnRuntime) initSecretStores(factorys ...*msecretstores.Factory) error
 	log.DefaultLogger.Infof("[runtime] start initializing SecretStores components")
 	// 1. register all factory methods.
 	m.secretStoresRegistry.Register(factorys...)
-	// 2. loop initializing
-	for name, config := range m.runtimeConfig.SecretStoresManagement {
-		// 2.1. create the component
-		comp, err := m.secretStoresRegistry.Create(config.Type)
-		if err != nil {
-			m.errInt(err, "create secretStore component %s failed", name)
-			return err
-		}
-		//inject component
-		if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-			return err
-		}
-		// 2.2. init
-		if err := comp.Init(secretstores.Metadata{Properties: config.Metadata}); err != nil {
-			m.errInt(err, "init secretStore component %s failed", name)
-			return err
+	// 2. loop initializing, the secret stores referring to the others are initialized later
+	for _, withRef := range []bool{false, true} {
+		for name, config := range m.runtimeConfig.SecretStoresManagement {
+			if (len(config.SecretRef) > 0) != withRef {
+				continue
+			}
+			comp, err := m.createSecretStore(name, config)
+			if err != nil {
+				return err
+			}
+			// save runtime related configs
+			m.secretStores[name] = comp
+			m.replaceDynamicComponent(lifecycle.KindSecret, name, comp)
 		}
-
-		// 2.3. save runtime related configs
-		m.secretStores[name] = comp
-		m.storeDynamicComponent(lifecycle.KindSecret, name, comp)
 	}
 	return nil
 }
 
+func (m *MosnRuntime) createSecretStore(name string, config msecretstores.Metadata) (secretstores.SecretStore, error) {
+	// 1. create the component
+	comp, err := m.secretStoresRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create secretStore component %s failed", name)
+		return nil, err
+	}
+	//inject secret from the other secret stores
+	if config.Metadata, err = m.injectSecretRef(lifecycle.KindSecret, name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	// 2. init
+	if err := comp.Init(secretstores.Metadata{Properties: config.Metadata}); err != nil {
+		m.errInt(err, "init secretStore component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 func (m *MosnRuntime) AppendInitRuntimeStage(f initRuntimeStage) {
 	if f == nil || m.started {
 		log.DefaultLogger.Errorf("[runtime] invalid initRuntimeStage or already started")
@@ -763,34 +1077,42 @@ func (m *MosnRuntime) initCustomComponents(kind2factorys map[string][]*custom.Fa
 		m.customComponentRegistry.Register(kind, factorys...)
 		// loop initializing component instances
 		for name, config := range name2Config {
-			// create the component
-			comp, err := m.customComponentRegistry.Create(kind, config.Type)
+			comp, err := m.createCustomComponent(kind, name, config)
 			if err != nil {
-				m.errInt(err, "create custom component %s failed", name)
-				return err
-			}
-			//inject secret to component
-			if config.Metadata, err = m.Injector.InjectSecretRef(config.SecretRef, config.Metadata); err != nil {
-				return err
-			}
-			//inject component
-			if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
-				return err
-			}
-			// init
-			if err := comp.Initialize(context.TODO(), config); err != nil {
-				m.errInt(err, "init custom component %s failed", name)
 				return err
 			}
 			// initialization finish
 			m.SetCustomComponent(kind, name, comp)
-			m.storeDynamicComponent(fmt.Sprintf("%s.%s", lifecycle.KindCustom, kind), name, comp)
+			m.replaceDynamicComponent(fmt.Sprintf("%s.%s", lifecycle.KindCustom, kind), name, comp)
 		}
 	}
 
 	return nil
 }
 
+func (m *MosnRuntime) createCustomComponent(kind string, name string, config custom.Config) (custom.Component, error) {
+	// create the component
+	comp, err := m.customComponentRegistry.Create(kind, config.Type)
+	if err != nil {
+		m.errInt(err, "create custom component %s failed", name)
+		return nil, err
+	}
+	//inject secret to component
+	if config.Metadata, err = m.injectSecretRef(lifecycle.CustomKind(kind), name, config.SecretRef, config.Metadata); err != nil {
+		return nil, err
+	}
+	//inject component
+	if err := m.initComponentInject(comp, config.ComponentRef); err != nil {
+		return nil, err
+	}
+	// init
+	if err := comp.Initialize(context.TODO(), config); err != nil {
+		m.errInt(err, "init custom component %s failed", name)
+		return nil, err
+	}
+	return comp, nil
+}
+
 func (m *MosnRuntime) initComponentInject(comp interface{}, config *refconfig.ComponentRefConfig) error {
 	if setComp, ok := comp.(common.SetComponent); ok {
 		configRef, err := m.Injector.GetConfigStore(config)
@@ -813,5 +1135,16 @@ func (m *MosnRuntime) initComponentInject(comp interface{}, config *refconfig.Co
 			}
 		}
 	}
+	if setComp, ok := comp.(common.SetCryptionService); ok {
+		cryptionRef, err := m.Injector.GetCryptionService(config)
+		if err != nil {
+			return err
+		}
+		if cryptionRef != nil {
+			if err = setComp.SetCryptionService(cryptionRef); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
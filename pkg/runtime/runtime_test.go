@@ -21,10 +21,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -39,7 +42,15 @@ import (
 
 	s3ext "mosn.io/layotto/pkg/grpc/extension/s3"
 
+	"mosn.io/layotto/components/cryption"
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/dedup"
+	local_file "mosn.io/layotto/components/file/local"
+	"mosn.io/layotto/components/file/replication"
 	"mosn.io/layotto/components/oss"
+	oss_encryption "mosn.io/layotto/components/oss/encryption"
+	local_oss "mosn.io/layotto/components/oss/local"
+	refconfig "mosn.io/layotto/components/ref"
 
 	"github.com/dapr/components-contrib/bindings"
 	"google.golang.org/grpc/test/bufconn"
@@ -54,6 +65,7 @@ import (
 	"mosn.io/layotto/pkg/grpc/default_api"
 	mock_appcallback "mosn.io/layotto/pkg/mock/runtime/appcallback"
 	mbindings "mosn.io/layotto/pkg/runtime/bindings"
+	"mosn.io/layotto/pkg/runtime/ref"
 	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 
@@ -158,7 +170,10 @@ func TestMosnRuntime_Run(t *testing.T) {
 		)
 		assert.Nil(t, err)
 		assert.NotNil(t, server)
+		// the default api is closed to stop its scans of the files
+		assert.Len(t, rt.apiClosers, 1)
 		rt.Stop()
+		assert.Len(t, rt.apiClosers, 0)
 	})
 	t.Run("run successfully with initRuntimeStage", func(t *testing.T) {
 		runtimeConfig := &MosnRuntimeConfig{}
@@ -420,6 +435,7 @@ func TestMosnRuntime_initStates(t *testing.T) {
 		// prepare mock
 		mockStateStore := mock_state.NewMockStore(gomock.NewController(t))
 		mockStateStore.EXPECT().Init(gomock.Any()).Return(nil)
+		mockStateStore.EXPECT().Features().Return(nil)
 		f := func() state.Store {
 			return mockStateStore
 		}
@@ -494,6 +510,59 @@ func TestMosnRuntime_initConfigStores(t *testing.T) {
 		err := m.initConfigStores(configstores.NewStoreFactory("store_config", f))
 		assert.Nil(t, err)
 	})
+
+	t.Run("init with gray rules", func(t *testing.T) {
+		mockStore := mock.NewMockStore(gomock.NewController(t))
+		mockStore.EXPECT().Init(gomock.Any()).Return(nil)
+		f := func() configstores.Store {
+			return mockStore
+		}
+
+		cfg := &MosnRuntimeConfig{
+			AppManagement: AppConfig{
+				AppId:      "app",
+				AppVersion: "2.0.0",
+			},
+			ConfigStoreManagement: map[string]configstores.StoreConfig{
+				"mock": {
+					Type: "store_config",
+					GrayRules: []*configstores.GrayRule{
+						{Label: "canary", Match: map[string]string{configstores.AttrAppVersion: "2.*"}},
+					},
+				},
+			},
+		}
+		m := NewMosnRuntime(cfg)
+		m.errInt = func(err error, format string, args ...interface{}) {
+			log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+		}
+		err := m.initConfigStores(configstores.NewStoreFactory("store_config", f))
+		assert.Nil(t, err)
+		assert.Equal(t, "canary", m.configStores["mock"].GetDefaultLabel())
+	})
+
+	t.Run("invalid gray rules", func(t *testing.T) {
+		mockStore := mock.NewMockStore(gomock.NewController(t))
+		mockStore.EXPECT().Init(gomock.Any()).Return(nil)
+		f := func() configstores.Store {
+			return mockStore
+		}
+
+		cfg := &MosnRuntimeConfig{
+			ConfigStoreManagement: map[string]configstores.StoreConfig{
+				"mock": {
+					Type:      "store_config",
+					GrayRules: []*configstores.GrayRule{{Percentage: 10}},
+				},
+			},
+		}
+		m := NewMosnRuntime(cfg)
+		m.errInt = func(err error, format string, args ...interface{}) {
+			log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+		}
+		err := m.initConfigStores(configstores.NewStoreFactory("store_config", f))
+		assert.Error(t, err)
+	})
 }
 
 func TestMosnRuntime_initHellos(t *testing.T) {
@@ -946,3 +1015,139 @@ func TestMosnRuntimeWithOssConfig(t *testing.T) {
 		rt.Stop()
 	})
 }
+
+func TestMosnRuntimeWithFileWrapper(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "content"), 0755))
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "index"), 0755))
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"store":       map[string]string{"type": "local"},
+		"contentDir":  filepath.Join(dir, "content"),
+		"indexDir":    filepath.Join(dir, "index"),
+		"compression": "zstd",
+	})
+	m := NewMosnRuntime(&MosnRuntimeConfig{Files: map[string]file.FileConfig{
+		"artifacts": {Type: "dedup", Metadata: metadata},
+	}})
+	m.errInt = func(err error, format string, args ...interface{}) {
+		log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+	}
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	err := m.initFiles(file.NewFileFactory("local", local_file.NewLocalStore), file.NewFileFactory("dedup", dedup.NewDedupFile))
+	require.Nil(t, err)
+	require.IsType(t, &dedup.DedupFile{}, m.files["artifacts"])
+
+	// the wrapped local store stores the content and the index
+	putMeta := map[string]string{local_file.FileMode: "420", local_file.FileFlag: strconv.Itoa(os.O_CREATE | os.O_WRONLY | os.O_TRUNC)}
+	err = m.files["artifacts"].Put(context.Background(), &file.PutFileStu{FileName: "a.txt", DataStream: strings.NewReader("hello"), Metadata: putMeta})
+	assert.Nil(t, err)
+	rc, err := m.files["artifacts"].Get(context.Background(), &file.GetFileStu{FileName: "a.txt"})
+	require.Nil(t, err)
+	data, _ := ioutil.ReadAll(rc)
+	rc.Close()
+	assert.Equal(t, "hello", string(data))
+	_, err = os.Stat(filepath.Join(dir, "index", "a.txt"))
+	assert.Nil(t, err)
+}
+
+func TestMosnRuntimeWithFileReplica(t *testing.T) {
+	dir := t.TempDir()
+	dedupConfig := func(d string) map[string]interface{} {
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, d, "content"), 0755))
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, d, "index"), 0755))
+		return map[string]interface{}{
+			"type": "dedup",
+			"metadata": map[string]interface{}{
+				"store":      map[string]string{"type": "local"},
+				"contentDir": filepath.Join(dir, d, "content"),
+				"indexDir":   filepath.Join(dir, d, "index"),
+			},
+		}
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"store":    dedupConfig("primary"),
+		"replica":  dedupConfig("replica"),
+		"mode":     "sync",
+		"queueDir": filepath.Join(dir, "queue"),
+	})
+	m := NewMosnRuntime(&MosnRuntimeConfig{Files: map[string]file.FileConfig{
+		"artifacts": {Type: "replication", Metadata: metadata},
+	}})
+	m.errInt = func(err error, format string, args ...interface{}) {
+		log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+	}
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	err := m.initFiles(file.NewFileFactory("local", local_file.NewLocalStore), file.NewFileFactory("dedup", dedup.NewDedupFile),
+		file.NewFileFactory("replication", replication.NewReplicationFile))
+	require.Nil(t, err)
+	require.IsType(t, &replication.ReplicationFile{}, m.files["artifacts"])
+	defer m.files["artifacts"].(*replication.ReplicationFile).Close()
+
+	// the file is put to the store, and replicated to the replica
+	putMeta := map[string]string{local_file.FileMode: "420", local_file.FileFlag: strconv.Itoa(os.O_CREATE | os.O_WRONLY | os.O_TRUNC)}
+	err = m.files["artifacts"].Put(context.Background(), &file.PutFileStu{FileName: "a.txt", DataStream: strings.NewReader("hello"), Metadata: putMeta})
+	require.Nil(t, err)
+	_, err = os.Stat(filepath.Join(dir, "primary", "index", "a.txt"))
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(dir, "replica", "index", "a.txt"))
+	assert.Nil(t, err)
+}
+
+// reversingCryption wraps the keys by reversing them
+type reversingCryption struct{}
+
+func reverseBytes(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[len(data)-1-i] = b
+	}
+	return out
+}
+
+func (r *reversingCryption) Init(context.Context, *cryption.Config) error {
+	return nil
+}
+
+func (r *reversingCryption) Encrypt(ctx context.Context, req *cryption.EncryptRequest) (*cryption.EncryptResponse, error) {
+	return &cryption.EncryptResponse{CipherText: reverseBytes(req.PlainText)}, nil
+}
+
+func (r *reversingCryption) Decrypt(ctx context.Context, req *cryption.DecryptRequest) (*cryption.DecryptResponse, error) {
+	return &cryption.DecryptResponse{PlainText: reverseBytes(req.CipherText)}, nil
+}
+
+func TestMosnRuntimeWithOssWrapper(t *testing.T) {
+	config := oss.Config{
+		Type: "encryption.oss",
+		Metadata: map[string]json.RawMessage{
+			"store": json.RawMessage(`{"type":"in-memory.oss","metadata":{"basic_config":{"buckets":["layotto"]}}}`),
+		},
+	}
+	config.ComponentRef = &refconfig.ComponentRefConfig{CryptionService: "kms"}
+	m := NewMosnRuntime(&MosnRuntimeConfig{Oss: map[string]oss.Config{"encrypted": config}})
+	m.errInt = func(err error, format string, args ...interface{}) {
+		log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+	}
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	m.Injector.Container.CryptionRef = map[string]cryption.CryptionService{"kms": &reversingCryption{}}
+	err := m.initOss(oss.NewFactory("in-memory.oss", local_oss.NewInMemoryOss), oss.NewFactory("encryption.oss", oss_encryption.NewEncryptionOss))
+	require.Nil(t, err)
+	require.IsType(t, &oss_encryption.EncryptionOss{}, m.oss["encrypted"])
+
+	ctx := context.Background()
+	_, err = m.oss["encrypted"].PutObject(ctx, &oss.PutObjectInput{Bucket: "layotto", Key: "a.txt", DataStream: strings.NewReader("hello")})
+	require.Nil(t, err)
+	out, err := m.oss["encrypted"].GetObject(ctx, &oss.GetObjectInput{Bucket: "layotto", Key: "a.txt"})
+	require.Nil(t, err)
+	data, _ := ioutil.ReadAll(out.DataStream)
+	out.DataStream.Close()
+	assert.Equal(t, "hello", string(data))
+
+	// the cryption service must be referred to
+	config.ComponentRef = nil
+	m = NewMosnRuntime(&MosnRuntimeConfig{Oss: map[string]oss.Config{"encrypted": config}})
+	m.errInt = func(err error, format string, args ...interface{}) {}
+	m.Injector = ref.NewDefaultInjector(m.secretStores, m.configStores)
+	err = m.initOss(oss.NewFactory("in-memory.oss", local_oss.NewInMemoryOss), oss.NewFactory("encryption.oss", oss_encryption.NewEncryptionOss))
+	assert.Error(t, err)
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	refconfig "mosn.io/layotto/components/ref"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+	"mosn.io/layotto/pkg/runtime/ref"
+)
+
+// componentSecretRef holds the secret refs of a component and the secrets applied to it
+type componentSecretRef struct {
+	items   []*refconfig.SecretRefConfig
+	secrets map[string]string
+	// metadata builds the metadata passed to DynamicComponent.ApplyConfig with the rotated secrets
+	metadata func(secrets map[string]string) (map[string]string, error)
+}
+
+// injectSecretRef injects the secrets into the metadata, and keeps the secret refs pending,
+// they are committed once the component is put into service, so the rotated secrets can be applied to it later.
+func (m *MosnRuntime) injectSecretRef(kind string, name string, items []*refconfig.SecretRefConfig, metadata map[string]string) (map[string]string, error) {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	key := lifecycle.ComponentKey{Kind: kind, Name: name}
+	if len(items) == 0 {
+		m.pendingSecretRefs[key] = nil
+		return metadata, nil
+	}
+	base := copyMetadata(metadata)
+	secrets, err := m.Injector.ResolveSecretRef(items)
+	if err != nil {
+		return metadata, err
+	}
+	for k, v := range secrets {
+		metadata[k] = v
+	}
+	m.pendingSecretRefs[key] = &componentSecretRef{
+		items:   items,
+		secrets: secrets,
+		metadata: func(secrets map[string]string) (map[string]string, error) {
+			res := copyMetadata(base)
+			for k, v := range secrets {
+				res[k] = v
+			}
+			return res, nil
+		},
+	}
+	return metadata, nil
+}
+
+// injectSecretRefToJSON injects the secrets into a component configured with a json object.
+// The metadata applied to the component later is made up of the top level fields of the object.
+func (m *MosnRuntime) injectSecretRefToJSON(kind string, name string, items []*refconfig.SecretRefConfig, data json.RawMessage) (json.RawMessage, error) {
+	key := lifecycle.ComponentKey{Kind: kind, Name: name}
+	if len(items) == 0 {
+		m.pendingSecretRefs[key] = nil
+		return data, nil
+	}
+	secrets, err := m.Injector.ResolveSecretRef(items)
+	if err != nil {
+		return data, err
+	}
+	injected, err := ref.InjectSecretsToJSON(data, secrets)
+	if err != nil {
+		return data, err
+	}
+	m.pendingSecretRefs[key] = &componentSecretRef{
+		items:   items,
+		secrets: secrets,
+		metadata: func(secrets map[string]string) (map[string]string, error) {
+			injected, err := ref.InjectSecretsToJSON(data, secrets)
+			if err != nil {
+				return nil, err
+			}
+			fields := make(map[string]json.RawMessage)
+			if err := json.Unmarshal(injected, &fields); err != nil {
+				return nil, err
+			}
+			res := make(map[string]string, len(fields))
+			for k, v := range fields {
+				res[k] = string(v)
+			}
+			return res, nil
+		},
+	}
+	return injected, nil
+}
+
+func (m *MosnRuntime) injectSecretRefToOssMetadata(name string, items []*refconfig.SecretRefConfig, metadata map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	if len(items) == 0 {
+		m.pendingSecretRefs[lifecycle.ComponentKey{Kind: lifecycle.KindOss, Name: name}] = nil
+		return metadata, nil
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return metadata, err
+	}
+	if data, err = m.injectSecretRefToJSON(lifecycle.KindOss, name, items, data); err != nil {
+		return metadata, err
+	}
+	res := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return metadata, err
+	}
+	return res, nil
+}
+
+// commitSecretRefs puts the pending secret refs of the component and its nested components into service,
+// the secret refs of the nested components not created again are removed
+func (m *MosnRuntime) commitSecretRefs(kind string, name string) {
+	for key := range m.secretRefs {
+		if _, ok := m.pendingSecretRefs[key]; !ok && isNestedComponent(key, kind, name) {
+			delete(m.secretRefs, key)
+		}
+	}
+	for key, r := range m.pendingSecretRefs {
+		if key != (lifecycle.ComponentKey{Kind: kind, Name: name}) && !isNestedComponent(key, kind, name) {
+			continue
+		}
+		if r == nil {
+			delete(m.secretRefs, key)
+		} else {
+			m.secretRefs[key] = r
+		}
+		delete(m.pendingSecretRefs, key)
+	}
+}
+
+// startSecretRefresher refreshes the secrets periodically if the interval is configured
+func (m *MosnRuntime) startSecretRefresher() error {
+	if m.runtimeConfig == nil || m.runtimeConfig.SecretRefreshInterval == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(m.runtimeConfig.SecretRefreshInterval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("[runtime] invalid secret_refresh_interval %s", m.runtimeConfig.SecretRefreshInterval)
+	}
+	stop := make(chan struct{})
+	m.stopSecretRefresher = stop
+	utils.GoWithRecover(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), componentOperationTimeout)
+				m.refreshSecrets(ctx)
+				cancel()
+			case <-stop:
+				return
+			}
+		}
+	}, nil)
+	log.DefaultLogger.Infof("[runtime] start to refresh secrets every %s", interval)
+	return nil
+}
+
+// refreshSecrets resolves the secret refs again, and applies the rotated secrets to the components.
+// The components failed to apply the secrets will be retried on next refreshing.
+func (m *MosnRuntime) refreshSecrets(ctx context.Context) {
+	m.componentsMu.Lock()
+	defer m.componentsMu.Unlock()
+	for key, r := range m.secretRefs {
+		secrets, err := m.Injector.ResolveSecretRef(r.items)
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] failed to refresh secrets of component %s %s: %v", key.Kind, key.Name, err)
+			continue
+		}
+		if reflect.DeepEqual(secrets, r.secrets) {
+			continue
+		}
+		comp, ok := m.dynamicComponents[key]
+		if !ok {
+			log.DefaultLogger.Warnf("[runtime] secrets of component %s %s are rotated, but it can not apply them until restart", key.Kind, key.Name)
+			r.secrets = secrets
+			continue
+		}
+		metadata, err := r.metadata(secrets)
+		if err == nil {
+			err = comp.ApplyConfig(ctx, metadata)
+		}
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] failed to apply the rotated secrets to component %s %s: %v", key.Kind, key.Name, err)
+			continue
+		}
+		r.secrets = secrets
+		log.DefaultLogger.Infof("[runtime] the rotated secrets are applied to component %s %s", key.Kind, key.Name)
+	}
+}
+
+func copyMetadata(metadata map[string]string) map[string]string {
+	res := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,320 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/hello"
+	"mosn.io/layotto/components/oss"
+	refconfig "mosn.io/layotto/components/ref"
+	"mosn.io/layotto/pkg/runtime/lifecycle"
+)
+
+type rotatingSecretStore struct {
+	mu      sync.Mutex
+	secrets map[string]map[string]string
+}
+
+func (s *rotatingSecretStore) Init(metadata secretstores.Metadata) error {
+	return nil
+}
+
+func (s *rotatingSecretStore) GetSecret(req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := make(map[string]string)
+	for k, v := range s.secrets[req.Name] {
+		data[k] = v
+	}
+	return secretstores.GetSecretResponse{Data: data}, nil
+}
+
+func (s *rotatingSecretStore) BulkGetSecret(req secretstores.BulkGetSecretRequest) (secretstores.BulkGetSecretResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return secretstores.BulkGetSecretResponse{Data: s.secrets}, nil
+}
+
+func (s *rotatingSecretStore) rotate(key, subKey, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[key] = map[string]string{subKey: value}
+}
+
+func TestMosnRuntime_refreshSecrets(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	store := &rotatingSecretStore{secrets: map[string]map[string]string{}}
+	store.rotate("greetings", "value", "hello")
+	m.secretStores["rotating"] = store
+	ctx := context.Background()
+
+	config := hello.HelloConfig{
+		Type:     "helloworld",
+		Metadata: map[string]string{"hello": "default"},
+	}
+	config.SecretRef = []*refconfig.SecretRefConfig{
+		{StoreName: "rotating", Key: "greetings", SubKey: "value", InjectAs: "hello"},
+	}
+	data, err := json.Marshal(config)
+	assert.Nil(t, err)
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindHello, "rotating", data))
+	key := lifecycle.ComponentKey{Kind: lifecycle.KindHello, Name: "rotating"}
+	assert.Equal(t, map[string]string{"hello": "hello"}, m.secretRefs[key].secrets)
+
+	// nothing changes
+	m.refreshSecrets(ctx)
+	assert.Equal(t, "", sayHello(t, m, "rotating"))
+
+	store.rotate("greetings", "value", "rotated")
+	m.refreshSecrets(ctx)
+	assert.Equal(t, "rotated", sayHello(t, m, "rotating"))
+	assert.Equal(t, map[string]string{"hello": "rotated"}, m.secretRefs[key].secrets)
+
+	// the secret refs are removed with the component
+	assert.Nil(t, m.RemoveComponent(ctx, lifecycle.KindHello, "rotating"))
+	assert.NotContains(t, m.secretRefs, key)
+}
+
+// secretFile is a file component recording the metadata applied to it
+type secretFile struct {
+	file.File
+	applied map[string]string
+}
+
+func (f *secretFile) Init(ctx context.Context, config *file.FileConfig) error {
+	return nil
+}
+
+func (f *secretFile) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	f.applied = metadata
+	return nil
+}
+
+// wrappingSecretFile wraps the file component configured in the store field of the metadata
+type wrappingSecretFile struct {
+	secretFile
+	wrapped file.File
+}
+
+func (f *wrappingSecretFile) WrappedConfig(config *file.FileConfig) (*file.FileConfig, error) {
+	var meta struct {
+		Store file.FileConfig `json:"store"`
+	}
+	if err := json.Unmarshal(config.Metadata, &meta); err != nil {
+		return nil, err
+	}
+	return &meta.Store, nil
+}
+
+func (f *wrappingSecretFile) Wrap(wrapped file.File) {
+	f.wrapped = wrapped
+}
+
+func TestMosnRuntime_refreshNestedSecrets(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	store := &rotatingSecretStore{secrets: map[string]map[string]string{}}
+	store.rotate("outer", "token", "outer")
+	store.rotate("inner", "token", "inner")
+	m.secretStores["rotating"] = store
+	m.fileRegistry.Register(
+		file.NewFileFactory("plain", func() file.File { return &secretFile{} }),
+		file.NewFileFactory("wrapping", func() file.File { return &wrappingSecretFile{} }),
+	)
+	ctx := context.Background()
+
+	data := []byte(`{
+		"type": "wrapping",
+		"secret_ref": [{"store_name": "rotating", "key": "outer", "sub_key": "token", "inject_as": "token"}],
+		"metadata": {
+			"token": "",
+			"store": {
+				"type": "plain",
+				"secret_ref": [{"store_name": "rotating", "key": "inner", "sub_key": "token", "inject_as": "token"}],
+				"metadata": {"token": ""}
+			}
+		}
+	}`)
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindFile, "artifacts", data))
+	outer := m.files["artifacts"].(*wrappingSecretFile)
+	inner := outer.wrapped.(*secretFile)
+	outerKey := lifecycle.ComponentKey{Kind: lifecycle.KindFile, Name: "artifacts"}
+	innerKey := lifecycle.ComponentKey{Kind: lifecycle.KindFile, Name: "artifacts/wrapped"}
+	assert.Equal(t, map[string]string{"token": "outer"}, m.secretRefs[outerKey].secrets)
+	assert.Equal(t, map[string]string{"token": "inner"}, m.secretRefs[innerKey].secrets)
+	assert.Contains(t, m.dynamicComponents, innerKey)
+
+	// the rotated secrets are applied to the component referring to them
+	store.rotate("inner", "token", "inner-rotated")
+	m.refreshSecrets(ctx)
+	assert.Equal(t, `"inner-rotated"`, inner.applied["token"])
+	assert.Nil(t, outer.applied)
+
+	store.rotate("outer", "token", "outer-rotated")
+	m.refreshSecrets(ctx)
+	assert.Equal(t, `"outer-rotated"`, outer.applied["token"])
+	assert.Equal(t, `"inner-rotated"`, inner.applied["token"])
+
+	// the secret refs are kept if the new component fails to be created
+	broken := strings.Replace(string(data), `"type": "plain"`, `"type": "not_exist"`, 1)
+	broken = strings.Replace(broken, `"key": "outer"`, `"key": "inner"`, 1)
+	assert.Error(t, m.ApplyComponent(ctx, lifecycle.KindFile, "artifacts", []byte(broken)))
+	assert.Equal(t, "outer", m.secretRefs[outerKey].items[0].Key)
+	assert.Contains(t, m.secretRefs, innerKey)
+	assert.Empty(t, m.pendingSecretRefs)
+
+	// the nested component is unregistered with the wrapper
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindFile, "artifacts", []byte(`{"type":"plain","metadata":{}}`)))
+	assert.NotContains(t, m.secretRefs, outerKey)
+	assert.NotContains(t, m.secretRefs, innerKey)
+	assert.NotContains(t, m.dynamicComponents, innerKey)
+	assert.Empty(t, m.nestedComponents)
+
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindFile, "artifacts", data))
+	assert.Contains(t, m.secretRefs, innerKey)
+	assert.Nil(t, m.RemoveComponent(ctx, lifecycle.KindFile, "artifacts"))
+	assert.NotContains(t, m.secretRefs, innerKey)
+	assert.NotContains(t, m.dynamicComponents, innerKey)
+}
+
+// secretOss is an oss component recording the metadata applied to it
+type secretOss struct {
+	oss.Oss
+	applied map[string]string
+}
+
+func (o *secretOss) Init(ctx context.Context, config *oss.Config) error {
+	return nil
+}
+
+func (o *secretOss) ApplyConfig(ctx context.Context, metadata map[string]string) error {
+	o.applied = metadata
+	return nil
+}
+
+// wrappingSecretOss wraps the oss component configured in the store field of the metadata
+type wrappingSecretOss struct {
+	secretOss
+	wrapped oss.Oss
+}
+
+func (o *wrappingSecretOss) WrappedConfig(config *oss.Config) (*oss.Config, error) {
+	var store oss.Config
+	if err := json.Unmarshal(config.Metadata["store"], &store); err != nil {
+		return nil, err
+	}
+	return &store, nil
+}
+
+func (o *wrappingSecretOss) Wrap(wrapped oss.Oss) {
+	o.wrapped = wrapped
+}
+
+func TestMosnRuntime_refreshNestedOssSecrets(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	store := &rotatingSecretStore{secrets: map[string]map[string]string{}}
+	store.rotate("outer", "token", "outer")
+	store.rotate("inner", "token", "inner")
+	m.secretStores["rotating"] = store
+	m.ossRegistry.Register(
+		oss.NewFactory("plain", func() oss.Oss { return &secretOss{} }),
+		oss.NewFactory("wrapping", func() oss.Oss { return &wrappingSecretOss{} }),
+	)
+	ctx := context.Background()
+
+	data := []byte(`{
+		"type": "wrapping",
+		"secret_ref": [{"store_name": "rotating", "key": "outer", "sub_key": "token", "inject_as": "token"}],
+		"metadata": {
+			"token": "",
+			"store": {
+				"type": "plain",
+				"secret_ref": [{"store_name": "rotating", "key": "inner", "sub_key": "token", "inject_as": "token"}],
+				"metadata": {"token": ""}
+			}
+		}
+	}`)
+	assert.Nil(t, m.ApplyComponent(ctx, lifecycle.KindOss, "encrypted", data))
+	outer := m.oss["encrypted"].(*wrappingSecretOss)
+	inner := outer.wrapped.(*secretOss)
+	outerKey := lifecycle.ComponentKey{Kind: lifecycle.KindOss, Name: "encrypted"}
+	innerKey := lifecycle.ComponentKey{Kind: lifecycle.KindOss, Name: "encrypted/wrapped"}
+	assert.Equal(t, map[string]string{"token": "outer"}, m.secretRefs[outerKey].secrets)
+	assert.Equal(t, map[string]string{"token": "inner"}, m.secretRefs[innerKey].secrets)
+
+	store.rotate("inner", "token", "inner-rotated")
+	m.refreshSecrets(ctx)
+	assert.Equal(t, `"inner-rotated"`, inner.applied["token"])
+	assert.Nil(t, outer.applied)
+
+	store.rotate("outer", "token", "outer-rotated")
+	m.refreshSecrets(ctx)
+	assert.Equal(t, `"outer-rotated"`, outer.applied["token"])
+	assert.Equal(t, `"inner-rotated"`, inner.applied["token"])
+
+	assert.Nil(t, m.RemoveComponent(ctx, lifecycle.KindOss, "encrypted"))
+	assert.NotContains(t, m.secretRefs, innerKey)
+	assert.NotContains(t, m.dynamicComponents, innerKey)
+}
+
+func TestMosnRuntime_injectSecretRefToJSON(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	store := &rotatingSecretStore{secrets: map[string]map[string]string{}}
+	store.rotate("oss", "sk", "secret")
+	m.secretStores["rotating"] = store
+
+	items := []*refconfig.SecretRefConfig{
+		{StoreName: "rotating", Key: "oss", SubKey: "sk", InjectAs: "basic.accessKeySecret"},
+	}
+	data, err := m.injectSecretRefToJSON(lifecycle.KindOss, "oss", items, []byte(`{"basic":{"accessKeyID":"ak"},"region":"r"}`))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"basic":{"accessKeyID":"ak","accessKeySecret":"secret"},"region":"r"}`, string(data))
+
+	// the secret refs are pending until the component is put into service
+	key := lifecycle.ComponentKey{Kind: lifecycle.KindOss, Name: "oss"}
+	assert.NotContains(t, m.secretRefs, key)
+	r := m.pendingSecretRefs[key]
+	metadata, err := r.metadata(map[string]string{"basic.accessKeySecret": "rotated"})
+	assert.Nil(t, err)
+	assert.Equal(t, `"r"`, metadata["region"])
+	assert.JSONEq(t, `{"accessKeyID":"ak","accessKeySecret":"rotated"}`, metadata["basic"])
+
+	// unknown secret store
+	items[0].StoreName = "not_exist"
+	_, err = m.injectSecretRefToJSON(lifecycle.KindOss, "oss", items, []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestMosnRuntime_startSecretRefresher(t *testing.T) {
+	m := newDynamicTestRuntime(t)
+	m.runtimeConfig.SecretRefreshInterval = "invalid"
+	assert.Error(t, m.startSecretRefresher())
+
+	m.runtimeConfig.SecretRefreshInterval = "1m"
+	assert.Nil(t, m.startSecretRefresher())
+	assert.NotNil(t, m.stopSecretRefresher)
+	m.Stop()
+	assert.Nil(t, m.stopSecretRefresher)
+}
@@ -26,6 +26,12 @@ type LifecycleClient interface {
 	// Apply the dynamic configuration.
 	// The DynamicConfiguration here should be full configuration, not incremental configuration
 	ApplyConfiguration(ctx context.Context, in *DynamicConfiguration, opts ...grpc.CallOption) (*ApplyConfigurationResponse, error)
+	// Create a component, or replace the existing one with the same kind and name.
+	// In-flight requests are drained before the old component is swapped out and closed.
+	ApplyComponent(ctx context.Context, in *ApplyComponentRequest, opts ...grpc.CallOption) (*ApplyComponentResponse, error)
+	// Tear down a component.
+	// In-flight requests are drained before the component is removed and closed.
+	RemoveComponent(ctx context.Context, in *RemoveComponentRequest, opts ...grpc.CallOption) (*RemoveComponentResponse, error)
 }
 
 type lifecycleClient struct {
@@ -45,6 +51,24 @@ func (c *lifecycleClient) ApplyConfiguration(ctx context.Context, in *DynamicCon
 	return out, nil
 }
 
+func (c *lifecycleClient) ApplyComponent(ctx context.Context, in *ApplyComponentRequest, opts ...grpc.CallOption) (*ApplyComponentResponse, error) {
+	out := new(ApplyComponentResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Lifecycle/ApplyComponent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lifecycleClient) RemoveComponent(ctx context.Context, in *RemoveComponentRequest, opts ...grpc.CallOption) (*RemoveComponentResponse, error) {
+	out := new(RemoveComponentResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Lifecycle/RemoveComponent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LifecycleServer is the server API for Lifecycle service.
 // All implementations should embed UnimplementedLifecycleServer
 // for forward compatibility
@@ -52,6 +76,12 @@ type LifecycleServer interface {
 	// Apply the dynamic configuration.
 	// The DynamicConfiguration here should be full configuration, not incremental configuration
 	ApplyConfiguration(context.Context, *DynamicConfiguration) (*ApplyConfigurationResponse, error)
+	// Create a component, or replace the existing one with the same kind and name.
+	// In-flight requests are drained before the old component is swapped out and closed.
+	ApplyComponent(context.Context, *ApplyComponentRequest) (*ApplyComponentResponse, error)
+	// Tear down a component.
+	// In-flight requests are drained before the component is removed and closed.
+	RemoveComponent(context.Context, *RemoveComponentRequest) (*RemoveComponentResponse, error)
 }
 
 // UnimplementedLifecycleServer should be embedded to have forward compatible implementations.
@@ -61,6 +91,12 @@ type UnimplementedLifecycleServer struct {
 func (UnimplementedLifecycleServer) ApplyConfiguration(context.Context, *DynamicConfiguration) (*ApplyConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
 }
+func (UnimplementedLifecycleServer) ApplyComponent(context.Context, *ApplyComponentRequest) (*ApplyComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyComponent not implemented")
+}
+func (UnimplementedLifecycleServer) RemoveComponent(context.Context, *RemoveComponentRequest) (*RemoveComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveComponent not implemented")
+}
 
 // UnsafeLifecycleServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LifecycleServer will
@@ -91,6 +127,42 @@ func _Lifecycle_ApplyConfiguration_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lifecycle_ApplyComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifecycleServer).ApplyComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Lifecycle/ApplyComponent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifecycleServer).ApplyComponent(ctx, req.(*ApplyComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lifecycle_RemoveComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifecycleServer).RemoveComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Lifecycle/RemoveComponent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifecycleServer).RemoveComponent(ctx, req.(*RemoveComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Lifecycle_ServiceDesc is the grpc.ServiceDesc for Lifecycle service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -102,6 +174,14 @@ var Lifecycle_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyConfiguration",
 			Handler:    _Lifecycle_ApplyConfiguration_Handler,
 		},
+		{
+			MethodName: "ApplyComponent",
+			Handler:    _Lifecycle_ApplyComponent_Handler,
+		},
+		{
+			MethodName: "RemoveComponent",
+			Handler:    _Lifecycle_RemoveComponent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lifecycle.proto",
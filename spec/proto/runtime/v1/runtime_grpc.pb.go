@@ -28,6 +28,9 @@ type RuntimeClient interface {
 	SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error)
 	// InvokeService do rpc calls
 	InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+	// InvokeServiceStream do streaming rpc calls, including client streaming, server streaming and bidirectional streaming.
+	// The first request must contain the id and the method of the service.
+	InvokeServiceStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceStreamClient, error)
 	// GetConfiguration gets configuration from configuration store.
 	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
 	// SaveConfiguration saves configuration into configuration store.
@@ -73,6 +76,21 @@ type RuntimeClient interface {
 	DelFile(ctx context.Context, in *DelFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get file meta data, if file not exist,return code.NotFound error
 	GetFileMeta(ctx context.Context, in *GetFileMetaRequest, opts ...grpc.CallOption) (*GetFileMetaResponse, error)
+	// Get the size of the data persisted by the unfinished resumable upload of a file,
+	// the upload is resumed by calling PutFile with it as the offset
+	GetFileUploadOffset(ctx context.Context, in *GetFileUploadOffsetRequest, opts ...grpc.CallOption) (*GetFileUploadOffsetResponse, error)
+	// Presign the url of a file, with which the clients get or put the file directly
+	// without proxying it through the app
+	SignFileURL(ctx context.Context, in *SignFileURLRequest, opts ...grpc.CallOption) (*SignFileURLResponse, error)
+	// Subscribe the events of the files created or deleted in a directory of the store,
+	// the events are sent until the client cancels the stream
+	SubscribeFileEvents(ctx context.Context, in *SubscribeFileEventsRequest, opts ...grpc.CallOption) (Runtime_SubscribeFileEventsClient, error)
+	// Create a directory, including the missing parent directories
+	Mkdir(ctx context.Context, in *MkdirRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Rename or move a file, which is not atomic on the stores copying and then deleting the file
+	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Copy a file in the store
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -107,6 +125,37 @@ func (c *runtimeClient) InvokeService(ctx context.Context, in *InvokeServiceRequ
 	return out, nil
 }
 
+func (c *runtimeClient) InvokeServiceStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[0], "/spec.proto.runtime.v1.Runtime/InvokeServiceStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeInvokeServiceStreamClient{stream}
+	return x, nil
+}
+
+type Runtime_InvokeServiceStreamClient interface {
+	Send(*InvokeServiceStreamRequest) error
+	Recv() (*InvokeServiceStreamResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeInvokeServiceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeInvokeServiceStreamClient) Send(m *InvokeServiceStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceStreamClient) Recv() (*InvokeServiceStreamResponse, error) {
+	m := new(InvokeServiceStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runtimeClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
 	out := new(GetConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetConfiguration", in, out, opts...)
@@ -135,7 +184,7 @@ func (c *runtimeClient) DeleteConfiguration(ctx context.Context, in *DeleteConfi
 }
 
 func (c *runtimeClient) SubscribeConfiguration(ctx context.Context, opts ...grpc.CallOption) (Runtime_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[0], "/spec.proto.runtime.v1.Runtime/SubscribeConfiguration", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[1], "/spec.proto.runtime.v1.Runtime/SubscribeConfiguration", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +314,7 @@ func (c *runtimeClient) PublishEvent(ctx context.Context, in *PublishEventReques
 }
 
 func (c *runtimeClient) SubscribeTopicEvents(ctx context.Context, opts ...grpc.CallOption) (Runtime_SubscribeTopicEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[1], "/spec.proto.runtime.v1.Runtime/SubscribeTopicEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[2], "/spec.proto.runtime.v1.Runtime/SubscribeTopicEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +345,7 @@ func (x *runtimeSubscribeTopicEventsClient) Recv() (*SubscribeTopicEventsRespons
 }
 
 func (c *runtimeClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (Runtime_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[2], "/spec.proto.runtime.v1.Runtime/GetFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[3], "/spec.proto.runtime.v1.Runtime/GetFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +377,7 @@ func (x *runtimeGetFileClient) Recv() (*GetFileResponse, error) {
 }
 
 func (c *runtimeClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (Runtime_PutFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[3], "/spec.proto.runtime.v1.Runtime/PutFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[4], "/spec.proto.runtime.v1.Runtime/PutFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +437,83 @@ func (c *runtimeClient) GetFileMeta(ctx context.Context, in *GetFileMetaRequest,
 	return out, nil
 }
 
+func (c *runtimeClient) GetFileUploadOffset(ctx context.Context, in *GetFileUploadOffsetRequest, opts ...grpc.CallOption) (*GetFileUploadOffsetResponse, error) {
+	out := new(GetFileUploadOffsetResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetFileUploadOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) SignFileURL(ctx context.Context, in *SignFileURLRequest, opts ...grpc.CallOption) (*SignFileURLResponse, error) {
+	out := new(SignFileURLResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/SignFileURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) SubscribeFileEvents(ctx context.Context, in *SubscribeFileEventsRequest, opts ...grpc.CallOption) (Runtime_SubscribeFileEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[5], "/spec.proto.runtime.v1.Runtime/SubscribeFileEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeSubscribeFileEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Runtime_SubscribeFileEventsClient interface {
+	Recv() (*SubscribeFileEventsResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeSubscribeFileEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeSubscribeFileEventsClient) Recv() (*SubscribeFileEventsResponse, error) {
+	m := new(SubscribeFileEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeClient) Mkdir(ctx context.Context, in *MkdirRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/Mkdir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/RenameFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/InvokeBinding", in, out, opts...)
@@ -423,6 +549,9 @@ type RuntimeServer interface {
 	SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error)
 	// InvokeService do rpc calls
 	InvokeService(context.Context, *InvokeServiceRequest) (*InvokeResponse, error)
+	// InvokeServiceStream do streaming rpc calls, including client streaming, server streaming and bidirectional streaming.
+	// The first request must contain the id and the method of the service.
+	InvokeServiceStream(Runtime_InvokeServiceStreamServer) error
 	// GetConfiguration gets configuration from configuration store.
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
 	// SaveConfiguration saves configuration into configuration store.
@@ -468,6 +597,21 @@ type RuntimeServer interface {
 	DelFile(context.Context, *DelFileRequest) (*emptypb.Empty, error)
 	// Get file meta data, if file not exist,return code.NotFound error
 	GetFileMeta(context.Context, *GetFileMetaRequest) (*GetFileMetaResponse, error)
+	// Get the size of the data persisted by the unfinished resumable upload of a file,
+	// the upload is resumed by calling PutFile with it as the offset
+	GetFileUploadOffset(context.Context, *GetFileUploadOffsetRequest) (*GetFileUploadOffsetResponse, error)
+	// Presign the url of a file, with which the clients get or put the file directly
+	// without proxying it through the app
+	SignFileURL(context.Context, *SignFileURLRequest) (*SignFileURLResponse, error)
+	// Subscribe the events of the files created or deleted in a directory of the store,
+	// the events are sent until the client cancels the stream
+	SubscribeFileEvents(*SubscribeFileEventsRequest, Runtime_SubscribeFileEventsServer) error
+	// Create a directory, including the missing parent directories
+	Mkdir(context.Context, *MkdirRequest) (*emptypb.Empty, error)
+	// Rename or move a file, which is not atomic on the stores copying and then deleting the file
+	RenameFile(context.Context, *RenameFileRequest) (*emptypb.Empty, error)
+	// Copy a file in the store
+	CopyFile(context.Context, *CopyFileRequest) (*emptypb.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -486,6 +630,9 @@ func (UnimplementedRuntimeServer) SayHello(context.Context, *SayHelloRequest) (*
 func (UnimplementedRuntimeServer) InvokeService(context.Context, *InvokeServiceRequest) (*InvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeService not implemented")
 }
+func (UnimplementedRuntimeServer) InvokeServiceStream(Runtime_InvokeServiceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeServiceStream not implemented")
+}
 func (UnimplementedRuntimeServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
@@ -549,6 +696,24 @@ func (UnimplementedRuntimeServer) DelFile(context.Context, *DelFileRequest) (*em
 func (UnimplementedRuntimeServer) GetFileMeta(context.Context, *GetFileMetaRequest) (*GetFileMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFileMeta not implemented")
 }
+func (UnimplementedRuntimeServer) GetFileUploadOffset(context.Context, *GetFileUploadOffsetRequest) (*GetFileUploadOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUploadOffset not implemented")
+}
+func (UnimplementedRuntimeServer) SignFileURL(context.Context, *SignFileURLRequest) (*SignFileURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignFileURL not implemented")
+}
+func (UnimplementedRuntimeServer) SubscribeFileEvents(*SubscribeFileEventsRequest, Runtime_SubscribeFileEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFileEvents not implemented")
+}
+func (UnimplementedRuntimeServer) Mkdir(context.Context, *MkdirRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mkdir not implemented")
+}
+func (UnimplementedRuntimeServer) RenameFile(context.Context, *RenameFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+}
+func (UnimplementedRuntimeServer) CopyFile(context.Context, *CopyFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFile not implemented")
+}
 func (UnimplementedRuntimeServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -606,6 +771,32 @@ func _Runtime_InvokeService_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runtime_InvokeServiceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuntimeServer).InvokeServiceStream(&runtimeInvokeServiceStreamServer{stream})
+}
+
+type Runtime_InvokeServiceStreamServer interface {
+	Send(*InvokeServiceStreamResponse) error
+	Recv() (*InvokeServiceStreamRequest, error)
+	grpc.ServerStream
+}
+
+type runtimeInvokeServiceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeInvokeServiceStreamServer) Send(m *InvokeServiceStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceStreamServer) Recv() (*InvokeServiceStreamRequest, error) {
+	m := new(InvokeServiceStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Runtime_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -1011,6 +1202,117 @@ func _Runtime_GetFileMeta_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runtime_GetFileUploadOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUploadOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).GetFileUploadOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetFileUploadOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).GetFileUploadOffset(ctx, req.(*GetFileUploadOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_SignFileURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignFileURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).SignFileURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/SignFileURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).SignFileURL(ctx, req.(*SignFileURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_SubscribeFileEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFileEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServer).SubscribeFileEvents(m, &runtimeSubscribeFileEventsServer{stream})
+}
+
+type Runtime_SubscribeFileEventsServer interface {
+	Send(*SubscribeFileEventsResponse) error
+	grpc.ServerStream
+}
+
+type runtimeSubscribeFileEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeSubscribeFileEventsServer) Send(m *SubscribeFileEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Runtime_Mkdir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MkdirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).Mkdir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/Mkdir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).Mkdir(ctx, req.(*MkdirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_RenameFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).RenameFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/RenameFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).RenameFile(ctx, req.(*RenameFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).CopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/CopyFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).CopyFile(ctx, req.(*CopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runtime_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -1148,6 +1450,26 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFileMeta",
 			Handler:    _Runtime_GetFileMeta_Handler,
 		},
+		{
+			MethodName: "GetFileUploadOffset",
+			Handler:    _Runtime_GetFileUploadOffset_Handler,
+		},
+		{
+			MethodName: "SignFileURL",
+			Handler:    _Runtime_SignFileURL_Handler,
+		},
+		{
+			MethodName: "Mkdir",
+			Handler:    _Runtime_Mkdir_Handler,
+		},
+		{
+			MethodName: "RenameFile",
+			Handler:    _Runtime_RenameFile_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _Runtime_CopyFile_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Runtime_InvokeBinding_Handler,
@@ -1162,6 +1484,12 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeServiceStream",
+			Handler:       _Runtime_InvokeServiceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SubscribeConfiguration",
 			Handler:       _Runtime_SubscribeConfiguration_Handler,
@@ -1184,6 +1512,11 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Runtime_PutFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeFileEvents",
+			Handler:       _Runtime_SubscribeFileEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "runtime.proto",
 }